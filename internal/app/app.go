@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -28,11 +29,7 @@ type Options struct {
 func Run(ctx context.Context, opts Options) error {
 	if os.Getenv("LAZYGITLAB_MOCK_DATA") == "1" {
 		provider := NewMockProvider()
-		model := tui.NewDashboardModel(provider, tui.DashboardContext{
-			ProjectPath: "mock/group/project",
-			Connection:  "Connected as mock-user",
-			Host:        "https://mock.gitlab.local/api/v4",
-		})
+		model := tui.NewDashboardModel(provider, mockDashboardContext("mock/group/project", &mockSettings{}))
 
 		program := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
 		if _, err := program.Run(); err != nil {
@@ -100,6 +97,8 @@ func Run(ctx context.Context, opts Options) error {
 				instances = append(instances, selected)
 			}
 
+			// The picker toggles favourites from its own goroutine.
+			var cfgMu sync.Mutex
 			instanceByHost := make(map[string]config.Instance, len(instances))
 			options := make([]tui.InstanceOption, 0, len(instances))
 			for _, instance := range instances {
@@ -133,24 +132,28 @@ func Run(ctx context.Context, opts Options) error {
 					listCtx, cancelList := context.WithTimeout(ctx, 20*time.Second)
 					defer cancelList()
 
-					projects, listErr := pickerClient.ListProjects(listCtx, "")
+					paths, listErr := listProjectPaths(listCtx, pickerClient)
 					if listErr != nil {
 						return nil, fmt.Errorf("load projects for picker (timeout 20s): %w", listErr)
 					}
 
-					projectOptions := make([]tui.StartupProjectOption, 0, len(projects))
-					for _, project := range projects {
-						if project == nil {
-							continue
-						}
-						path := strings.TrimSpace(project.PathWithNamespace)
-						if path == "" {
-							continue
-						}
-						projectOptions = append(projectOptions, tui.StartupProjectOption{Path: path, Label: path})
+					cfgMu.Lock()
+					defer cfgMu.Unlock()
+					return startupProjectOptions(cfg, instance.Host, paths), nil
+				},
+				ToggleFavorite: func(instanceOption tui.InstanceOption, path string) (bool, error) {
+					instance, ok := instanceByHost[strings.ToLower(strings.TrimSpace(instanceOption.Host))]
+					if !ok {
+						return false, fmt.Errorf("selected instance %q is unavailable", strings.TrimSpace(instanceOption.Host))
 					}
 
-					return projectOptions, nil
+					cfgMu.Lock()
+					defer cfgMu.Unlock()
+					favorite := cfg.ToggleFavoriteProject(instance.Host, path)
+					if err := config.Save(cfg); err != nil {
+						return favorite, fmt.Errorf("save favourite projects: %w", err)
+					}
+					return favorite, nil
 				},
 			})
 			if startupErr != nil {
@@ -208,6 +211,7 @@ func Run(ctx context.Context, opts Options) error {
 	}
 
 	cfg.LastProject = projectPath
+	cfg.AddRecentProject(cfg.Host, projectPath)
 	if err := config.Save(cfg); err != nil {
 		logger.Printf("failed to persist last project: %v", err)
 	}
@@ -218,10 +222,17 @@ func Run(ctx context.Context, opts Options) error {
 		return nil
 	}
 
+	shared := newSettings(cfg)
 	model := tui.NewDashboardModel(provider, tui.DashboardContext{
-		ProjectPath: projectPath,
-		Connection:  fmt.Sprintf("Connected as %s", user.Username),
-		Host:        cfg.Host,
+		ProjectPath:        projectPath,
+		Connection:         fmt.Sprintf("Connected as %s", user.Username),
+		Host:               cfg.Host,
+		User:               tui.CurrentUser{ID: user.ID, Username: user.Username, Name: user.Name},
+		IssueBranchPattern: cfg.IssueBranchPattern,
+		Switcher:           newProjectSwitcher(shared, client, logger),
+		Filters:            shared,
+		Sorts:              shared,
+		Views:              shared,
 	})
 
 	program := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
@@ -1,9 +1,12 @@
 package app
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/davzucky/lazygitlab/internal/tui"
 )
@@ -14,6 +17,12 @@ func NewMockProvider() *MockProvider {
 	return &MockProvider{}
 }
 
+// ForProject returns the same provider: the mock serves identical data for
+// every project.
+func (p *MockProvider) ForProject(string) tui.DataProvider {
+	return p
+}
+
 func (p *MockProvider) LoadProjects(context.Context) ([]tui.ListItem, error) {
 	return []tui.ListItem{
 		{ID: 101, Title: "mock/group/project", Subtitle: "Mock project for CI validation", URL: "https://mock.gitlab.local/mock/group/project"},
@@ -37,10 +46,7 @@ func (p *MockProvider) LoadIssues(_ context.Context, query tui.IssueQuery) (tui.
 	needle := strings.ToLower(strings.TrimSpace(query.Search))
 
 	for i := 120; i >= 1; i-- {
-		issueState := "opened"
-		if i%3 == 0 {
-			issueState = "closed"
-		}
+		issueState := mockIssueState(i)
 		if state == tui.IssueStateOpened && issueState != "opened" {
 			continue
 		}
@@ -48,28 +54,19 @@ func (p *MockProvider) LoadIssues(_ context.Context, query tui.IssueQuery) (tui.
 			continue
 		}
 
-		title := fmt.Sprintf("Mock issue %03d with long title to validate clipping and stable panel width behavior", i)
-		if needle != "" && !strings.Contains(strings.ToLower(title), needle) {
+		item := mockIssueItem(i)
+		if needle != "" && !strings.Contains(strings.ToLower(item.Title), needle) {
 			continue
 		}
-
-		filtered = append(filtered, tui.ListItem{
-			ID:       int64(2000 + i),
-			Title:    title,
-			Subtitle: fmt.Sprintf("#%d • %s", 3000+i, issueState),
-			URL:      fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/issues/%d", 3000+i),
-			Issue: &tui.IssueDetails{
-				IID:         int64(3000 + i),
-				State:       issueState,
-				Author:      "Mock Author",
-				Assignees:   []string{"Mock Assignee"},
-				Labels:      []string{"mock", "ui"},
-				CreatedAt:   "2026-01-01 10:00 UTC",
-				UpdatedAt:   "2026-01-02 11:00 UTC",
-				URL:         fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/issues/%d", 3000+i),
-				Description: "Mock issue description for validating wrapped and scrollable issue detail rendering in the dashboard.",
-			},
-		})
+		if !mockIssueMatches(item.Issue, query.Filter) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+	// The mock issues only differ in age, so every ascending sort lists the
+	// oldest first.
+	if query.Sort.Ascending {
+		slices.Reverse(filtered)
 	}
 
 	start := (query.Page - 1) * query.PerPage
@@ -84,6 +81,51 @@ func (p *MockProvider) LoadIssues(_ context.Context, query tui.IssueQuery) (tui.
 	return tui.IssueResult{Items: filtered[start:end], HasNextPage: end < len(filtered)}, nil
 }
 
+// mockIssueMatches applies the label filters; the mock issues share their
+// other fields, so the remaining filters keep every issue.
+func mockIssueMatches(issue *tui.IssueDetails, filter tui.IssueFilter) bool {
+	for _, label := range filter.Labels {
+		if !slices.Contains(issue.Labels, label) {
+			return false
+		}
+	}
+	for _, label := range filter.NotLabels {
+		if slices.Contains(issue.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+func mockIssueState(i int) string {
+	if i%3 == 0 {
+		return "closed"
+	}
+	return "opened"
+}
+
+func mockIssueItem(i int) tui.ListItem {
+	issueState := mockIssueState(i)
+	return tui.ListItem{
+		ID:       int64(2000 + i),
+		Title:    fmt.Sprintf("Mock issue %03d with long title to validate clipping and stable panel width behavior", i),
+		Subtitle: fmt.Sprintf("#%d • %s", 3000+i, issueState),
+		URL:      fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/issues/%d", 3000+i),
+		Issue: &tui.IssueDetails{
+			IID:         int64(3000 + i),
+			State:       issueState,
+			Author:      "Mock Author",
+			Assignees:   []string{"Mock Assignee"},
+			AssigneeIDs: []int64{2},
+			Labels:      []string{"mock", "ui"},
+			CreatedAt:   "2026-01-01 10:00 UTC",
+			UpdatedAt:   "2026-01-02 11:00 UTC",
+			URL:         fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/issues/%d", 3000+i),
+			Description: "Mock issue description for validating wrapped and scrollable issue detail rendering in the dashboard.",
+		},
+	}
+}
+
 func (p *MockProvider) LoadMergeRequests(_ context.Context, query tui.MergeRequestQuery) (tui.MergeRequestResult, error) {
 	state := query.State
 	if state == "" {
@@ -97,14 +139,9 @@ func (p *MockProvider) LoadMergeRequests(_ context.Context, query tui.MergeReque
 	}
 
 	items := make([]tui.ListItem, 0, 20)
+	needle := strings.ToLower(strings.TrimSpace(query.Search))
 	for i := 20; i >= 1; i-- {
-		mrState := "opened"
-		switch {
-		case i%5 == 0:
-			mrState = "merged"
-		case i%4 == 0:
-			mrState = "closed"
-		}
+		mrState := mockMergeRequestState(i)
 		if state == tui.MergeRequestStateOpened && mrState != "opened" {
 			continue
 		}
@@ -115,25 +152,17 @@ func (p *MockProvider) LoadMergeRequests(_ context.Context, query tui.MergeReque
 			continue
 		}
 
-		iid := int64(6000 + i)
-		url := fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/merge_requests/%d", iid)
-		items = append(items, tui.ListItem{
-			ID:       int64(5000 + i),
-			Title:    fmt.Sprintf("Mock merge request %02d with extended title for rendering checks", i),
-			Subtitle: fmt.Sprintf("!%d • %s", iid, mrState),
-			URL:      url,
-			MergeRequest: &tui.MergeRequestDetails{
-				IID:          iid,
-				State:        mrState,
-				Author:       "Mock Author",
-				SourceBranch: fmt.Sprintf("feature/mock-%02d", i),
-				TargetBranch: "main",
-				CreatedAt:    "2026-01-01 10:00 UTC",
-				UpdatedAt:    "2026-01-02 11:00 UTC",
-				URL:          url,
-				Description:  "Mock merge request description for validating detail rendering and scroll behavior.",
-			},
-		})
+		item := mockMergeRequestItem(i)
+		if needle != "" && !strings.Contains(strings.ToLower(item.Title), needle) {
+			continue
+		}
+		if !mockMergeRequestMatches(item.MergeRequest, query.Filter) {
+			continue
+		}
+		items = append(items, item)
+	}
+	if query.Sort.Ascending {
+		slices.Reverse(items)
 	}
 
 	start := (query.Page - 1) * query.PerPage
@@ -148,12 +177,87 @@ func (p *MockProvider) LoadMergeRequests(_ context.Context, query tui.MergeReque
 	return tui.MergeRequestResult{Items: items[start:end], HasNextPage: end < len(items)}, nil
 }
 
+// mockMergeRequestMatches applies the parts of a filter the mock data can
+// answer: the target branch and the draft flag.
+func mockMergeRequestMatches(mr *tui.MergeRequestDetails, filter tui.MergeRequestFilter) bool {
+	if filter.Target != "" && filter.Target != mr.TargetBranch {
+		return false
+	}
+	if filter.Draft != "" && (filter.Draft == "yes") != mr.Draft {
+		return false
+	}
+	return true
+}
+
+func mockMergeRequestState(i int) string {
+	switch {
+	case i%5 == 0:
+		return "merged"
+	case i%4 == 0:
+		return "closed"
+	}
+	return "opened"
+}
+
+func mockMergeRequestItem(i int) tui.ListItem {
+	iid := int64(6000 + i)
+	mrState := mockMergeRequestState(i)
+	mergeStatus := "mergeable"
+	if mrState != "opened" {
+		mergeStatus = "not_open"
+	}
+	url := fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/merge_requests/%d", iid)
+	return tui.ListItem{
+		ID:       int64(5000 + i),
+		Title:    fmt.Sprintf("Mock merge request %02d with extended title for rendering checks", i),
+		Subtitle: fmt.Sprintf("!%d • %s", iid, mrState),
+		URL:      url,
+		MergeRequest: &tui.MergeRequestDetails{
+			IID:                iid,
+			State:              mrState,
+			Author:             "Mock Author",
+			SourceBranch:       fmt.Sprintf("feature/mock-%02d", i),
+			TargetBranch:       "main",
+			CreatedAt:          "2026-01-01 10:00 UTC",
+			UpdatedAt:          "2026-01-02 11:00 UTC",
+			URL:                url,
+			Description:        "Mock merge request description for validating detail rendering and scroll behavior.",
+			MergeStatus:        mergeStatus,
+			RemoveSourceBranch: true,
+			Draft:              i%6 == 0,
+		},
+	}
+}
+
+func mockMergeRequestIndex(mergeRequestIID int64) (int, error) {
+	if mergeRequestIID <= 6000 || mergeRequestIID > 6020 {
+		return 0, fmt.Errorf("merge request !%d not found", mergeRequestIID)
+	}
+	return int(mergeRequestIID - 6000), nil
+}
+
 func (p *MockProvider) LoadIssueDetailData(_ context.Context, issueIID int64) (tui.IssueDetailData, error) {
 	if issueIID <= 0 {
 		return tui.IssueDetailData{}, fmt.Errorf("invalid issue IID: %d", issueIID)
 	}
 
+	i := int(issueIID-3000) % 20
+	var links []tui.IssueLink
+	for _, link := range []struct {
+		kind tui.IssueLinkKind
+		item tui.ListItem
+	}{
+		{tui.IssueLinkRelatesTo, mockIssueItem(i + 1)},
+		{tui.IssueLinkBlockedBy, mockIssueItem(i + 2)},
+		{tui.IssueLinkClosedBy, mockMergeRequestItem(i + 1)},
+		{tui.IssueLinkRelatedToMR, mockMergeRequestItem(i + 2)},
+	} {
+		link.item.ProjectPath = "mock/group/project"
+		links = append(links, tui.IssueLink{Kind: link.kind, Item: link.item})
+	}
+
 	return tui.IssueDetailData{
+		Links: links,
 		Activities: []tui.IssueActivity{
 			{Actor: "Mock Author", CreatedAt: "2026-01-02 11:30 UTC", Action: "closed"},
 			{Actor: "Mock Assignee", CreatedAt: "2026-01-02 11:20 UTC", Action: "reopened"},
@@ -165,3 +269,973 @@ func (p *MockProvider) LoadIssueDetailData(_ context.Context, issueIID int64) (t
 		},
 	}, nil
 }
+
+func (p *MockProvider) LoadPipelines(_ context.Context, query tui.PipelineQuery) (tui.PipelineResult, error) {
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PerPage <= 0 {
+		query.PerPage = 25
+	}
+
+	statuses := []string{"success", "failed", "running", "success", "canceled", "success", "pending"}
+	refs := []string{"main", "feature/mock-01", "feature/mock-02", "release/1.2"}
+	items := make([]tui.ListItem, 0, 40)
+	for i := 40; i >= 1; i-- {
+		id := int64(9000 + i)
+		status := statuses[i%len(statuses)]
+		ref := refs[i%len(refs)]
+		sha := fmt.Sprintf("%08x", 0xabc000+i)
+		url := fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/pipelines/%d", id)
+		items = append(items, tui.ListItem{
+			ID:       id,
+			Title:    fmt.Sprintf("#%d %s", id, ref),
+			Subtitle: fmt.Sprintf("%s • %s", status, sha),
+			URL:      url,
+			Pipeline: &tui.PipelineDetails{
+				ID:          id,
+				IID:         int64(i),
+				Status:      status,
+				Ref:         ref,
+				SHA:         sha,
+				Source:      "push",
+				Duration:    fmt.Sprintf("%dm %02ds", 2+i%7, (i*13)%60),
+				TriggeredBy: "Mock Author",
+				CreatedAt:   "2026-01-01 10:00 UTC",
+				UpdatedAt:   "2026-01-02 11:00 UTC",
+				URL:         url,
+			},
+		})
+	}
+
+	start := (query.Page - 1) * query.PerPage
+	if start >= len(items) {
+		return tui.PipelineResult{Items: []tui.ListItem{}, HasNextPage: false}, nil
+	}
+	end := start + query.PerPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return tui.PipelineResult{Items: items[start:end], HasNextPage: end < len(items)}, nil
+}
+
+func (p *MockProvider) LoadPipelineDetailData(_ context.Context, pipelineID int64) (tui.PipelineDetailData, error) {
+	if pipelineID <= 0 {
+		return tui.PipelineDetailData{}, fmt.Errorf("invalid pipeline ID: %d", pipelineID)
+	}
+
+	url := fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/pipelines/%d", pipelineID)
+	return tui.PipelineDetailData{
+		Pipeline: tui.PipelineDetails{
+			ID:          pipelineID,
+			Status:      "failed",
+			Ref:         "main",
+			SHA:         "abc00123",
+			Source:      "push",
+			Duration:    "6m 12s",
+			TriggeredBy: "Mock Author",
+			CreatedAt:   "2026-01-01 10:00 UTC",
+			UpdatedAt:   "2026-01-02 11:00 UTC",
+			URL:         url,
+		},
+		Stages: []tui.PipelineStage{
+			{Name: "build", Status: "success", Jobs: []tui.PipelineJob{
+				{ID: pipelineID*10 + 1, Name: "compile", Stage: "build", Status: "success", Duration: "1m 04s"},
+				{ID: pipelineID*10 + 2, Name: "docker-image", Stage: "build", Status: "success", Duration: "2m 40s"},
+			}},
+			{Name: "test", Status: "failed", Jobs: []tui.PipelineJob{
+				{ID: pipelineID*10 + 3, Name: "unit", Stage: "test", Status: "success", Duration: "1m 12s"},
+				{ID: pipelineID*10 + 4, Name: "integration", Stage: "test", Status: "failed", Duration: "2m 55s"},
+				{ID: pipelineID*10 + 5, Name: "lint", Stage: "test", Status: "failed", Duration: "18s", AllowFailure: true},
+			}},
+			{Name: "deploy", Status: "skipped", Jobs: []tui.PipelineJob{
+				{ID: pipelineID*10 + 6, Name: "staging", Stage: "deploy", Status: "skipped", Duration: "-"},
+				{ID: pipelineID*10 + 7, Name: "production", Stage: "deploy", Status: "manual", Duration: "-"},
+			}},
+		},
+	}, nil
+}
+
+func (p *MockProvider) LoadMergeRequestPipeline(ctx context.Context, mergeRequestIID int64) (tui.PipelineDetailData, error) {
+	if mergeRequestIID <= 0 {
+		return tui.PipelineDetailData{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	return p.LoadPipelineDetailData(ctx, 9000+mergeRequestIID%40+1)
+}
+
+func (p *MockProvider) LoadJobTrace(_ context.Context, jobID int64, offset int64) (tui.JobTrace, error) {
+	if jobID <= 0 {
+		return tui.JobTrace{}, fmt.Errorf("invalid job ID: %d", jobID)
+	}
+
+	lines := []string{
+		"\x1b[0KRunning with gitlab-runner 17.0.0 (mock)",
+		"\x1b[0Ksection_start:1767261600:prepare_executor\r\x1b[0K\x1b[0K\x1b[36;1mPreparing the \"docker\" executor\x1b[0;m",
+		"\x1b[0KUsing Docker executor with image golang:1.24 ...",
+		"\x1b[0KPulling docker image golang:1.24 ...",
+		"\x1b[0Ksection_end:1767261604:prepare_executor\r\x1b[0K",
+		"\x1b[0Ksection_start:1767261604:get_sources[collapsed=true]\r\x1b[0K\x1b[0K\x1b[36;1mGetting source from Git repository\x1b[0;m",
+		"\x1b[32;1mFetching changes with git depth set to 20...\x1b[0;m",
+		"Initialized empty Git repository in /builds/mock/group/project/.git/",
+		"\x1b[32;1mChecking out abc00123 as detached HEAD (ref is main)...\x1b[0;m",
+		"\x1b[0Ksection_end:1767261609:get_sources\r\x1b[0K",
+		"\x1b[0Ksection_start:1767261609:step_script\r\x1b[0K\x1b[0K\x1b[36;1mExecuting \"step_script\" stage of the job script\x1b[0;m",
+		"\x1b[32;1m$ go test ./...\x1b[0;m",
+		"ok  \tgithub.com/mock/project/internal/config\t0.012s",
+		"--- FAIL: TestIntegration (2.31s)",
+		"    integration_test.go:42: expected 200, got 503",
+		"FAIL",
+		"FAIL\tgithub.com/mock/project/internal/server\t2.350s",
+		"\x1b[0Ksection_end:1767261784:step_script\r\x1b[0K",
+		"\x1b[0Ksection_start:1767261784:cleanup_file_variables\r\x1b[0K\x1b[0K\x1b[36;1mCleaning up project directory and file based variables\x1b[0;m",
+		"\x1b[0Ksection_end:1767261785:cleanup_file_variables\r\x1b[0K",
+		"\x1b[31;1mERROR: Job failed: exit code 1\x1b[0;m",
+	}
+	content := strings.Join(lines, "\n") + "\n"
+	if offset >= int64(len(content)) {
+		return tui.JobTrace{Offset: int64(len(content)), Status: "failed", Complete: true}, nil
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return tui.JobTrace{Content: content[offset:], Offset: int64(len(content)), Status: "failed", Complete: true}, nil
+}
+
+func (p *MockProvider) LoadMergeRequestDetailData(_ context.Context, mergeRequestIID int64) (tui.MergeRequestDetailData, error) {
+	if mergeRequestIID <= 0 {
+		return tui.MergeRequestDetailData{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	return tui.MergeRequestDetailData{
+		Threads: []tui.MergeRequestThread{
+			{
+				ID:         "mock-thread-1",
+				Resolvable: true,
+				Path:       "internal/server/handler.go",
+				OldLine:    14,
+				NewLine:    14,
+				Notes: []tui.IssueComment{
+					{Author: "Mock Reviewer", CreatedAt: "2026-01-02 09:15 UTC", Body: "Should `HEAD` really share the `GET` path here?"},
+					{Author: "Mock Author", CreatedAt: "2026-01-02 09:40 UTC", Body: "It mirrors what `net/http` does for static files, but happy to split it."},
+				},
+			},
+			{
+				ID:         "mock-thread-2",
+				Resolvable: true,
+				Resolved:   true,
+				ResolvedBy: "Mock Author",
+				Path:       "internal/server/legacy.go",
+				OldLine:    3,
+				Notes: []tui.IssueComment{
+					{Author: "Mock Reviewer", CreatedAt: "2026-01-02 09:20 UTC", Body: "Is anything still importing this?"},
+					{Author: "Mock Author", CreatedAt: "2026-01-02 09:45 UTC", Body: "No, removed the last caller in !12."},
+				},
+			},
+			{
+				ID:    "mock-thread-3",
+				Notes: []tui.IssueComment{{Author: "Mock Assignee", CreatedAt: "2026-01-02 10:05 UTC", Body: "Deployed to **staging**, smoke tests pass."}},
+			},
+		},
+		Activities: []tui.IssueActivity{
+			{Actor: "Mock Author", CreatedAt: "2026-01-02 09:50 UTC", Action: "marked this merge request as ready"},
+			{Actor: "Mock Author", CreatedAt: "2026-01-02 09:30 UTC", Action: "added 1 commit"},
+			{Actor: "Mock Author", CreatedAt: "2026-01-01 10:00 UTC", Action: "requested review from @mock.reviewer"},
+		},
+		Approvals: tui.MergeRequestApprovals{
+			Required:       2,
+			Left:           1,
+			ApprovedBy:     []string{"Mock Reviewer"},
+			UserCanApprove: true,
+			Rules: []tui.MergeRequestApprovalRule{
+				{Name: "Backend", Required: 1, Approved: true, ApprovedBy: []string{"Mock Reviewer"}},
+				{Name: "Security", Required: 1},
+			},
+		},
+	}, nil
+}
+
+func (p *MockProvider) LoadMergeRequestDiffs(_ context.Context, mergeRequestIID int64) ([]tui.MergeRequestDiffFile, error) {
+	if mergeRequestIID <= 0 {
+		return nil, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	return []tui.MergeRequestDiffFile{
+		{
+			OldPath: "internal/server/handler.go",
+			NewPath: "internal/server/handler.go",
+			Diff: "@@ -12,9 +12,12 @@ import (\n" +
+				" \n" +
+				" func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {\n" +
+				"-\tif r.Method != http.MethodGet {\n" +
+				"-\t\thttp.Error(w, \"method not allowed\", http.StatusMethodNotAllowed)\n" +
+				"+\tswitch r.Method {\n" +
+				"+\tcase http.MethodGet, http.MethodHead:\n" +
+				"+\tdefault:\n" +
+				"+\t\thttp.Error(w, \"method not allowed\", http.StatusMethodNotAllowed)\n" +
+				" \t\treturn\n" +
+				" \t}\n" +
+				"+\t// Health checks must not hit the database.\n" +
+				" \th.serve(w, r)\n" +
+				" }\n",
+		},
+		{
+			OldPath: "docs/handler.md",
+			NewPath: "docs/handler.md",
+			NewFile: true,
+			Diff: "@@ -0,0 +1,4 @@\n" +
+				"+# Handler\n" +
+				"+\n" +
+				"+The handler accepts `GET` and `HEAD` requests.\n" +
+				"+Other methods return **405**.\n",
+		},
+		{
+			OldPath:     "internal/server/legacy.go",
+			NewPath:     "internal/server/legacy.go",
+			DeletedFile: true,
+			Diff: "@@ -1,5 +0,0 @@\n" +
+				"-package server\n" +
+				"-\n" +
+				"-func legacyHandler() {\n" +
+				"-\tpanic(\"unused\")\n" +
+				"-}\n",
+		},
+	}, nil
+}
+
+func (p *MockProvider) CreateIssueNote(_ context.Context, issueIID int64, body string) error {
+	if issueIID <= 0 {
+		return fmt.Errorf("invalid issue IID: %d", issueIID)
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("comment is empty")
+	}
+	return nil
+}
+
+func (p *MockProvider) CreateMergeRequestNote(_ context.Context, mergeRequestIID int64, body string) error {
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("comment is empty")
+	}
+	return nil
+}
+
+func (p *MockProvider) AddDiscussionNote(_ context.Context, mergeRequestIID int64, discussionID string, body string) error {
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	if discussionID == "" {
+		return fmt.Errorf("no discussion selected")
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("reply is empty")
+	}
+	return nil
+}
+
+func (p *MockProvider) LoadIssueFormOptions(context.Context) (tui.IssueFormOptions, error) {
+	return tui.IssueFormOptions{
+		Labels: []tui.ProjectLabel{
+			{Name: "bug", Color: "#d9534f", Description: "Something is broken"},
+			{Name: "feature", Color: "#428bca", Description: "New functionality"},
+			{Name: "mock", Color: "#8e44ad"},
+			{Name: "ui", Color: "#5cb85c", Description: "Terminal user interface"},
+			{Name: "priority::high", Color: "#f0ad4e"},
+		},
+		Members:    mockMembers,
+		Milestones: mockMilestones,
+	}, nil
+}
+
+var mockMembers = []tui.ProjectMember{
+	{ID: 1, Username: "mock.author", Name: "Mock Author"},
+	{ID: 2, Username: "mock.assignee", Name: "Mock Assignee"},
+	{ID: 3, Username: "mock.reviewer", Name: "Mock Reviewer"},
+	{ID: 4, Username: "mock-user", Name: "Mock User"},
+}
+
+var mockMilestones = []tui.ProjectMilestone{
+	{ID: 11, Title: "v1.0", DueDate: "2026-03-31"},
+	{ID: 12, Title: "v1.1"},
+}
+
+// UpdateIssue applies the update to the generated mock issue so the optimistic
+// state in the dashboard is confirmed rather than reset.
+func (p *MockProvider) UpdateIssue(_ context.Context, issueIID int64, update tui.IssueUpdate) (tui.ListItem, error) {
+	if issueIID <= 3000 || issueIID > 3120 {
+		return tui.ListItem{}, fmt.Errorf("issue #%d not found", issueIID)
+	}
+	item := mockIssueItem(int(issueIID - 3000))
+	details := item.Issue
+	switch update.StateEvent {
+	case "close":
+		details.State = "closed"
+	case "reopen":
+		details.State = "opened"
+	}
+	item.Subtitle = fmt.Sprintf("#%d • %s", details.IID, details.State)
+	if update.AssigneeIDs != nil {
+		details.AssigneeIDs = *update.AssigneeIDs
+		details.Assignees = nil
+		for _, id := range details.AssigneeIDs {
+			for _, member := range mockMembers {
+				if member.ID == id {
+					details.Assignees = append(details.Assignees, member.Name)
+				}
+			}
+		}
+	}
+	for _, label := range update.AddLabels {
+		if !slices.Contains(details.Labels, label) {
+			details.Labels = append(details.Labels, label)
+		}
+	}
+	details.Labels = slices.DeleteFunc(details.Labels, func(label string) bool {
+		return slices.Contains(update.RemoveLabels, label)
+	})
+	if update.MilestoneID != nil {
+		details.Milestone, details.MilestoneID = "", 0
+		for _, milestone := range mockMilestones {
+			if milestone.ID == *update.MilestoneID {
+				details.Milestone, details.MilestoneID = milestone.Title, milestone.ID
+			}
+		}
+	}
+	if update.Confidential != nil {
+		details.Confidential = *update.Confidential
+	}
+	return item, nil
+}
+
+func (p *MockProvider) ApproveMergeRequest(_ context.Context, mergeRequestIID int64) error {
+	_, err := mockMergeRequestIndex(mergeRequestIID)
+	return err
+}
+
+func (p *MockProvider) UnapproveMergeRequest(_ context.Context, mergeRequestIID int64) error {
+	_, err := mockMergeRequestIndex(mergeRequestIID)
+	return err
+}
+
+func (p *MockProvider) MergeMergeRequest(_ context.Context, mergeRequestIID int64, opts tui.MergeOptions) (tui.ListItem, error) {
+	i, err := mockMergeRequestIndex(mergeRequestIID)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	item := mockMergeRequestItem(i)
+	details := item.MergeRequest
+	if details.State != "opened" {
+		return tui.ListItem{}, fmt.Errorf("merge request !%d is %s", mergeRequestIID, details.State)
+	}
+	details.Squash = opts.Squash
+	details.RemoveSourceBranch = opts.RemoveSourceBranch
+	if opts.AutoMerge {
+		details.AutoMerge = true
+		return item, nil
+	}
+	details.State, details.MergeStatus = "merged", "not_open"
+	item.Subtitle = fmt.Sprintf("!%d • %s", details.IID, details.State)
+	return item, nil
+}
+
+func (p *MockProvider) RebaseMergeRequest(_ context.Context, mergeRequestIID int64) error {
+	_, err := mockMergeRequestIndex(mergeRequestIID)
+	return err
+}
+
+func (p *MockProvider) SetMergeRequestState(_ context.Context, mergeRequestIID int64, stateEvent string) (tui.ListItem, error) {
+	i, err := mockMergeRequestIndex(mergeRequestIID)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	item := mockMergeRequestItem(i)
+	details := item.MergeRequest
+	switch stateEvent {
+	case "close":
+		details.State, details.MergeStatus = "closed", "not_open"
+	case "reopen":
+		details.State, details.MergeStatus = "opened", "mergeable"
+	}
+	item.Subtitle = fmt.Sprintf("!%d • %s", details.IID, details.State)
+	return item, nil
+}
+
+func (p *MockProvider) CheckoutMergeRequest(_ context.Context, mergeRequestIID int64, sourceBranch string) (string, error) {
+	if _, err := mockMergeRequestIndex(mergeRequestIID); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("$ git fetch origin refs/merge-requests/%d/head\nFrom mock.gitlab.local:mock/group/project\n * branch            refs/merge-requests/%d/head -> FETCH_HEAD\n$ git checkout -b %s FETCH_HEAD\nSwitched to a new branch '%s'\n", mergeRequestIID, mergeRequestIID, sourceBranch, sourceBranch), nil
+}
+
+func (p *MockProvider) LoadMergeRequestFormOptions(context.Context) (tui.MergeRequestFormOptions, error) {
+	return tui.MergeRequestFormOptions{
+		Branch: tui.LocalBranch{
+			Name: "feature/mock-local",
+			Commits: []string{
+				"Add mock login form\n\nRenders the form with the shared input styles.",
+				"Validate mock passwords",
+			},
+		},
+		DefaultBranch:  "main",
+		TargetBranches: []string{"main", "develop", "release/1.0"},
+		Template:       "## Checklist\n\n- [ ] Tests added\n- [ ] Changelog updated",
+	}, nil
+}
+
+func (p *MockProvider) PushBranch(_ context.Context, branch string) (string, error) {
+	return fmt.Sprintf("$ git push --set-upstream origin %s\nTo mock.gitlab.local:mock/group/project.git\n * [new branch]      %s -> %s\nbranch '%s' set up to track 'origin/%s'.\n", branch, branch, branch, branch, branch), nil
+}
+
+func (p *MockProvider) CreateMergeRequest(_ context.Context, mr tui.NewMergeRequest) (tui.ListItem, error) {
+	title := strings.TrimSpace(mr.Title)
+	if title == "" {
+		return tui.ListItem{}, fmt.Errorf("merge request title is required")
+	}
+	if mr.Draft {
+		title = "Draft: " + title
+	}
+	const iid = 6021
+	url := fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/merge_requests/%d", iid)
+	return tui.ListItem{
+		ID:       5021,
+		Title:    title,
+		Subtitle: fmt.Sprintf("!%d • opened", iid),
+		URL:      url,
+		MergeRequest: &tui.MergeRequestDetails{
+			IID:          iid,
+			State:        "opened",
+			Author:       "Mock Author",
+			SourceBranch: mr.SourceBranch,
+			TargetBranch: mr.TargetBranch,
+			CreatedAt:    "2026-01-03 09:00 UTC",
+			UpdatedAt:    "2026-01-03 09:00 UTC",
+			URL:          url,
+			Description:  mr.Description,
+			Draft:        mr.Draft,
+			MergeStatus:  "checking",
+			Squash:       mr.Squash,
+		},
+	}, nil
+}
+
+func (p *MockProvider) CreateIssueBranch(ctx context.Context, req tui.IssueBranchRequest) (tui.IssueBranchResult, error) {
+	if req.IssueIID <= 0 {
+		return tui.IssueBranchResult{}, fmt.Errorf("invalid issue IID: %d", req.IssueIID)
+	}
+	result := tui.IssueBranchResult{
+		Branch: req.Branch,
+		Output: fmt.Sprintf("$ git fetch origin %s\nFrom mock.gitlab.local:mock/group/project\n * branch            %s -> FETCH_HEAD\n$ git checkout -b %s --track origin/%s\nSwitched to a new branch '%s'\n", req.Branch, req.Branch, req.Branch, req.Branch, req.Branch),
+	}
+	if req.DraftMergeRequest {
+		item, err := p.CreateMergeRequest(ctx, tui.NewMergeRequest{
+			SourceBranch: req.Branch,
+			TargetBranch: "main",
+			Title:        fmt.Sprintf("Resolve \"%s\"", req.IssueTitle),
+			Description:  fmt.Sprintf("Closes #%d", req.IssueIID),
+			Draft:        true,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.MergeRequest = &item
+	}
+	return result, nil
+}
+
+var mockTodos = []struct {
+	action      tui.TodoAction
+	targetType  tui.TodoTargetType
+	index       int
+	projectPath string
+	author      string
+}{
+	{tui.TodoActionReviewRequested, tui.TodoTargetMergeRequest, 3, "mock/group/project", "Mock Author"},
+	{tui.TodoActionMentioned, tui.TodoTargetIssue, 7, "mock/group/project", "Mock Reviewer"},
+	{tui.TodoActionAssigned, tui.TodoTargetIssue, 12, "mock/group/other", "Mock Author"},
+	{tui.TodoActionBuildFailed, tui.TodoTargetMergeRequest, 5, "mock/group/other", "Mock User"},
+	{tui.TodoActionMentioned, tui.TodoTargetMergeRequest, 9, "mock/group/project", "Mock Reviewer"},
+	{tui.TodoActionAssigned, tui.TodoTargetIssue, 2, "mock/group/project", "Mock Author"},
+}
+
+func (p *MockProvider) LoadTodos(_ context.Context, query tui.TodoQuery) (tui.TodoResult, error) {
+	items := make([]tui.ListItem, 0, len(mockTodos))
+	for i, todo := range mockTodos {
+		if query.Action != tui.TodoActionAll && todo.action != query.Action {
+			continue
+		}
+		target := mockIssueItem(todo.index)
+		iid, reference := target.Issue.IID, fmt.Sprintf("#%d", target.Issue.IID)
+		state := target.Issue.State
+		if todo.targetType == tui.TodoTargetMergeRequest {
+			target = mockMergeRequestItem(todo.index)
+			iid, reference = target.MergeRequest.IID, fmt.Sprintf("!%d", target.MergeRequest.IID)
+			state = target.MergeRequest.State
+		}
+		id := int64(9000 + i)
+		items = append(items, tui.ListItem{
+			ID:       id,
+			Title:    target.Title,
+			Subtitle: fmt.Sprintf("%s%s • %s", todo.projectPath, reference, todo.action),
+			URL:      target.URL,
+			Todo: &tui.TodoDetails{
+				ID:          id,
+				Action:      todo.action,
+				TargetType:  todo.targetType,
+				TargetIID:   iid,
+				TargetState: state,
+				ProjectPath: todo.projectPath,
+				Author:      todo.author,
+				Body:        "Mock todo body referencing " + reference,
+				CreatedAt:   "2026-01-03 09:00 UTC",
+			},
+		})
+	}
+	return tui.TodoResult{Items: items}, nil
+}
+
+func (p *MockProvider) MarkTodoDone(_ context.Context, todoID int64) error {
+	if todoID < 9000 || todoID >= 9000+int64(len(mockTodos)) {
+		return fmt.Errorf("todo %d not found", todoID)
+	}
+	return nil
+}
+
+func (p *MockProvider) MarkAllTodosDone(context.Context) error {
+	return nil
+}
+
+func (p *MockProvider) LoadIssue(_ context.Context, issueIID int64) (tui.ListItem, error) {
+	if issueIID <= 3000 || issueIID > 3120 {
+		return tui.ListItem{}, fmt.Errorf("issue #%d not found", issueIID)
+	}
+	return mockIssueItem(int(issueIID - 3000)), nil
+}
+
+func (p *MockProvider) LoadMergeRequest(_ context.Context, mergeRequestIID int64) (tui.ListItem, error) {
+	i, err := mockMergeRequestIndex(mergeRequestIID)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	return mockMergeRequestItem(i), nil
+}
+
+var mockMyWorkProjects = []string{"mock/group/project", "mock/group/other", "mock/platform/api"}
+
+const mockHost = "https://mock.gitlab.local/api/v4"
+
+func mockDashboardContext(projectPath string, settings *mockSettings) tui.DashboardContext {
+	return tui.DashboardContext{
+		ProjectPath: projectPath,
+		Connection:  "Connected as mock-user",
+		Host:        mockHost,
+		User:        tui.CurrentUser{ID: 4, Username: "mock-user", Name: "Mock User"},
+		Switcher:    mockProjectSwitcher{settings: settings},
+		Filters:     settings,
+		Sorts:       settings,
+		Views:       settings,
+	}
+}
+
+// mockSettings keeps saved issue filters, list sorts and saved views for the
+// session only.
+type mockSettings struct {
+	mu      sync.Mutex
+	filters []tui.SavedFilter
+	sorts   map[tui.ViewMode]tui.ListSort
+	views   []tui.SavedView
+}
+
+func (s *mockSettings) IssueFilters() []tui.SavedFilter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.filters)
+}
+
+func (s *mockSettings) SaveIssueFilter(filter tui.SavedFilter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filters = slices.DeleteFunc(s.filters, func(saved tui.SavedFilter) bool {
+		return strings.EqualFold(saved.Name, filter.Name)
+	})
+	s.filters = append(s.filters, filter)
+	return nil
+}
+
+func (s *mockSettings) SavedViews() []tui.SavedView {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.views)
+}
+
+func (s *mockSettings) SaveView(view tui.SavedView) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	index := slices.IndexFunc(s.views, func(saved tui.SavedView) bool {
+		return strings.EqualFold(saved.Name, view.Name)
+	})
+	if index >= 0 {
+		s.views[index] = view
+		return nil
+	}
+	s.views = append(s.views, view)
+	return nil
+}
+
+func (s *mockSettings) ListSort(view tui.ViewMode) (tui.ListSort, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sort, ok := s.sorts[view]
+	return sort, ok
+}
+
+func (s *mockSettings) SaveListSort(view tui.ViewMode, sort tui.ListSort) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sorts == nil {
+		s.sorts = make(map[tui.ViewMode]tui.ListSort)
+	}
+	s.sorts[view] = sort
+	return nil
+}
+
+// mockProjectSwitcher switches between the mock projects, all served by the
+// same mock provider.
+type mockProjectSwitcher struct {
+	settings *mockSettings
+}
+
+func (mockProjectSwitcher) LoadProjectOptions(context.Context) ([]tui.ProjectOption, error) {
+	options := make([]tui.ProjectOption, 0, len(mockMyWorkProjects))
+	for _, path := range mockMyWorkProjects {
+		options = append(options, tui.ProjectOption{Host: mockHost, HostLabel: "mock.gitlab.local (/api/v4)", Path: path})
+	}
+	return options, nil
+}
+
+func (s mockProjectSwitcher) SwitchProject(_ context.Context, option tui.ProjectOption) (tui.DataProvider, tui.DashboardContext, error) {
+	return NewMockProvider(), mockDashboardContext(option.Path, s.settings), nil
+}
+
+func (mockProjectSwitcher) ToggleFavorite(_ context.Context, option tui.ProjectOption) (bool, error) {
+	return !option.Favorite, nil
+}
+
+// LoadGroup builds the group tree from the mock project paths.
+func (p *MockProvider) LoadGroup(_ context.Context, query tui.GroupQuery) (tui.GroupResult, error) {
+	var projects []string
+	for _, projectPath := range mockMyWorkProjects {
+		if query.Path == "" || strings.HasPrefix(projectPath, query.Path+"/") {
+			projects = append(projects, projectPath)
+		}
+	}
+	if query.Path != "" && len(projects) == 0 {
+		return tui.GroupResult{}, fmt.Errorf("group %q not found", query.Path)
+	}
+
+	items := make([]tui.ListItem, 0, len(projects))
+	switch query.Scope {
+	case tui.GroupContents:
+		seen := make(map[string]bool)
+		var children []tui.ListItem
+		for i, projectPath := range projects {
+			rest := strings.TrimPrefix(projectPath, query.Path+"/")
+			if query.Path == "" {
+				rest = projectPath
+			}
+			name, _, nested := strings.Cut(rest, "/")
+			fullPath := strings.TrimPrefix(query.Path+"/"+name, "/")
+			if !nested {
+				children = append(children, tui.ListItem{
+					ID:    int64(7000 + i),
+					Title: name,
+					Group: &tui.GroupEntry{Kind: tui.GroupEntryProject, FullPath: fullPath, Description: "Mock project"},
+				})
+				continue
+			}
+			if seen[fullPath] {
+				continue
+			}
+			seen[fullPath] = true
+			items = append(items, tui.ListItem{
+				ID:    int64(7100 + len(seen)),
+				Title: name,
+				Group: &tui.GroupEntry{Kind: tui.GroupEntrySubgroup, FullPath: fullPath, Description: "Mock group"},
+			})
+		}
+		items = append(items, children...)
+	case tui.GroupIssues:
+		for i, projectPath := range projects {
+			item := mockIssueItem(i*4 + 1)
+			item.ProjectPath = projectPath
+			item.Subtitle = fmt.Sprintf("%s#%d • %s", projectPath, item.Issue.IID, item.Issue.State)
+			items = append(items, item)
+		}
+	case tui.GroupMergeRequests:
+		for i, projectPath := range projects {
+			item := mockMergeRequestItem(i + 1)
+			details := item.MergeRequest
+			item.ProjectPath = projectPath
+			item.Subtitle = fmt.Sprintf("%s!%d • %s • %s → %s", projectPath, details.IID, details.Author, details.SourceBranch, details.TargetBranch)
+			items = append(items, item)
+		}
+	default:
+		return tui.GroupResult{}, fmt.Errorf("unknown group list %q", query.Scope)
+	}
+	return tui.GroupResult{Items: items}, nil
+}
+
+func (p *MockProvider) LoadMyWork(_ context.Context, query tui.MyWorkQuery) (tui.MyWorkResult, error) {
+	items := make([]tui.ListItem, 0, 6)
+	for i := 1; i <= 6; i++ {
+		projectPath := mockMyWorkProjects[i%len(mockMyWorkProjects)]
+		var item tui.ListItem
+		switch query.Scope {
+		case tui.MyWorkAssignedIssues:
+			item = mockIssueItem(i * 4)
+			item.Subtitle = fmt.Sprintf("%s#%d • %s", projectPath, item.Issue.IID, item.Issue.State)
+		case tui.MyWorkAuthoredMergeRequests, tui.MyWorkReviewRequests:
+			index := i
+			if query.Scope == tui.MyWorkReviewRequests {
+				index = i + 10
+			}
+			item = mockMergeRequestItem(index)
+			details := item.MergeRequest
+			item.Subtitle = fmt.Sprintf("%s!%d • %s • %s → %s", projectPath, details.IID, details.Author, details.SourceBranch, details.TargetBranch)
+		default:
+			return tui.MyWorkResult{}, fmt.Errorf("unknown my work list %q", query.Scope)
+		}
+		item.ProjectPath = projectPath
+		items = append(items, item)
+	}
+	return tui.MyWorkResult{Items: items}, nil
+}
+
+func (p *MockProvider) CreateIssue(_ context.Context, issue tui.NewIssue) (tui.ListItem, error) {
+	title := strings.TrimSpace(issue.Title)
+	if title == "" {
+		return tui.ListItem{}, fmt.Errorf("issue title is required")
+	}
+	const iid = 3121
+	url := fmt.Sprintf("https://mock.gitlab.local/mock/group/project/-/issues/%d", iid)
+	return tui.ListItem{
+		ID:       2121,
+		Title:    title,
+		Subtitle: fmt.Sprintf("#%d • opened", iid),
+		URL:      url,
+		Issue: &tui.IssueDetails{
+			IID:         iid,
+			State:       "opened",
+			Author:      "Mock Author",
+			Labels:      issue.Labels,
+			CreatedAt:   "2026-01-03 09:00 UTC",
+			UpdatedAt:   "2026-01-03 09:00 UTC",
+			URL:         url,
+			Description: issue.Description,
+		},
+	}, nil
+}
+
+// mockMilestoneItems are the milestones of every mock project, the active
+// project ones matching mockMilestones.
+var mockMilestoneItems = []tui.ListItem{
+	{
+		ID:       11,
+		Title:    "v1.0",
+		Subtitle: "active • due 2026-03-31",
+		URL:      "https://mock.gitlab.local/mock/group/project/-/milestones/1",
+		Milestone: &tui.MilestoneDetails{
+			ID: 11, IID: 1, State: "active", StartDate: "2026-03-02", DueDate: "2026-03-31", DaysLeft: 12,
+			OpenIssues: 4, ClosedIssues: 6, TotalWeight: 21, ClosedWeight: 13,
+			URL: "https://mock.gitlab.local/mock/group/project/-/milestones/1",
+		},
+	},
+	{
+		ID:       14,
+		Title:    "Platform hardening",
+		Subtitle: "active • due 2026-03-20",
+		URL:      "https://mock.gitlab.local/groups/mock/-/milestones/3",
+		Milestone: &tui.MilestoneDetails{
+			ID: 14, IID: 3, GroupID: 40, State: "active", DueDate: "2026-03-20", DaysLeft: -3,
+			OpenIssues: 2, ClosedIssues: 3, TotalWeight: 8, ClosedWeight: 5,
+			URL: "https://mock.gitlab.local/groups/mock/-/milestones/3",
+		},
+	},
+	{
+		ID:       12,
+		Title:    "v1.1",
+		Subtitle: "active • due -",
+		URL:      "https://mock.gitlab.local/mock/group/project/-/milestones/2",
+		Milestone: &tui.MilestoneDetails{
+			ID: 12, IID: 2, State: "active", OpenIssues: 5,
+			URL: "https://mock.gitlab.local/mock/group/project/-/milestones/2",
+		},
+	},
+	{
+		ID:       10,
+		Title:    "v0.9",
+		Subtitle: "closed • due 2026-01-31",
+		URL:      "https://mock.gitlab.local/mock/group/project/-/milestones/0",
+		Milestone: &tui.MilestoneDetails{
+			ID: 10, State: "closed", DueDate: "2026-01-31", DaysLeft: -47,
+			ClosedIssues: 6, TotalWeight: 10, ClosedWeight: 10,
+			URL: "https://mock.gitlab.local/mock/group/project/-/milestones/0",
+		},
+	},
+}
+
+// LoadMilestones lists mockMilestoneItems, and six generated issues or merge
+// requests for the open milestone.
+func (p *MockProvider) LoadMilestones(_ context.Context, query tui.MilestoneQuery) (tui.MilestoneResult, error) {
+	if query.Milestone == nil {
+		state := cmp.Or(query.State, tui.MilestoneStateActive)
+		var items []tui.ListItem
+		for _, item := range mockMilestoneItems {
+			if item.Milestone.State == string(state) {
+				items = append(items, item)
+			}
+		}
+		return tui.MilestoneResult{Items: items}, nil
+	}
+
+	first := int(query.Milestone.ID-10) * 6
+	items := make([]tui.ListItem, 0, 6)
+	for i := first + 1; i <= first+6; i++ {
+		switch query.Scope {
+		case tui.MilestoneIssues:
+			item := mockIssueItem(i)
+			item.Issue.MilestoneID = query.Milestone.ID
+			items = append(items, item)
+		case tui.MilestoneMergeRequests:
+			items = append(items, mockMergeRequestItem(i))
+		default:
+			return tui.MilestoneResult{}, fmt.Errorf("unknown milestone list %q", query.Scope)
+		}
+	}
+	return tui.MilestoneResult{Items: items}, nil
+}
+
+var mockBoards = []tui.IssueBoard{{ID: 1, Name: "Development"}, {ID: 2, Name: "Release"}}
+
+// mockBoardLabels are the label lists of each mock board, in board order.
+var mockBoardLabels = map[int64][]string{
+	1: {"To Do", "Doing", "Review"},
+	2: {"Review"},
+}
+
+// LoadBoard spreads generated issues over the lists of a mock board, giving
+// the open ones "To Do", "Doing", "Review" or no workflow label in turn.
+func (p *MockProvider) LoadBoard(_ context.Context, query tui.BoardQuery) (tui.BoardResult, error) {
+	result := tui.BoardResult{Boards: mockBoards, Board: mockBoards[0]}
+	for _, board := range mockBoards {
+		if board.ID == query.BoardID {
+			result.Board = board
+		}
+	}
+	result.Columns = append(result.Columns, tui.BoardColumn{Kind: tui.BoardListOpen, Title: "Open"})
+	for _, label := range mockBoardLabels[result.Board.ID] {
+		result.Columns = append(result.Columns, tui.BoardColumn{Kind: tui.BoardListLabel, Title: label, Label: label})
+	}
+	result.Columns = append(result.Columns, tui.BoardColumn{Kind: tui.BoardListClosed, Title: "Closed"})
+
+	workflow := []string{"", "To Do", "Doing", "Review"}
+	for i := 1; i <= 24; i++ {
+		item := mockIssueItem(i)
+		if label := workflow[i%len(workflow)]; label != "" {
+			item.Issue.Labels = append(item.Issue.Labels, label)
+		}
+		column := 0
+		if item.Issue.State == "closed" {
+			column = len(result.Columns) - 1
+		} else {
+			for j, candidate := range result.Columns {
+				if candidate.Kind == tui.BoardListLabel && slices.Contains(item.Issue.Labels, candidate.Label) {
+					column = j
+				}
+			}
+		}
+		result.Columns[column].Items = append(result.Columns[column].Items, item)
+	}
+	return result, nil
+}
+
+// mockEpics are the epics of the mock group; parent is the IID of the parent
+// epic and issues are the mock issues linked to each one.
+var mockEpics = []struct {
+	iid    int64
+	parent int64
+	title  string
+	state  string
+	issues []int
+}{
+	{iid: 1, title: "Checkout revamp", state: "opened", issues: []int{1, 2}},
+	{iid: 2, parent: 1, title: "Payment providers", state: "opened", issues: []int{3, 4}},
+	{iid: 3, parent: 1, title: "Cart persistence", state: "closed", issues: []int{5}},
+	{iid: 4, title: "Onboarding", state: "opened"},
+}
+
+func mockEpicItem(index int) tui.ListItem {
+	epic := mockEpics[index]
+	reference := fmt.Sprintf("mock/group&%d", epic.iid)
+	url := fmt.Sprintf("https://mock.gitlab.local/groups/mock/group/-/epics/%d", epic.iid)
+	return tui.ListItem{
+		ID:       500 + epic.iid,
+		Title:    epic.title,
+		Subtitle: reference + " • " + epic.state,
+		URL:      url,
+		Issue: &tui.IssueDetails{
+			State:       epic.state,
+			Author:      "Mock Author",
+			Labels:      []string{"mock", "epic"},
+			CreatedAt:   "2026-01-01 10:00 UTC",
+			UpdatedAt:   "2026-01-02 11:00 UTC",
+			URL:         url,
+			Description: "Mock epic description grouping the work on " + strings.ToLower(epic.title) + ".",
+		},
+		Epic: &tui.EpicDetails{ID: 500 + epic.iid, IID: epic.iid, GroupID: 2, Reference: reference},
+	}
+}
+
+func (p *MockProvider) LoadEpics(_ context.Context, query tui.EpicQuery) (tui.EpicResult, error) {
+	var items []tui.ListItem
+	for i, epic := range mockEpics {
+		if query.State == tui.EpicStateAll || epic.state == string(cmp.Or(query.State, tui.EpicStateOpened)) {
+			items = append(items, mockEpicItem(i))
+		}
+	}
+	return tui.EpicResult{Items: items}, nil
+}
+
+func (p *MockProvider) LoadEpicChildren(_ context.Context, parent tui.EpicDetails) ([]tui.ListItem, error) {
+	var items []tui.ListItem
+	for i, epic := range mockEpics {
+		if epic.parent == parent.IID {
+			items = append(items, mockEpicItem(i))
+		}
+	}
+	for _, epic := range mockEpics {
+		if epic.iid != parent.IID {
+			continue
+		}
+		for _, i := range epic.issues {
+			item := mockIssueItem(i)
+			item.ProjectPath = "mock/group/project"
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (p *MockProvider) LoadEpicDetailData(_ context.Context, epic tui.EpicDetails) (tui.IssueDetailData, error) {
+	return tui.IssueDetailData{
+		Activities: []tui.IssueActivity{
+			{Actor: "Mock Author", CreatedAt: "2026-01-02 11:30 UTC", Action: fmt.Sprintf("added epic mock/group&%d as child epic", epic.IID+1)},
+		},
+		Comments: []tui.IssueComment{
+			{Author: "Mock Reviewer", CreatedAt: "2026-01-02 11:10 UTC", Body: "Let's split the **remaining work** into child epics."},
+		},
+	}, nil
+}
@@ -1,16 +1,28 @@
 package app
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	gl "gitlab.com/gitlab-org/api/client-go"
+
 	"github.com/davzucky/lazygitlab/internal/gitlab"
+	"github.com/davzucky/lazygitlab/internal/project"
 	"github.com/davzucky/lazygitlab/internal/tui"
 )
 
+const (
+	pipelineDetailConcurrency = 4
+	milestoneIssueConcurrency = 4
+	boardListConcurrency      = 4
+)
+
 type Provider struct {
 	client      gitlab.Client
 	projectPath string
@@ -20,6 +32,10 @@ func NewProvider(client gitlab.Client, projectPath string) *Provider {
 	return &Provider{client: client, projectPath: projectPath}
 }
 
+func (p *Provider) ForProject(projectPath string) tui.DataProvider {
+	return NewProvider(p.client, projectPath)
+}
+
 func (p *Provider) LoadProjects(ctx context.Context) ([]tui.ListItem, error) {
 	projects, err := p.client.ListProjects(ctx, "")
 	if err != nil {
@@ -44,11 +60,28 @@ func (p *Provider) LoadIssues(ctx context.Context, query tui.IssueQuery) (tui.Is
 		return tui.IssueResult{}, fmt.Errorf("no project context selected")
 	}
 
+	filter := query.Filter
+	dueDate := filter.Due
+	if dueDate == "none" {
+		dueDate = "0"
+	}
 	issues, hasNextPage, err := p.client.ListIssues(ctx, p.projectPath, gitlab.IssueListOptions{
-		State:   string(query.State),
-		Search:  query.Search,
-		Page:    int64(query.Page),
-		PerPage: query.PerPage,
+		State:               string(query.State),
+		Search:              query.Search,
+		Labels:              filter.Labels,
+		NotLabels:           filter.NotLabels,
+		AssigneeUsername:    filter.Assignee,
+		NotAssigneeUsername: filter.NotAssignee,
+		AuthorUsername:      filter.Author,
+		NotAuthorUsername:   filter.NotAuthor,
+		Milestone:           filterKeyword(filter.Milestone),
+		NotMilestone:        filterKeyword(filter.NotMilestone),
+		Weight:              filterKeyword(filter.Weight),
+		DueDate:             dueDate,
+		OrderBy:             string(query.Sort.Field),
+		Sort:                sortDirection(query.Sort),
+		Page:                int64(query.Page),
+		PerPage:             query.PerPage,
 	})
 	if err != nil {
 		return tui.IssueResult{}, err
@@ -56,45 +89,189 @@ func (p *Provider) LoadIssues(ctx context.Context, query tui.IssueQuery) (tui.Is
 
 	items := make([]tui.ListItem, 0, len(issues))
 	for _, issue := range issues {
-		subtitle := fmt.Sprintf("#%d • %s", issue.IID, issue.State)
-		author := "-"
-		if issue.Author != nil {
-			author = displayName(issue.Author.Name, issue.Author.Username)
+		if issue == nil {
+			continue
 		}
-		assignees := make([]string, 0, len(issue.Assignees))
-		for _, assignee := range issue.Assignees {
-			if assignee == nil {
-				continue
-			}
-			assignees = append(assignees, displayName(assignee.Name, assignee.Username))
+		items = append(items, issueListItem(issue))
+	}
+
+	return tui.IssueResult{Items: items, HasNextPage: hasNextPage}, nil
+}
+
+// sortDirection is the GitLab sort value of a list sort.
+func sortDirection(sort tui.ListSort) string {
+	if sort.Ascending {
+		return "asc"
+	}
+	return "desc"
+}
+
+// filterKeyword spells the "none" and "any" of a filter value the way the
+// GitLab API expects them; other values are passed as they are.
+func filterKeyword(value string) string {
+	switch strings.ToLower(value) {
+	case "none":
+		return "None"
+	case "any":
+		return "Any"
+	}
+	return value
+}
+
+func issueListItem(issue *gl.Issue) tui.ListItem {
+	author := "-"
+	if issue.Author != nil {
+		author = displayName(issue.Author.Name, issue.Author.Username)
+	}
+	assignees := make([]string, 0, len(issue.Assignees))
+	assigneeIDs := make([]int64, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		if assignee == nil {
+			continue
 		}
-		labels := make([]string, 0, len(issue.Labels))
-		for _, label := range issue.Labels {
-			if strings.TrimSpace(label) == "" {
-				continue
-			}
-			labels = append(labels, label)
+		assignees = append(assignees, displayName(assignee.Name, assignee.Username))
+		assigneeIDs = append(assigneeIDs, assignee.ID)
+	}
+	milestone, milestoneID := "", int64(0)
+	if issue.Milestone != nil {
+		milestone, milestoneID = issue.Milestone.Title, issue.Milestone.ID
+	}
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		if strings.TrimSpace(label) == "" {
+			continue
 		}
-		items = append(items, tui.ListItem{
-			ID:       issue.ID,
-			Title:    issue.Title,
-			Subtitle: subtitle,
-			URL:      issue.WebURL,
-			Issue: &tui.IssueDetails{
-				IID:         issue.IID,
-				State:       issue.State,
-				Author:      author,
-				Assignees:   assignees,
-				Labels:      labels,
-				CreatedAt:   formatIssueTime(issue.CreatedAt),
-				UpdatedAt:   formatIssueTime(issue.UpdatedAt),
-				URL:         issue.WebURL,
-				Description: issue.Description,
-			},
-		})
+		labels = append(labels, label)
+	}
+	return tui.ListItem{
+		ID:       issue.ID,
+		Title:    issue.Title,
+		Subtitle: fmt.Sprintf("#%d • %s", issue.IID, issue.State),
+		URL:      issue.WebURL,
+		Issue: &tui.IssueDetails{
+			IID:          issue.IID,
+			State:        issue.State,
+			Author:       author,
+			Assignees:    assignees,
+			Labels:       labels,
+			CreatedAt:    formatIssueTime(issue.CreatedAt),
+			UpdatedAt:    formatIssueTime(issue.UpdatedAt),
+			URL:          issue.WebURL,
+			Description:  issue.Description,
+			AssigneeIDs:  assigneeIDs,
+			Milestone:    milestone,
+			MilestoneID:  milestoneID,
+			Confidential: issue.Confidential,
+		},
 	}
+}
 
-	return tui.IssueResult{Items: items, HasNextPage: hasNextPage}, nil
+func (p *Provider) LoadIssueFormOptions(ctx context.Context) (tui.IssueFormOptions, error) {
+	if p.projectPath == "" {
+		return tui.IssueFormOptions{}, fmt.Errorf("no project context selected")
+	}
+
+	labels, err := p.client.ListLabels(ctx, p.projectPath)
+	if err != nil {
+		return tui.IssueFormOptions{}, fmt.Errorf("load labels: %w", err)
+	}
+	members, err := p.client.ListProjectMembers(ctx, p.projectPath)
+	if err != nil {
+		return tui.IssueFormOptions{}, fmt.Errorf("load project members: %w", err)
+	}
+	milestones, err := p.client.ListMilestones(ctx, p.projectPath, "active")
+	if err != nil {
+		return tui.IssueFormOptions{}, fmt.Errorf("load milestones: %w", err)
+	}
+
+	options := tui.IssueFormOptions{
+		Labels:     make([]tui.ProjectLabel, 0, len(labels)),
+		Members:    make([]tui.ProjectMember, 0, len(members)),
+		Milestones: make([]tui.ProjectMilestone, 0, len(milestones)),
+	}
+	for _, label := range labels {
+		if label == nil {
+			continue
+		}
+		options.Labels = append(options.Labels, tui.ProjectLabel{Name: label.Name, Color: label.Color, Description: label.Description})
+	}
+	for _, member := range members {
+		if member == nil || member.State == "blocked" {
+			continue
+		}
+		options.Members = append(options.Members, tui.ProjectMember{ID: member.ID, Username: member.Username, Name: member.Name})
+	}
+	for _, milestone := range milestones {
+		if milestone == nil {
+			continue
+		}
+		dueDate := ""
+		if milestone.DueDate != nil {
+			dueDate = milestone.DueDate.String()
+		}
+		options.Milestones = append(options.Milestones, tui.ProjectMilestone{ID: milestone.ID, Title: milestone.Title, DueDate: dueDate})
+	}
+	return options, nil
+}
+
+func (p *Provider) CreateIssue(ctx context.Context, issue tui.NewIssue) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	title := strings.TrimSpace(issue.Title)
+	if title == "" {
+		return tui.ListItem{}, fmt.Errorf("issue title is required")
+	}
+
+	opts := gitlab.CreateIssueOptions{
+		Title:        title,
+		Description:  strings.TrimSpace(issue.Description),
+		Labels:       issue.Labels,
+		AssigneeIDs:  issue.AssigneeIDs,
+		MilestoneID:  issue.MilestoneID,
+		Confidential: issue.Confidential,
+	}
+	if dueDate := strings.TrimSpace(issue.DueDate); dueDate != "" {
+		parsed, err := time.Parse("2006-01-02", dueDate)
+		if err != nil {
+			return tui.ListItem{}, fmt.Errorf("invalid due date %q: use YYYY-MM-DD", dueDate)
+		}
+		opts.DueDate = &parsed
+	}
+
+	created, err := p.client.CreateIssue(ctx, p.projectPath, opts)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	if created == nil {
+		return tui.ListItem{}, fmt.Errorf("create issue: empty response")
+	}
+	return issueListItem(created), nil
+}
+
+func (p *Provider) UpdateIssue(ctx context.Context, issueIID int64, update tui.IssueUpdate) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	if issueIID <= 0 {
+		return tui.ListItem{}, fmt.Errorf("invalid issue IID: %d", issueIID)
+	}
+
+	updated, err := p.client.UpdateIssue(ctx, p.projectPath, issueIID, gitlab.UpdateIssueOptions{
+		StateEvent:   update.StateEvent,
+		AssigneeIDs:  update.AssigneeIDs,
+		AddLabels:    update.AddLabels,
+		RemoveLabels: update.RemoveLabels,
+		MilestoneID:  update.MilestoneID,
+		Confidential: update.Confidential,
+	})
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	if updated == nil {
+		return tui.ListItem{}, fmt.Errorf("update issue %d: empty response", issueIID)
+	}
+	return issueListItem(updated), nil
 }
 
 func (p *Provider) LoadMergeRequests(ctx context.Context, query tui.MergeRequestQuery) (tui.MergeRequestResult, error) {
@@ -112,10 +289,27 @@ func (p *Provider) LoadMergeRequests(ctx context.Context, query tui.MergeRequest
 		query.PerPage = 25
 	}
 
+	filter := query.Filter
+	var draft *bool
+	if filter.Draft != "" {
+		draft = gl.Ptr(filter.Draft == "yes")
+	}
 	mrs, hasNextPage, err := p.client.ListMergeRequests(ctx, p.projectPath, gitlab.MergeRequestListOptions{
-		State:   state,
-		Page:    int64(query.Page),
-		PerPage: query.PerPage,
+		State:              state,
+		Search:             query.Search,
+		Labels:             filter.Labels,
+		NotLabels:          filter.NotLabels,
+		AuthorUsername:     filter.Author,
+		NotAuthorUsername:  filter.NotAuthor,
+		AssigneeUsername:   filter.Assignee,
+		ReviewerUsername:   filter.Reviewer,
+		ApprovedByUsername: filter.ApprovedBy,
+		TargetBranch:       filter.Target,
+		Draft:              draft,
+		OrderBy:            string(query.Sort.Field),
+		Sort:               sortDirection(query.Sort),
+		Page:               int64(query.Page),
+		PerPage:            query.PerPage,
 	})
 	if err != nil {
 		return tui.MergeRequestResult{}, err
@@ -123,31 +317,385 @@ func (p *Provider) LoadMergeRequests(ctx context.Context, query tui.MergeRequest
 
 	items := make([]tui.ListItem, 0, len(mrs))
 	for _, mr := range mrs {
-		subtitle := fmt.Sprintf("!%d • %s", mr.IID, mr.State)
+		items = append(items, mergeRequestListItem(mr))
+	}
+
+	return tui.MergeRequestResult{Items: items, HasNextPage: hasNextPage}, nil
+}
+
+func mergeRequestListItem(mr *gl.BasicMergeRequest) tui.ListItem {
+	author := "-"
+	if mr.Author != nil {
+		author = displayName(mr.Author.Name, mr.Author.Username)
+	}
+	return tui.ListItem{
+		ID:       mr.ID,
+		Title:    mr.Title,
+		Subtitle: fmt.Sprintf("!%d • %s", mr.IID, mr.State),
+		URL:      mr.WebURL,
+		MergeRequest: &tui.MergeRequestDetails{
+			IID:                mr.IID,
+			State:              mr.State,
+			Author:             author,
+			SourceBranch:       mr.SourceBranch,
+			TargetBranch:       mr.TargetBranch,
+			FromFork:           mr.SourceProjectID != 0 && mr.SourceProjectID != mr.TargetProjectID,
+			CreatedAt:          formatIssueTime(mr.CreatedAt),
+			UpdatedAt:          formatIssueTime(mr.UpdatedAt),
+			URL:                mr.WebURL,
+			Description:        mr.Description,
+			Draft:              mr.Draft,
+			MergeStatus:        mr.DetailedMergeStatus,
+			HasConflicts:       mr.HasConflicts,
+			Squash:             mr.Squash || mr.SquashOnMerge,
+			RemoveSourceBranch: mr.ForceRemoveSourceBranch,
+			AutoMerge:          mr.MergeWhenPipelineSucceeds,
+		},
+	}
+}
+
+func (p *Provider) ApproveMergeRequest(ctx context.Context, mergeRequestIID int64) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	return p.client.ApproveMergeRequest(ctx, p.projectPath, mergeRequestIID)
+}
+
+func (p *Provider) UnapproveMergeRequest(ctx context.Context, mergeRequestIID int64) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	return p.client.UnapproveMergeRequest(ctx, p.projectPath, mergeRequestIID)
+}
+
+func (p *Provider) MergeMergeRequest(ctx context.Context, mergeRequestIID int64, opts tui.MergeOptions) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return tui.ListItem{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	merged, err := p.client.AcceptMergeRequest(ctx, p.projectPath, mergeRequestIID, gitlab.AcceptMergeRequestOptions{
+		Squash:             opts.Squash,
+		RemoveSourceBranch: opts.RemoveSourceBranch,
+		AutoMerge:          opts.AutoMerge,
+	})
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	if merged == nil {
+		return tui.ListItem{}, fmt.Errorf("merge merge request %d: empty response", mergeRequestIID)
+	}
+	return mergeRequestListItem(&merged.BasicMergeRequest), nil
+}
+
+func (p *Provider) RebaseMergeRequest(ctx context.Context, mergeRequestIID int64) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	return p.client.RebaseMergeRequest(ctx, p.projectPath, mergeRequestIID)
+}
+
+func (p *Provider) SetMergeRequestState(ctx context.Context, mergeRequestIID int64, stateEvent string) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return tui.ListItem{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	updated, err := p.client.UpdateMergeRequestState(ctx, p.projectPath, mergeRequestIID, stateEvent)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	if updated == nil {
+		return tui.ListItem{}, fmt.Errorf("%s merge request %d: empty response", stateEvent, mergeRequestIID)
+	}
+	return mergeRequestListItem(&updated.BasicMergeRequest), nil
+}
+
+// CheckoutMergeRequest checks out a merge request in the git repository the
+// dashboard was started from, after making sure that repository is a clone of
+// the selected project. It returns the git transcript alongside any error.
+func (p *Provider) CheckoutMergeRequest(ctx context.Context, mergeRequestIID int64, sourceBranch string) (string, error) {
+	if p.projectPath == "" {
+		return "", fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return "", fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	if err := p.checkLocalClone(ctx); err != nil {
+		return "", err
+	}
+	return project.CheckoutMergeRequest(ctx, "", mergeRequestIID, sourceBranch)
+}
+
+// LoadMergeRequestFormOptions reads the branch checked out locally and what
+// the new merge request form offers for it: the target branches, the project
+// default description template and the commits the branch adds.
+func (p *Provider) LoadMergeRequestFormOptions(ctx context.Context) (tui.MergeRequestFormOptions, error) {
+	if p.projectPath == "" {
+		return tui.MergeRequestFormOptions{}, fmt.Errorf("no project context selected")
+	}
+	if err := p.checkLocalClone(ctx); err != nil {
+		return tui.MergeRequestFormOptions{}, err
+	}
+	branch, err := project.CurrentBranch(ctx, "")
+	if err != nil {
+		return tui.MergeRequestFormOptions{}, err
+	}
+
+	gitlabProject, err := p.client.GetProject(ctx, p.projectPath)
+	if err != nil {
+		return tui.MergeRequestFormOptions{}, fmt.Errorf("load project: %w", err)
+	}
+	branches, err := p.client.ListBranches(ctx, p.projectPath)
+	if err != nil {
+		return tui.MergeRequestFormOptions{}, fmt.Errorf("load branches: %w", err)
+	}
+	template, err := p.client.GetMergeRequestTemplate(ctx, p.projectPath, "Default")
+	if err != nil {
+		return tui.MergeRequestFormOptions{}, fmt.Errorf("load merge request template: %w", err)
+	}
+
+	options := tui.MergeRequestFormOptions{
+		Branch:         tui.LocalBranch{Name: branch, HasUpstream: project.HasUpstream(ctx, "", branch)},
+		DefaultBranch:  gitlabProject.DefaultBranch,
+		TargetBranches: make([]string, 0, len(branches)),
+		Template:       template,
+	}
+	for _, candidate := range branches {
+		if candidate == nil || candidate.Name == branch {
+			continue
+		}
+		options.TargetBranches = append(options.TargetBranches, candidate.Name)
+	}
+	sort.SliceStable(options.TargetBranches, func(i, j int) bool {
+		return options.TargetBranches[i] == options.DefaultBranch && options.TargetBranches[j] != options.DefaultBranch
+	})
+	if options.DefaultBranch != "" {
+		options.Branch.Commits, err = project.CommitMessages(ctx, "", "origin/"+options.DefaultBranch)
+		if err != nil {
+			return tui.MergeRequestFormOptions{}, err
+		}
+	}
+	return options, nil
+}
+
+func (p *Provider) PushBranch(ctx context.Context, branch string) (string, error) {
+	if err := p.checkLocalClone(ctx); err != nil {
+		return "", err
+	}
+	return project.PushBranch(ctx, "", branch)
+}
+
+func (p *Provider) CreateMergeRequest(ctx context.Context, mr tui.NewMergeRequest) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	title := strings.TrimSpace(mr.Title)
+	if title == "" {
+		return tui.ListItem{}, fmt.Errorf("merge request title is required")
+	}
+	if mr.SourceBranch == "" || mr.TargetBranch == "" {
+		return tui.ListItem{}, fmt.Errorf("source and target branches are required")
+	}
+	if mr.Draft && !strings.HasPrefix(strings.ToLower(title), "draft:") {
+		title = "Draft: " + title
+	}
+
+	created, err := p.client.CreateMergeRequest(ctx, p.projectPath, gitlab.CreateMergeRequestOptions{
+		Title:        title,
+		Description:  strings.TrimSpace(mr.Description),
+		SourceBranch: mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		Labels:       mr.Labels,
+		ReviewerIDs:  mr.ReviewerIDs,
+		Squash:       mr.Squash,
+	})
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	if created == nil {
+		return tui.ListItem{}, fmt.Errorf("create merge request: empty response")
+	}
+	return mergeRequestListItem(&created.BasicMergeRequest), nil
+}
+
+// CreateIssueBranch mirrors GitLab's "Create merge request" button on issues:
+// the branch is created on GitLab from the default branch, optionally with a
+// draft merge request that closes the issue, and then checked out locally when
+// the dashboard runs inside a clone of the project.
+func (p *Provider) CreateIssueBranch(ctx context.Context, req tui.IssueBranchRequest) (tui.IssueBranchResult, error) {
+	if p.projectPath == "" {
+		return tui.IssueBranchResult{}, fmt.Errorf("no project context selected")
+	}
+	if req.IssueIID <= 0 {
+		return tui.IssueBranchResult{}, fmt.Errorf("invalid issue IID: %d", req.IssueIID)
+	}
+	branch := strings.TrimSpace(req.Branch)
+	if branch == "" {
+		return tui.IssueBranchResult{}, fmt.Errorf("branch name is required")
+	}
+
+	gitlabProject, err := p.client.GetProject(ctx, p.projectPath)
+	if err != nil {
+		return tui.IssueBranchResult{}, fmt.Errorf("load project: %w", err)
+	}
+	if gitlabProject.DefaultBranch == "" {
+		return tui.IssueBranchResult{}, fmt.Errorf("project %s has no default branch", p.projectPath)
+	}
+	if _, err := p.client.CreateBranch(ctx, p.projectPath, branch, gitlabProject.DefaultBranch); err != nil {
+		return tui.IssueBranchResult{}, err
+	}
+
+	result := tui.IssueBranchResult{Branch: branch}
+	if req.DraftMergeRequest {
+		created, err := p.client.CreateMergeRequest(ctx, p.projectPath, gitlab.CreateMergeRequestOptions{
+			Title:        fmt.Sprintf("Draft: Resolve \"%s\"", strings.TrimSpace(req.IssueTitle)),
+			Description:  fmt.Sprintf("Closes #%d", req.IssueIID),
+			SourceBranch: branch,
+			TargetBranch: gitlabProject.DefaultBranch,
+		})
+		if err != nil {
+			return result, fmt.Errorf("branch %s was created, but the draft merge request was not: %w", branch, err)
+		}
+		if created != nil {
+			item := mergeRequestListItem(&created.BasicMergeRequest)
+			result.MergeRequest = &item
+		}
+	}
+
+	if err := p.checkLocalClone(ctx); err != nil {
+		result.Output = "Not checked out locally: " + err.Error()
+		return result, nil
+	}
+	result.Output, err = project.CheckoutRemoteBranch(ctx, "", branch)
+	if err != nil {
+		return result, fmt.Errorf("branch %s was created on GitLab, but the local checkout failed: %w", branch, err)
+	}
+	return result, nil
+}
+
+// checkLocalClone makes sure the git repository the dashboard was started
+// from is a clone of the selected project before running git in it.
+func (p *Provider) checkLocalClone(ctx context.Context) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	originPath, err := project.OriginProjectPath(ctx, "")
+	if err != nil {
+		return fmt.Errorf("not inside a git checkout of %s: %w", p.projectPath, err)
+	}
+	if !strings.EqualFold(originPath, p.projectPath) {
+		return fmt.Errorf("the local repository is a clone of %s, not %s", originPath, p.projectPath)
+	}
+	return nil
+}
+
+// LoadTodos lists the current user's pending to-do items across all projects.
+// Items other than issues and merge requests are skipped since the dashboard
+// cannot open them.
+func (p *Provider) LoadTodos(ctx context.Context, query tui.TodoQuery) (tui.TodoResult, error) {
+	todos, hasNextPage, err := p.client.ListTodos(ctx, gitlab.TodoListOptions{
+		Action:  string(query.Action),
+		Page:    int64(query.Page),
+		PerPage: query.PerPage,
+	})
+	if err != nil {
+		return tui.TodoResult{}, err
+	}
+
+	items := make([]tui.ListItem, 0, len(todos))
+	for _, todo := range todos {
+		if todo == nil || todo.Target == nil {
+			continue
+		}
+		targetType := tui.TodoTargetType(todo.TargetType)
+		if targetType != tui.TodoTargetIssue && targetType != tui.TodoTargetMergeRequest {
+			continue
+		}
 		author := "-"
-		if mr.Author != nil {
-			author = displayName(mr.Author.Name, mr.Author.Username)
+		if todo.Author != nil {
+			author = displayName(todo.Author.Name, todo.Author.Username)
+		}
+		projectPath := ""
+		if todo.Project != nil {
+			projectPath = todo.Project.PathWithNamespace
+		}
+		reference := fmt.Sprintf("#%d", todo.Target.IID)
+		if targetType == tui.TodoTargetMergeRequest {
+			reference = fmt.Sprintf("!%d", todo.Target.IID)
 		}
 		items = append(items, tui.ListItem{
-			ID:       mr.ID,
-			Title:    mr.Title,
-			Subtitle: subtitle,
-			URL:      mr.WebURL,
-			MergeRequest: &tui.MergeRequestDetails{
-				IID:          mr.IID,
-				State:        mr.State,
-				Author:       author,
-				SourceBranch: mr.SourceBranch,
-				TargetBranch: mr.TargetBranch,
-				CreatedAt:    formatIssueTime(mr.CreatedAt),
-				UpdatedAt:    formatIssueTime(mr.UpdatedAt),
-				URL:          mr.WebURL,
-				Description:  mr.Description,
+			ID:       todo.ID,
+			Title:    todo.Target.Title,
+			Subtitle: fmt.Sprintf("%s%s • %s", projectPath, reference, todo.ActionName),
+			URL:      todo.TargetURL,
+			Todo: &tui.TodoDetails{
+				ID:          todo.ID,
+				Action:      tui.TodoAction(todo.ActionName),
+				TargetType:  targetType,
+				TargetIID:   todo.Target.IID,
+				TargetState: todo.Target.State,
+				ProjectPath: projectPath,
+				Author:      author,
+				Body:        todo.Body,
+				CreatedAt:   formatIssueTime(todo.CreatedAt),
 			},
 		})
 	}
+	return tui.TodoResult{Items: items, HasNextPage: hasNextPage}, nil
+}
 
-	return tui.MergeRequestResult{Items: items, HasNextPage: hasNextPage}, nil
+func (p *Provider) MarkTodoDone(ctx context.Context, todoID int64) error {
+	if todoID <= 0 {
+		return fmt.Errorf("invalid todo ID: %d", todoID)
+	}
+	return p.client.MarkTodoDone(ctx, todoID)
+}
+
+func (p *Provider) MarkAllTodosDone(ctx context.Context) error {
+	return p.client.MarkAllTodosDone(ctx)
+}
+
+func (p *Provider) LoadIssue(ctx context.Context, issueIID int64) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	if issueIID <= 0 {
+		return tui.ListItem{}, fmt.Errorf("invalid issue IID: %d", issueIID)
+	}
+	issue, err := p.client.GetIssue(ctx, p.projectPath, issueIID)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	return issueListItem(issue), nil
+}
+
+func (p *Provider) LoadMergeRequest(ctx context.Context, mergeRequestIID int64) (tui.ListItem, error) {
+	if p.projectPath == "" {
+		return tui.ListItem{}, fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return tui.ListItem{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	mr, err := p.client.GetMergeRequest(ctx, p.projectPath, mergeRequestIID)
+	if err != nil {
+		return tui.ListItem{}, err
+	}
+	return mergeRequestListItem(&mr.BasicMergeRequest), nil
 }
 
 func (p *Provider) LoadIssueDetailData(ctx context.Context, issueIID int64) (tui.IssueDetailData, error) {
@@ -167,29 +715,7 @@ func (p *Provider) LoadIssueDetailData(ctx context.Context, issueIID int64) (tui
 		return tui.IssueDetailData{}, fmt.Errorf("load issue state events: %w", err)
 	}
 
-	comments := make([]tui.IssueComment, 0, len(notes))
-	activities := make([]tui.IssueActivity, 0, len(notes)+len(stateEvents))
-
-	for _, note := range notes {
-		if note == nil {
-			continue
-		}
-		author := displayName(note.Author.Name, note.Author.Username)
-		createdAt := formatIssueTime(note.CreatedAt)
-		body := strings.TrimSpace(note.Body)
-		if note.System {
-			if body == "" {
-				body = "System activity"
-			}
-			activities = append(activities, tui.IssueActivity{Actor: author, CreatedAt: createdAt, Action: body})
-			continue
-		}
-		if body == "" {
-			continue
-		}
-		comments = append(comments, tui.IssueComment{Author: author, CreatedAt: createdAt, Body: body})
-	}
-
+	comments, activities := splitIssueNotes(notes)
 	for _, event := range stateEvents {
 		if event == nil {
 			continue
@@ -208,15 +734,617 @@ func (p *Provider) LoadIssueDetailData(ctx context.Context, issueIID int64) (tui
 			Action:    action,
 		})
 	}
-
+	data := newestIssueDetailData(comments, activities)
+	links, err := p.loadIssueLinks(ctx, issueIID)
+	if err != nil {
+		data.LinksErr = err.Error()
+		return data, nil
+	}
+	data.Links = links
+	return data, nil
+}
+
+// loadIssueLinks lists the issues linked to an issue by link type, then the
+// merge requests that close it and the other merge requests mentioning it.
+func (p *Provider) loadIssueLinks(ctx context.Context, issueIID int64) ([]tui.IssueLink, error) {
+	relations, err := p.client.ListIssueLinks(ctx, p.projectPath, issueIID)
+	if err != nil {
+		return nil, fmt.Errorf("load linked issues: %w", err)
+	}
+	closing, err := p.client.ListMergeRequestsClosingIssue(ctx, p.projectPath, issueIID)
+	if err != nil {
+		return nil, fmt.Errorf("load merge requests closing the issue: %w", err)
+	}
+	related, err := p.client.ListRelatedMergeRequests(ctx, p.projectPath, issueIID)
+	if err != nil {
+		return nil, fmt.Errorf("load related merge requests: %w", err)
+	}
+
+	links := make([]tui.IssueLink, 0, len(relations)+len(closing)+len(related))
+	for _, kind := range []tui.IssueLinkKind{tui.IssueLinkRelatesTo, tui.IssueLinkBlocks, tui.IssueLinkBlockedBy} {
+		for _, relation := range relations {
+			if relation == nil || tui.IssueLinkKind(relation.LinkType) != kind {
+				continue
+			}
+			links = append(links, tui.IssueLink{Kind: kind, Item: p.linkedIssueItem(relation)})
+		}
+	}
+	closes := make(map[int64]bool, len(closing))
+	for _, mr := range closing {
+		if mr == nil {
+			continue
+		}
+		closes[mr.ID] = true
+		links = append(links, tui.IssueLink{Kind: tui.IssueLinkClosedBy, Item: p.linkedMergeRequestItem(mr)})
+	}
+	for _, mr := range related {
+		if mr == nil || closes[mr.ID] {
+			continue
+		}
+		links = append(links, tui.IssueLink{Kind: tui.IssueLinkRelatedToMR, Item: p.linkedMergeRequestItem(mr)})
+	}
+	return links, nil
+}
+
+func (p *Provider) linkedIssueItem(relation *gl.IssueRelation) tui.ListItem {
+	item := issueListItem(&gl.Issue{
+		ID:           relation.ID,
+		IID:          relation.IID,
+		State:        relation.State,
+		Title:        relation.Title,
+		Description:  relation.Description,
+		Author:       relation.Author,
+		Assignees:    relation.Assignees,
+		Labels:       relation.Labels,
+		Milestone:    relation.Milestone,
+		CreatedAt:    relation.CreatedAt,
+		UpdatedAt:    relation.UpdatedAt,
+		WebURL:       relation.WebURL,
+		Confidential: relation.Confidential,
+	})
+	item.ProjectPath = cmp.Or(referenceProjectPath(relation.References, "#"), p.projectPath)
+	item.Subtitle = fmt.Sprintf("%s#%d • %s", item.ProjectPath, relation.IID, relation.State)
+	return item
+}
+
+func (p *Provider) linkedMergeRequestItem(mr *gl.BasicMergeRequest) tui.ListItem {
+	item := mergeRequestListItem(mr)
+	item.ProjectPath = cmp.Or(referenceProjectPath(mr.References, "!"), p.projectPath)
+	item.Subtitle = fmt.Sprintf("%s!%d • %s", item.ProjectPath, mr.IID, mr.State)
+	return item
+}
+
+// splitIssueNotes turns the user notes of an issue or epic into comments and
+// its system notes into activities.
+func splitIssueNotes(notes []*gl.Note) ([]tui.IssueComment, []tui.IssueActivity) {
+	comments := make([]tui.IssueComment, 0, len(notes))
+	activities := make([]tui.IssueActivity, 0, len(notes))
+
+	for _, note := range notes {
+		if note == nil {
+			continue
+		}
+		author := displayName(note.Author.Name, note.Author.Username)
+		createdAt := formatIssueTime(note.CreatedAt)
+		body := strings.TrimSpace(note.Body)
+		if note.System {
+			if body == "" {
+				body = "System activity"
+			}
+			activities = append(activities, tui.IssueActivity{Actor: author, CreatedAt: createdAt, Action: body})
+			continue
+		}
+		if body == "" {
+			continue
+		}
+		comments = append(comments, tui.IssueComment{Author: author, CreatedAt: createdAt, Body: body})
+	}
+	return comments, activities
+}
+
+// newestIssueDetailData orders comments and activities newest first.
+func newestIssueDetailData(comments []tui.IssueComment, activities []tui.IssueActivity) tui.IssueDetailData {
 	sort.SliceStable(comments, func(i int, j int) bool {
 		return comments[i].CreatedAt > comments[j].CreatedAt
 	})
 	sort.SliceStable(activities, func(i int, j int) bool {
 		return activities[i].CreatedAt > activities[j].CreatedAt
 	})
+	return tui.IssueDetailData{Comments: comments, Activities: activities}
+}
 
-	return tui.IssueDetailData{Comments: comments, Activities: activities}, nil
+func (p *Provider) LoadPipelines(ctx context.Context, query tui.PipelineQuery) (tui.PipelineResult, error) {
+	if p.projectPath == "" {
+		return tui.PipelineResult{}, fmt.Errorf("no project context selected")
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PerPage <= 0 {
+		query.PerPage = 25
+	}
+
+	pipelines, hasNextPage, err := p.client.ListPipelines(ctx, p.projectPath, gitlab.PipelineListOptions{
+		Page:    int64(query.Page),
+		PerPage: query.PerPage,
+	})
+	if err != nil {
+		return tui.PipelineResult{}, err
+	}
+
+	// The list endpoint omits duration and triggerer, so fetch each pipeline
+	// with a small worker pool and fall back to list data when that fails.
+	details := make([]*gl.Pipeline, len(pipelines))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, pipelineDetailConcurrency)
+	for i, pipeline := range pipelines {
+		if pipeline == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(index int, pipelineID int64) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			detail, detailErr := p.client.GetPipeline(ctx, p.projectPath, pipelineID)
+			if detailErr == nil {
+				details[index] = detail
+			}
+		}(i, pipeline.ID)
+	}
+	wg.Wait()
+
+	items := make([]tui.ListItem, 0, len(pipelines))
+	for i, pipeline := range pipelines {
+		if pipeline == nil {
+			continue
+		}
+		var summary tui.PipelineDetails
+		if details[i] != nil {
+			summary = pipelineDetailsFromAPI(details[i])
+		} else {
+			summary = tui.PipelineDetails{
+				ID:        pipeline.ID,
+				IID:       pipeline.IID,
+				Status:    pipeline.Status,
+				Ref:       pipeline.Ref,
+				SHA:       pipeline.SHA,
+				Source:    pipeline.Source,
+				Duration:  "-",
+				CreatedAt: formatIssueTime(pipeline.CreatedAt),
+				UpdatedAt: formatIssueTime(pipeline.UpdatedAt),
+				URL:       pipeline.WebURL,
+			}
+		}
+		items = append(items, tui.ListItem{
+			ID:       pipeline.ID,
+			Title:    fmt.Sprintf("#%d %s", pipeline.ID, pipeline.Ref),
+			Subtitle: fmt.Sprintf("%s • %s", pipeline.Status, shortSHA(pipeline.SHA)),
+			URL:      pipeline.WebURL,
+			Pipeline: &summary,
+		})
+	}
+
+	return tui.PipelineResult{Items: items, HasNextPage: hasNextPage}, nil
+}
+
+func (p *Provider) LoadPipelineDetailData(ctx context.Context, pipelineID int64) (tui.PipelineDetailData, error) {
+	if p.projectPath == "" {
+		return tui.PipelineDetailData{}, fmt.Errorf("no project context selected")
+	}
+	if pipelineID <= 0 {
+		return tui.PipelineDetailData{}, fmt.Errorf("invalid pipeline ID: %d", pipelineID)
+	}
+
+	pipeline, err := p.client.GetPipeline(ctx, p.projectPath, pipelineID)
+	if err != nil {
+		return tui.PipelineDetailData{}, fmt.Errorf("load pipeline: %w", err)
+	}
+	jobs, err := p.client.ListPipelineJobs(ctx, p.projectPath, pipelineID)
+	if err != nil {
+		return tui.PipelineDetailData{}, fmt.Errorf("load pipeline jobs: %w", err)
+	}
+
+	return tui.PipelineDetailData{
+		Pipeline: pipelineDetailsFromAPI(pipeline),
+		Stages:   groupJobsByStage(jobs),
+	}, nil
+}
+
+func (p *Provider) LoadMergeRequestDetailData(ctx context.Context, mergeRequestIID int64) (tui.MergeRequestDetailData, error) {
+	if p.projectPath == "" {
+		return tui.MergeRequestDetailData{}, fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return tui.MergeRequestDetailData{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	discussions, err := p.client.ListMergeRequestDiscussions(ctx, p.projectPath, mergeRequestIID)
+	if err != nil {
+		return tui.MergeRequestDetailData{}, fmt.Errorf("load merge request discussions: %w", err)
+	}
+	approvals, err := p.client.GetMergeRequestApprovals(ctx, p.projectPath, mergeRequestIID)
+	if err != nil {
+		return tui.MergeRequestDetailData{}, fmt.Errorf("load merge request approvals: %w", err)
+	}
+	// Approval rules need a paid tier; without them the overview only shows
+	// the approvers, so a failure here is not worth failing the whole load.
+	rules, err := p.client.GetMergeRequestApprovalState(ctx, p.projectPath, mergeRequestIID)
+	if err != nil {
+		rules = nil
+	}
+
+	data := mergeRequestDetailFromDiscussions(discussions)
+	data.Approvals = mergeRequestApprovals(approvals, rules)
+	return data, nil
+}
+
+func mergeRequestApprovals(approvals *gl.MergeRequestApprovals, state *gl.MergeRequestApprovalState) tui.MergeRequestApprovals {
+	var result tui.MergeRequestApprovals
+	if approvals != nil {
+		result.Approved = approvals.Approved
+		result.Required = approvals.ApprovalsRequired
+		result.Left = approvals.ApprovalsLeft
+		result.UserHasApproved = approvals.UserHasApproved
+		result.UserCanApprove = approvals.UserCanApprove
+		for _, approver := range approvals.ApprovedBy {
+			if approver == nil || approver.User == nil {
+				continue
+			}
+			result.ApprovedBy = append(result.ApprovedBy, displayName(approver.User.Name, approver.User.Username))
+		}
+	}
+	if state == nil {
+		return result
+	}
+	for _, rule := range state.Rules {
+		if rule == nil {
+			continue
+		}
+		approvedBy := make([]string, 0, len(rule.ApprovedBy))
+		for _, user := range rule.ApprovedBy {
+			if user != nil {
+				approvedBy = append(approvedBy, displayName(user.Name, user.Username))
+			}
+		}
+		result.Rules = append(result.Rules, tui.MergeRequestApprovalRule{
+			Name:       rule.Name,
+			Required:   rule.ApprovalsRequired,
+			Approved:   rule.Approved,
+			ApprovedBy: approvedBy,
+		})
+	}
+	return result
+}
+
+// mergeRequestDetailFromDiscussions keeps threads in the order GitLab returns
+// them, which is oldest first, and moves system notes into the activity list.
+func mergeRequestDetailFromDiscussions(discussions []*gl.Discussion) tui.MergeRequestDetailData {
+	threads := make([]tui.MergeRequestThread, 0, len(discussions))
+	activities := make([]tui.IssueActivity, 0)
+
+	for _, discussion := range discussions {
+		if discussion == nil {
+			continue
+		}
+		thread := tui.MergeRequestThread{ID: discussion.ID}
+		for _, note := range discussion.Notes {
+			if note == nil {
+				continue
+			}
+			author := displayName(note.Author.Name, note.Author.Username)
+			createdAt := formatIssueTime(note.CreatedAt)
+			body := strings.TrimSpace(note.Body)
+			if note.System {
+				if body == "" {
+					body = "System activity"
+				}
+				activities = append(activities, tui.IssueActivity{Actor: author, CreatedAt: createdAt, Action: body})
+				continue
+			}
+			if note.Resolvable {
+				thread.Resolvable = true
+				thread.Resolved = note.Resolved
+				if note.Resolved && note.ResolvedBy.Username != "" {
+					thread.ResolvedBy = displayName(note.ResolvedBy.Name, note.ResolvedBy.Username)
+				}
+			}
+			if thread.Path == "" && note.Position != nil {
+				thread.Path = note.Position.NewPath
+				if thread.Path == "" {
+					thread.Path = note.Position.OldPath
+				}
+				thread.OldLine = note.Position.OldLine
+				thread.NewLine = note.Position.NewLine
+			}
+			thread.Notes = append(thread.Notes, tui.IssueComment{Author: author, CreatedAt: createdAt, Body: body})
+		}
+		if len(thread.Notes) > 0 {
+			threads = append(threads, thread)
+		}
+	}
+
+	sort.SliceStable(activities, func(i int, j int) bool {
+		return activities[i].CreatedAt > activities[j].CreatedAt
+	})
+
+	return tui.MergeRequestDetailData{Threads: threads, Activities: activities}
+}
+
+func (p *Provider) CreateIssueNote(ctx context.Context, issueIID int64, body string) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	if issueIID <= 0 {
+		return fmt.Errorf("invalid issue IID: %d", issueIID)
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("comment is empty")
+	}
+
+	if _, err := p.client.CreateIssueNote(ctx, p.projectPath, issueIID, body); err != nil {
+		return fmt.Errorf("post issue comment: %w", err)
+	}
+	return nil
+}
+
+func (p *Provider) CreateMergeRequestNote(ctx context.Context, mergeRequestIID int64, body string) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("comment is empty")
+	}
+
+	if _, err := p.client.CreateMergeRequestNote(ctx, p.projectPath, mergeRequestIID, body); err != nil {
+		return fmt.Errorf("post merge request comment: %w", err)
+	}
+	return nil
+}
+
+func (p *Provider) AddDiscussionNote(ctx context.Context, mergeRequestIID int64, discussionID string, body string) error {
+	if p.projectPath == "" {
+		return fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+	if discussionID == "" {
+		return fmt.Errorf("no discussion selected")
+	}
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("reply is empty")
+	}
+
+	if _, err := p.client.AddDiscussionNote(ctx, p.projectPath, mergeRequestIID, discussionID, body); err != nil {
+		return fmt.Errorf("post thread reply: %w", err)
+	}
+	return nil
+}
+
+func (p *Provider) LoadMergeRequestDiffs(ctx context.Context, mergeRequestIID int64) ([]tui.MergeRequestDiffFile, error) {
+	if p.projectPath == "" {
+		return nil, fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return nil, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	diffs, err := p.client.ListMergeRequestDiffs(ctx, p.projectPath, mergeRequestIID)
+	if err != nil {
+		return nil, fmt.Errorf("load merge request diffs: %w", err)
+	}
+
+	files := make([]tui.MergeRequestDiffFile, 0, len(diffs))
+	for _, diff := range diffs {
+		if diff == nil {
+			continue
+		}
+		files = append(files, tui.MergeRequestDiffFile{
+			OldPath:     diff.OldPath,
+			NewPath:     diff.NewPath,
+			NewFile:     diff.NewFile,
+			RenamedFile: diff.RenamedFile,
+			DeletedFile: diff.DeletedFile,
+			Diff:        diff.Diff,
+		})
+	}
+	return files, nil
+}
+
+func (p *Provider) LoadMergeRequestPipeline(ctx context.Context, mergeRequestIID int64) (tui.PipelineDetailData, error) {
+	if p.projectPath == "" {
+		return tui.PipelineDetailData{}, fmt.Errorf("no project context selected")
+	}
+	if mergeRequestIID <= 0 {
+		return tui.PipelineDetailData{}, fmt.Errorf("invalid merge request IID: %d", mergeRequestIID)
+	}
+
+	pipelines, err := p.client.ListMergeRequestPipelines(ctx, p.projectPath, mergeRequestIID)
+	if err != nil {
+		return tui.PipelineDetailData{}, fmt.Errorf("load merge request pipelines: %w", err)
+	}
+
+	// GitLab lists merge request pipelines newest first.
+	for _, pipeline := range pipelines {
+		if pipeline != nil && pipeline.ID > 0 {
+			return p.LoadPipelineDetailData(ctx, pipeline.ID)
+		}
+	}
+	return tui.PipelineDetailData{}, fmt.Errorf("merge request !%d has no pipelines", mergeRequestIID)
+}
+
+func (p *Provider) LoadJobTrace(ctx context.Context, jobID int64, offset int64) (tui.JobTrace, error) {
+	if p.projectPath == "" {
+		return tui.JobTrace{}, fmt.Errorf("no project context selected")
+	}
+	if jobID <= 0 {
+		return tui.JobTrace{}, fmt.Errorf("invalid job ID: %d", jobID)
+	}
+
+	// Read the status before the trace: a job that finishes between the two
+	// requests is then still reported as running and polled once more, rather
+	// than marked complete without its last chunk of output.
+	job, err := p.client.GetJob(ctx, p.projectPath, jobID)
+	if err != nil {
+		return tui.JobTrace{}, fmt.Errorf("load job status: %w", err)
+	}
+	trace, err := p.client.GetJobTrace(ctx, p.projectPath, jobID, offset)
+	if err != nil {
+		return tui.JobTrace{}, fmt.Errorf("load job trace: %w", err)
+	}
+
+	return tui.JobTrace{
+		Content:  string(trace.Content),
+		Offset:   trace.Offset,
+		Reset:    trace.Reset,
+		Status:   job.Status,
+		Complete: !jobStatusActive(job.Status),
+	}, nil
+}
+
+func pipelineDetailsFromAPI(pipeline *gl.Pipeline) tui.PipelineDetails {
+	triggeredBy := "-"
+	if pipeline.User != nil {
+		triggeredBy = displayName(pipeline.User.Name, pipeline.User.Username)
+	}
+	return tui.PipelineDetails{
+		ID:          pipeline.ID,
+		IID:         pipeline.IID,
+		Status:      pipeline.Status,
+		Ref:         pipeline.Ref,
+		SHA:         pipeline.SHA,
+		Source:      string(pipeline.Source),
+		Duration:    formatDuration(float64(pipeline.Duration)),
+		TriggeredBy: triggeredBy,
+		CreatedAt:   formatIssueTime(pipeline.CreatedAt),
+		UpdatedAt:   formatIssueTime(pipeline.UpdatedAt),
+		URL:         pipeline.WebURL,
+	}
+}
+
+// groupJobsByStage orders stages by their first job ID, which follows the
+// order GitLab created them in from the pipeline definition.
+func groupJobsByStage(jobs []*gl.Job) []tui.PipelineStage {
+	stageIndex := make(map[string]int)
+	firstJobID := make([]int64, 0, 8)
+	stages := make([]tui.PipelineStage, 0, 8)
+
+	for _, job := range jobs {
+		if job == nil {
+			continue
+		}
+		name := strings.TrimSpace(job.Stage)
+		if name == "" {
+			name = "-"
+		}
+		idx, ok := stageIndex[name]
+		if !ok {
+			idx = len(stages)
+			stageIndex[name] = idx
+			stages = append(stages, tui.PipelineStage{Name: name})
+			firstJobID = append(firstJobID, job.ID)
+		}
+		if job.ID < firstJobID[idx] {
+			firstJobID[idx] = job.ID
+		}
+		stages[idx].Jobs = append(stages[idx].Jobs, tui.PipelineJob{
+			ID:           job.ID,
+			Name:         job.Name,
+			Stage:        name,
+			Status:       job.Status,
+			Duration:     formatDuration(job.Duration),
+			AllowFailure: job.AllowFailure,
+			URL:          job.WebURL,
+		})
+	}
+
+	order := make([]int, len(stages))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i int, j int) bool {
+		return firstJobID[order[i]] < firstJobID[order[j]]
+	})
+
+	sorted := make([]tui.PipelineStage, 0, len(stages))
+	for _, idx := range order {
+		stage := stages[idx]
+		sort.SliceStable(stage.Jobs, func(i int, j int) bool {
+			return stage.Jobs[i].Name < stage.Jobs[j].Name
+		})
+		stage.Status = pipelineStageStatus(stage.Jobs)
+		sorted = append(sorted, stage)
+	}
+	return sorted
+}
+
+func pipelineStageStatus(jobs []tui.PipelineJob) string {
+	if len(jobs) == 0 {
+		return "created"
+	}
+	counts := make(map[string]int, len(jobs))
+	for _, job := range jobs {
+		status := job.Status
+		if status == "failed" && job.AllowFailure {
+			status = "success"
+		}
+		counts[status]++
+	}
+	for _, status := range []string{"failed", "running", "pending", "preparing", "waiting_for_resource", "manual", "scheduled", "canceled"} {
+		if counts[status] > 0 {
+			return status
+		}
+	}
+	if counts["success"] > 0 {
+		return "success"
+	}
+	if counts["skipped"] == len(jobs) {
+		return "skipped"
+	}
+	return jobs[0].Status
+}
+
+// jobStatusActive reports whether a job can still write to its trace.
+func jobStatusActive(status string) bool {
+	switch status {
+	case "created", "pending", "preparing", "waiting_for_resource", "running":
+		return true
+	default:
+		return false
+	}
+}
+
+func shortSHA(sha string) string {
+	trimmed := strings.TrimSpace(sha)
+	if len(trimmed) > 8 {
+		return trimmed[:8]
+	}
+	if trimmed == "" {
+		return "-"
+	}
+	return trimmed
+}
+
+func formatDuration(seconds float64) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	d := time.Duration(seconds * float64(time.Second)).Round(time.Second)
+	hours := int(d / time.Hour)
+	minutes := int(d%time.Hour) / int(time.Minute)
+	secs := int(d%time.Minute) / int(time.Second)
+	switch {
+	case hours > 0:
+		return fmt.Sprintf("%dh %02dm %02ds", hours, minutes, secs)
+	case minutes > 0:
+		return fmt.Sprintf("%dm %02ds", minutes, secs)
+	default:
+		return fmt.Sprintf("%ds", secs)
+	}
 }
 
 func displayName(name string, username string) string {
@@ -237,3 +1365,563 @@ func formatIssueTime(value *time.Time) string {
 	}
 	return value.Local().Format("2006-01-02 15:04 MST")
 }
+
+// LoadMyWork lists open issues and merge requests across every project. It
+// does not need a project context; each item carries its own project path.
+func (p *Provider) LoadMyWork(ctx context.Context, query tui.MyWorkQuery) (tui.MyWorkResult, error) {
+	var (
+		items       []tui.ListItem
+		hasNextPage bool
+	)
+	switch query.Scope {
+	case tui.MyWorkAssignedIssues:
+		issues, next, err := p.client.ListGlobalIssues(ctx, gitlab.GlobalIssueListOptions{
+			Scope:   "assigned_to_me",
+			State:   "opened",
+			Page:    int64(query.Page),
+			PerPage: query.PerPage,
+		})
+		if err != nil {
+			return tui.MyWorkResult{}, err
+		}
+		items = make([]tui.ListItem, 0, len(issues))
+		for _, issue := range issues {
+			if issue == nil {
+				continue
+			}
+			item := issueListItem(issue)
+			item.ProjectPath = referenceProjectPath(issue.References, "#")
+			item.Subtitle = fmt.Sprintf("%s#%d • %s", item.ProjectPath, issue.IID, issue.State)
+			items = append(items, item)
+		}
+		hasNextPage = next
+	case tui.MyWorkAuthoredMergeRequests, tui.MyWorkReviewRequests:
+		opts := gitlab.GlobalMergeRequestListOptions{
+			Scope:   "created_by_me",
+			State:   "opened",
+			Page:    int64(query.Page),
+			PerPage: query.PerPage,
+		}
+		if query.Scope == tui.MyWorkReviewRequests {
+			user, err := p.client.GetCurrentUser(ctx)
+			if err != nil {
+				return tui.MyWorkResult{}, err
+			}
+			opts.Scope = "all"
+			opts.ReviewerID = user.ID
+		}
+		mrs, next, err := p.client.ListGlobalMergeRequests(ctx, opts)
+		if err != nil {
+			return tui.MyWorkResult{}, err
+		}
+		items = make([]tui.ListItem, 0, len(mrs))
+		for _, mr := range mrs {
+			if mr == nil {
+				continue
+			}
+			item := mergeRequestListItem(mr)
+			item.ProjectPath = referenceProjectPath(mr.References, "!")
+			item.Subtitle = fmt.Sprintf("%s!%d • %s • %s → %s", item.ProjectPath, mr.IID, item.MergeRequest.Author, mr.SourceBranch, mr.TargetBranch)
+			items = append(items, item)
+		}
+		hasNextPage = next
+	default:
+		return tui.MyWorkResult{}, fmt.Errorf("unknown my work list %q", query.Scope)
+	}
+	return tui.MyWorkResult{Items: items, HasNextPage: hasNextPage}, nil
+}
+
+// referenceProjectPath returns the project part of a full reference such as
+// "group/project#12".
+func referenceProjectPath(references *gl.IssueReferences, separator string) string {
+	if references == nil {
+		return ""
+	}
+	path, _, _ := strings.Cut(references.Full, separator)
+	return path
+}
+
+// LoadGroup lists the top-level groups, or the subgroups and projects, open
+// issues or open merge requests of one group. Like LoadMyWork it does not
+// need a project context. The first page of a group's contents holds all of
+// its subgroups so they stay above the projects.
+func (p *Provider) LoadGroup(ctx context.Context, query tui.GroupQuery) (tui.GroupResult, error) {
+	switch query.Scope {
+	case tui.GroupContents:
+		if query.Path == "" {
+			groups, next, err := p.client.ListGroups(ctx, gitlab.GroupListOptions{Page: int64(query.Page), PerPage: query.PerPage})
+			if err != nil {
+				return tui.GroupResult{}, err
+			}
+			items := make([]tui.ListItem, 0, len(groups))
+			for _, group := range groups {
+				if group != nil {
+					items = append(items, groupListItem(group))
+				}
+			}
+			return tui.GroupResult{Items: items, HasNextPage: next}, nil
+		}
+
+		var items []tui.ListItem
+		if query.Page <= 1 {
+			for page := int64(1); ; page++ {
+				groups, next, err := p.client.ListSubgroups(ctx, query.Path, gitlab.GroupListOptions{Page: page})
+				if err != nil {
+					return tui.GroupResult{}, err
+				}
+				for _, group := range groups {
+					if group != nil {
+						items = append(items, groupListItem(group))
+					}
+				}
+				if !next {
+					break
+				}
+			}
+		}
+		projects, next, err := p.client.ListGroupProjects(ctx, query.Path, gitlab.GroupListOptions{Page: int64(query.Page), PerPage: query.PerPage})
+		if err != nil {
+			return tui.GroupResult{}, err
+		}
+		for _, project := range projects {
+			if project == nil {
+				continue
+			}
+			items = append(items, tui.ListItem{
+				ID:    project.ID,
+				Title: project.Name,
+				URL:   project.WebURL,
+				Group: &tui.GroupEntry{Kind: tui.GroupEntryProject, FullPath: project.PathWithNamespace, Description: project.Description},
+			})
+		}
+		return tui.GroupResult{Items: items, HasNextPage: next}, nil
+	case tui.GroupIssues:
+		issues, next, err := p.client.ListGroupIssues(ctx, query.Path, gitlab.IssueListOptions{
+			State:   "opened",
+			OrderBy: string(query.Sort.Field),
+			Sort:    sortDirection(query.Sort),
+			Page:    int64(query.Page),
+			PerPage: query.PerPage,
+		})
+		if err != nil {
+			return tui.GroupResult{}, err
+		}
+		items := make([]tui.ListItem, 0, len(issues))
+		for _, issue := range issues {
+			if issue == nil {
+				continue
+			}
+			item := issueListItem(issue)
+			item.ProjectPath = referenceProjectPath(issue.References, "#")
+			item.Subtitle = fmt.Sprintf("%s#%d • %s", item.ProjectPath, issue.IID, issue.State)
+			items = append(items, item)
+		}
+		return tui.GroupResult{Items: items, HasNextPage: next}, nil
+	case tui.GroupMergeRequests:
+		mrs, next, err := p.client.ListGroupMergeRequests(ctx, query.Path, gitlab.MergeRequestListOptions{
+			State:   "opened",
+			OrderBy: string(query.Sort.Field),
+			Sort:    sortDirection(query.Sort),
+			Page:    int64(query.Page),
+			PerPage: query.PerPage,
+		})
+		if err != nil {
+			return tui.GroupResult{}, err
+		}
+		items := make([]tui.ListItem, 0, len(mrs))
+		for _, mr := range mrs {
+			if mr == nil {
+				continue
+			}
+			item := mergeRequestListItem(mr)
+			item.ProjectPath = referenceProjectPath(mr.References, "!")
+			item.Subtitle = fmt.Sprintf("%s!%d • %s • %s → %s", item.ProjectPath, mr.IID, item.MergeRequest.Author, mr.SourceBranch, mr.TargetBranch)
+			items = append(items, item)
+		}
+		return tui.GroupResult{Items: items, HasNextPage: next}, nil
+	default:
+		return tui.GroupResult{}, fmt.Errorf("unknown group list %q", query.Scope)
+	}
+}
+
+func groupListItem(group *gl.Group) tui.ListItem {
+	return tui.ListItem{
+		ID:    group.ID,
+		Title: group.Name,
+		URL:   group.WebURL,
+		Group: &tui.GroupEntry{Kind: tui.GroupEntrySubgroup, FullPath: group.FullPath, Description: group.Description},
+	}
+}
+
+// LoadMilestones lists the milestones of the project and its parent groups,
+// soonest due first, or the issues or merge requests of one milestone. The
+// API has no milestone statistics, so the issues of every milestone on the
+// page are fetched to count and weigh them.
+func (p *Provider) LoadMilestones(ctx context.Context, query tui.MilestoneQuery) (tui.MilestoneResult, error) {
+	if p.projectPath == "" {
+		return tui.MilestoneResult{}, fmt.Errorf("no project context selected")
+	}
+	if query.Milestone != nil {
+		return p.loadMilestoneItems(ctx, query)
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PerPage <= 0 {
+		query.PerPage = 25
+	}
+
+	all, err := p.client.ListMilestones(ctx, p.projectPath, string(query.State))
+	if err != nil {
+		return tui.MilestoneResult{}, err
+	}
+	milestones := make([]*gl.Milestone, 0, len(all))
+	for _, milestone := range all {
+		if milestone != nil {
+			milestones = append(milestones, milestone)
+		}
+	}
+	sortMilestones(milestones, query.State == tui.MilestoneStateClosed)
+	start := min(len(milestones), (query.Page-1)*query.PerPage)
+	end := min(len(milestones), start+query.PerPage)
+	milestones = milestones[start:end]
+
+	now := time.Now()
+	summaries := make([]tui.MilestoneDetails, len(milestones))
+	errs := make([]error, len(milestones))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, milestoneIssueConcurrency)
+	for i, milestone := range milestones {
+		wg.Add(1)
+		go func(index int, milestone *gl.Milestone) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			issues, issuesErr := p.client.GetMilestoneIssues(ctx, p.projectPath, gitlab.MilestoneRef{ID: milestone.ID, GroupID: milestone.GroupID})
+			summaries[index] = milestoneDetails(milestone, issues, now)
+			errs[index] = issuesErr
+		}(i, milestone)
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return tui.MilestoneResult{}, err
+	}
+
+	items := make([]tui.ListItem, 0, len(milestones))
+	for i, milestone := range milestones {
+		items = append(items, tui.ListItem{
+			ID:        milestone.ID,
+			Title:     milestone.Title,
+			Subtitle:  fmt.Sprintf("%s • due %s", milestone.State, cmp.Or(summaries[i].DueDate, "-")),
+			URL:       milestone.WebURL,
+			Milestone: &summaries[i],
+		})
+	}
+	return tui.MilestoneResult{Items: items, HasNextPage: end < len(all)}, nil
+}
+
+func (p *Provider) loadMilestoneItems(ctx context.Context, query tui.MilestoneQuery) (tui.MilestoneResult, error) {
+	ref := gitlab.MilestoneRef{ID: query.Milestone.ID, GroupID: query.Milestone.GroupID}
+	switch query.Scope {
+	case tui.MilestoneIssues:
+		issues, err := p.client.GetMilestoneIssues(ctx, p.projectPath, ref)
+		if err != nil {
+			return tui.MilestoneResult{}, err
+		}
+		items := make([]tui.ListItem, 0, len(issues))
+		for _, issue := range issues {
+			if issue == nil {
+				continue
+			}
+			item := issueListItem(issue)
+			item.ProjectPath = cmp.Or(referenceProjectPath(issue.References, "#"), p.projectPath)
+			item.Subtitle = fmt.Sprintf("%s#%d • %s", item.ProjectPath, issue.IID, issue.State)
+			items = append(items, item)
+		}
+		return tui.MilestoneResult{Items: items}, nil
+	case tui.MilestoneMergeRequests:
+		mrs, err := p.client.GetMilestoneMergeRequests(ctx, p.projectPath, ref)
+		if err != nil {
+			return tui.MilestoneResult{}, err
+		}
+		items := make([]tui.ListItem, 0, len(mrs))
+		for _, mr := range mrs {
+			if mr == nil {
+				continue
+			}
+			item := mergeRequestListItem(mr)
+			item.ProjectPath = cmp.Or(referenceProjectPath(mr.References, "!"), p.projectPath)
+			item.Subtitle = fmt.Sprintf("%s!%d • %s • %s • %s → %s", item.ProjectPath, mr.IID, mr.State, item.MergeRequest.Author, mr.SourceBranch, mr.TargetBranch)
+			items = append(items, item)
+		}
+		return tui.MilestoneResult{Items: items}, nil
+	default:
+		return tui.MilestoneResult{}, fmt.Errorf("unknown milestone list %q", query.Scope)
+	}
+}
+
+// sortMilestones orders milestones by due date, soonest first or latest
+// first when latestFirst is set, with undated milestones last.
+func sortMilestones(milestones []*gl.Milestone, latestFirst bool) {
+	sort.SliceStable(milestones, func(i, j int) bool {
+		a, b := milestones[i].DueDate, milestones[j].DueDate
+		switch {
+		case a == nil || b == nil:
+			return a != nil
+		case latestFirst:
+			return time.Time(*a).After(time.Time(*b))
+		default:
+			return time.Time(*a).Before(time.Time(*b))
+		}
+	})
+}
+
+// milestoneDetails summarises a milestone from its issues, counting days
+// left from now.
+func milestoneDetails(milestone *gl.Milestone, issues []*gl.Issue, now time.Time) tui.MilestoneDetails {
+	details := tui.MilestoneDetails{
+		ID:          milestone.ID,
+		IID:         milestone.IID,
+		GroupID:     milestone.GroupID,
+		State:       milestone.State,
+		Description: milestone.Description,
+		URL:         milestone.WebURL,
+	}
+	if milestone.StartDate != nil {
+		details.StartDate = milestone.StartDate.String()
+	}
+	if milestone.DueDate != nil {
+		details.DueDate = milestone.DueDate.String()
+		year, month, day := now.Date()
+		today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		details.DaysLeft = int(time.Time(*milestone.DueDate).Sub(today).Hours() / 24)
+	}
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+		details.TotalWeight += issue.Weight
+		if issue.State == "closed" {
+			details.ClosedIssues++
+			details.ClosedWeight += issue.Weight
+		} else {
+			details.OpenIssues++
+		}
+	}
+	return details
+}
+
+// LoadBoard loads an issue board as columns: the open issues in none of its
+// label lists, one column per label list in board order, and the closed
+// issues. Lists scoped to an assignee, milestone or iteration are left out.
+func (p *Provider) LoadBoard(ctx context.Context, query tui.BoardQuery) (tui.BoardResult, error) {
+	if p.projectPath == "" {
+		return tui.BoardResult{}, fmt.Errorf("no project context selected")
+	}
+	if query.PerList <= 0 {
+		query.PerList = 20
+	}
+
+	boards, err := p.client.ListIssueBoards(ctx, p.projectPath)
+	if err != nil {
+		return tui.BoardResult{}, err
+	}
+	var result tui.BoardResult
+	for _, board := range boards {
+		if board == nil {
+			continue
+		}
+		result.Boards = append(result.Boards, tui.IssueBoard{ID: board.ID, Name: board.Name})
+		if result.Board.ID == 0 || board.ID == query.BoardID {
+			result.Board = tui.IssueBoard{ID: board.ID, Name: board.Name}
+		}
+	}
+	if result.Board.ID == 0 {
+		return result, nil
+	}
+
+	lists, err := p.client.ListBoardLists(ctx, p.projectPath, result.Board.ID)
+	if err != nil {
+		return tui.BoardResult{}, err
+	}
+	labelLists := make([]*gl.BoardList, 0, len(lists))
+	for _, list := range lists {
+		if list != nil && list.Label != nil {
+			labelLists = append(labelLists, list)
+		}
+	}
+	sort.SliceStable(labelLists, func(i, j int) bool {
+		return labelLists[i].Position < labelLists[j].Position
+	})
+	labels := make([]string, 0, len(labelLists))
+	for _, list := range labelLists {
+		labels = append(labels, list.Label.Name)
+	}
+
+	result.Columns = append(result.Columns, tui.BoardColumn{Kind: tui.BoardListOpen, Title: "Open"})
+	for _, label := range labels {
+		result.Columns = append(result.Columns, tui.BoardColumn{Kind: tui.BoardListLabel, Title: label, Label: label})
+	}
+	result.Columns = append(result.Columns, tui.BoardColumn{Kind: tui.BoardListClosed, Title: "Closed"})
+
+	errs := make([]error, len(result.Columns))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, boardListConcurrency)
+	for i := range result.Columns {
+		opts := gitlab.IssueListOptions{State: "opened", PerPage: query.PerList}
+		switch column := result.Columns[i]; column.Kind {
+		case tui.BoardListOpen:
+			opts.NotLabels = labels
+		case tui.BoardListLabel:
+			opts.Labels = []string{column.Label}
+		case tui.BoardListClosed:
+			opts.State = "closed"
+		}
+		wg.Add(1)
+		go func(index int, opts gitlab.IssueListOptions) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			issues, next, listErr := p.client.ListIssues(ctx, p.projectPath, opts)
+			if listErr != nil {
+				errs[index] = listErr
+				return
+			}
+			column := &result.Columns[index]
+			column.HasMore = next
+			for _, issue := range issues {
+				if issue != nil {
+					column.Items = append(column.Items, issueListItem(issue))
+				}
+			}
+		}(i, opts)
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return tui.BoardResult{}, err
+	}
+	return result, nil
+}
+
+// LoadEpics lists the epics of the project's parent group and its subgroups.
+// Epics need GitLab Premium or Ultimate, so on other instances, and for
+// projects outside a group, this fails with gitlab.ErrEpicsUnavailable.
+func (p *Provider) LoadEpics(ctx context.Context, query tui.EpicQuery) (tui.EpicResult, error) {
+	if p.projectPath == "" {
+		return tui.EpicResult{}, fmt.Errorf("no project context selected")
+	}
+	slash := strings.LastIndex(p.projectPath, "/")
+	if slash <= 0 {
+		return tui.EpicResult{}, fmt.Errorf("project %q is not in a group: %w", p.projectPath, gitlab.ErrEpicsUnavailable)
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PerPage <= 0 {
+		query.PerPage = 25
+	}
+
+	epics, hasNextPage, err := p.client.ListGroupEpics(ctx, p.projectPath[:slash], gitlab.EpicListOptions{
+		State:   string(query.State),
+		Page:    int64(query.Page),
+		PerPage: query.PerPage,
+	})
+	if err != nil {
+		return tui.EpicResult{}, err
+	}
+	items := make([]tui.ListItem, 0, len(epics))
+	for _, epic := range epics {
+		if epic != nil {
+			items = append(items, epicListItem(epic))
+		}
+	}
+	return tui.EpicResult{Items: items, HasNextPage: hasNextPage}, nil
+}
+
+// LoadEpicChildren lists the child epics of an epic followed by the issues
+// linked to it, which may belong to any project of the group.
+func (p *Provider) LoadEpicChildren(ctx context.Context, epic tui.EpicDetails) ([]tui.ListItem, error) {
+	children, err := p.client.ListEpicChildren(ctx, epic.GroupID, epic.IID)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := p.client.ListEpicIssues(ctx, epic.GroupID, epic.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]tui.ListItem, 0, len(children)+len(issues))
+	for _, child := range children {
+		if child != nil {
+			items = append(items, epicListItem(child))
+		}
+	}
+	for _, issue := range issues {
+		if issue == nil {
+			continue
+		}
+		item := issueListItem(issue)
+		item.ProjectPath = cmp.Or(referenceProjectPath(issue.References, "#"), p.projectPath)
+		item.Subtitle = fmt.Sprintf("%s#%d • %s", item.ProjectPath, issue.IID, issue.State)
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// LoadEpicDetailData loads the comments and activities of an epic, which
+// only has notes: its system notes are its activities.
+func (p *Provider) LoadEpicDetailData(ctx context.Context, epic tui.EpicDetails) (tui.IssueDetailData, error) {
+	notes, err := p.client.ListEpicNotes(ctx, epic.GroupID, epic.ID)
+	if err != nil {
+		return tui.IssueDetailData{}, fmt.Errorf("load epic notes: %w", err)
+	}
+	comments, activities := splitIssueNotes(notes)
+	return newestIssueDetailData(comments, activities), nil
+}
+
+func epicListItem(epic *gl.Epic) tui.ListItem {
+	author := "-"
+	if epic.Author != nil {
+		author = displayName(epic.Author.Name, epic.Author.Username)
+	}
+	details := &tui.EpicDetails{
+		ID:        epic.ID,
+		IID:       epic.IID,
+		GroupID:   epic.GroupID,
+		Reference: epicReference(epic),
+	}
+	if epic.StartDate != nil {
+		details.StartDate = epic.StartDate.String()
+	}
+	if epic.DueDate != nil {
+		details.DueDate = epic.DueDate.String()
+	}
+	return tui.ListItem{
+		ID:       epic.ID,
+		Title:    epic.Title,
+		Subtitle: fmt.Sprintf("%s • %s", details.Reference, epic.State),
+		URL:      epic.WebURL,
+		Issue: &tui.IssueDetails{
+			State:        epic.State,
+			Author:       author,
+			Labels:       epic.Labels,
+			CreatedAt:    formatIssueTime(epic.CreatedAt),
+			UpdatedAt:    formatIssueTime(epic.UpdatedAt),
+			URL:          epic.WebURL,
+			Description:  epic.Description,
+			Confidential: epic.Confidential,
+		},
+		Epic: details,
+	}
+}
+
+// epicReference returns the full reference of an epic, such as "org/team&4",
+// from its web URL, since the API does not return one.
+func epicReference(epic *gl.Epic) string {
+	_, path, found := strings.Cut(epic.WebURL, "/groups/")
+	if !found {
+		return fmt.Sprintf("&%d", epic.IID)
+	}
+	group, _, _ := strings.Cut(path, "/-/")
+	return fmt.Sprintf("%s&%d", group, epic.IID)
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"testing"
+
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestGroupJobsByStageOrdersStagesByFirstJob(t *testing.T) {
+	t.Parallel()
+
+	jobs := []*gl.Job{
+		{ID: 30, Name: "deploy", Stage: "deploy", Status: "manual"},
+		{ID: 21, Name: "unit", Stage: "test", Status: "failed"},
+		{ID: 22, Name: "lint", Stage: "test", Status: "failed", AllowFailure: true},
+		{ID: 10, Name: "compile", Stage: "build", Status: "success"},
+	}
+
+	stages := groupJobsByStage(jobs)
+	if len(stages) != 3 {
+		t.Fatalf("stage count = %d want %d", len(stages), 3)
+	}
+	wantOrder := []string{"build", "test", "deploy"}
+	for i, want := range wantOrder {
+		if stages[i].Name != want {
+			t.Fatalf("stage %d = %q want %q", i, stages[i].Name, want)
+		}
+	}
+	if stages[1].Status != "failed" {
+		t.Fatalf("test stage status = %q want %q", stages[1].Status, "failed")
+	}
+	if stages[1].Jobs[0].Name != "lint" {
+		t.Fatalf("first test job = %q want %q", stages[1].Jobs[0].Name, "lint")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[float64]string{
+		0:      "-",
+		42:     "42s",
+		125:    "2m 05s",
+		3725.4: "1h 02m 05s",
+	}
+	for input, want := range tests {
+		if got := formatDuration(input); got != want {
+			t.Errorf("formatDuration(%v) = %q want %q", input, got, want)
+		}
+	}
+}
+
+func TestMergeRequestDetailFromDiscussionsSplitsSystemNotes(t *testing.T) {
+	t.Parallel()
+
+	discussions := []*gl.Discussion{
+		{ID: "sys", IndividualNote: true, Notes: []*gl.Note{{Body: "added 1 commit", System: true}}},
+		{ID: "inline", Notes: []*gl.Note{
+			{Body: "rename this", Resolvable: true, Resolved: true, Position: &gl.NotePosition{NewPath: "main.go", NewLine: 12}},
+			{Body: "done", Resolvable: true, Resolved: true},
+		}},
+	}
+	discussions[1].Notes[0].ResolvedBy.Username = "alice"
+
+	data := mergeRequestDetailFromDiscussions(discussions)
+	if len(data.Threads) != 1 {
+		t.Fatalf("thread count = %d want %d", len(data.Threads), 1)
+	}
+	thread := data.Threads[0]
+	if !thread.Resolved || thread.ResolvedBy != "alice" {
+		t.Fatalf("thread resolved = %v by %q want true by %q", thread.Resolved, thread.ResolvedBy, "alice")
+	}
+	if thread.Path != "main.go" || thread.NewLine != 12 || len(thread.Notes) != 2 {
+		t.Fatalf("thread = %+v want main.go:12 with 2 notes", thread)
+	}
+	if len(data.Activities) != 1 || data.Activities[0].Action != "added 1 commit" {
+		t.Fatalf("activities = %+v want the system note", data.Activities)
+	}
+}
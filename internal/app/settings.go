@@ -0,0 +1,121 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/davzucky/lazygitlab/internal/config"
+	"github.com/davzucky/lazygitlab/internal/tui"
+)
+
+// settings is the configuration shared by the parts of the dashboard that
+// write to the config file, so one does not save over the changes of
+// another.
+type settings struct {
+	mu  sync.Mutex
+	cfg config.Config
+}
+
+func newSettings(cfg config.Config) *settings {
+	return &settings{cfg: cfg}
+}
+
+func (s *settings) config() config.Config {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cfg
+}
+
+// update applies change and saves the result, returning the new
+// configuration even when saving fails.
+func (s *settings) update(change func(cfg *config.Config)) (config.Config, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	change(&s.cfg)
+	return s.cfg, config.Save(s.cfg)
+}
+
+func (s *settings) IssueFilters() []tui.SavedFilter {
+	cfg := s.config()
+	filters := make([]tui.SavedFilter, 0, len(cfg.SavedIssueFilters))
+	for _, saved := range cfg.SavedIssueFilters {
+		filters = append(filters, tui.SavedFilter{Name: saved.Name, Query: saved.Query})
+	}
+	return filters
+}
+
+func (s *settings) ListSort(view tui.ViewMode) (tui.ListSort, bool) {
+	key, ok := listViewKey(view)
+	if !ok {
+		return tui.ListSort{}, false
+	}
+	saved, ok := s.config().ListSorts[key]
+	if !ok {
+		return tui.ListSort{}, false
+	}
+	return tui.ListSort{Field: tui.SortField(saved.OrderBy), Ascending: saved.Sort == "asc"}, true
+}
+
+func (s *settings) SaveListSort(view tui.ViewMode, sort tui.ListSort) error {
+	key, ok := listViewKey(view)
+	if !ok {
+		return nil
+	}
+	_, err := s.update(func(cfg *config.Config) {
+		cfg.SetListSort(key, config.ListSort{OrderBy: string(sort.Field), Sort: sortDirection(sort)})
+	})
+	return err
+}
+
+func (s *settings) SavedViews() []tui.SavedView {
+	cfg := s.config()
+	views := make([]tui.SavedView, 0, len(cfg.SavedViews))
+	for _, saved := range cfg.SavedViews {
+		view := tui.IssuesView
+		if saved.View == "merge_requests" {
+			view = tui.MergeRequestsView
+		}
+		views = append(views, tui.SavedView{
+			Name:  saved.Name,
+			View:  view,
+			State: saved.State,
+			Query: saved.Query,
+			Sort:  tui.ListSort{Field: tui.SortField(saved.OrderBy), Ascending: saved.Sort == "asc"},
+		})
+	}
+	return views
+}
+
+func (s *settings) SaveView(view tui.SavedView) error {
+	key, ok := listViewKey(view.View)
+	if !ok {
+		return fmt.Errorf("only issue and merge request lists can be saved as views")
+	}
+	saved := config.SavedView{Name: view.Name, View: key, State: view.State, Query: view.Query}
+	if view.Sort.Field != "" {
+		saved.OrderBy = string(view.Sort.Field)
+		saved.Sort = sortDirection(view.Sort)
+	}
+	_, err := s.update(func(cfg *config.Config) {
+		cfg.SaveView(saved)
+	})
+	return err
+}
+
+// listViewKey names a list view in the list_sort and saved_views config.
+func listViewKey(view tui.ViewMode) (string, bool) {
+	switch view {
+	case tui.IssuesView:
+		return "issues", true
+	case tui.MergeRequestsView:
+		return "merge_requests", true
+	}
+	return "", false
+}
+
+func (s *settings) SaveIssueFilter(filter tui.SavedFilter) error {
+	_, err := s.update(func(cfg *config.Config) {
+		cfg.SaveIssueFilter(filter.Name, filter.Query)
+	})
+	return err
+}
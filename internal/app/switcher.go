@@ -0,0 +1,242 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davzucky/lazygitlab/internal/config"
+	"github.com/davzucky/lazygitlab/internal/gitlab"
+	"github.com/davzucky/lazygitlab/internal/tui"
+)
+
+// projectSwitcher moves the dashboard to another project or GitLab instance.
+// Every switch is saved as the last project and added to the instance's
+// recent projects in the config file, as are pinned favourites.
+type projectSwitcher struct {
+	logger   *log.Logger
+	settings *settings
+
+	mu      sync.Mutex
+	clients map[string]gitlab.Client
+}
+
+func newProjectSwitcher(settings *settings, client gitlab.Client, logger *log.Logger) *projectSwitcher {
+	return &projectSwitcher{
+		logger:   logger,
+		settings: settings,
+		clients:  map[string]gitlab.Client{strings.ToLower(settings.config().Host): client},
+	}
+}
+
+// LoadProjectOptions lists the favourite and recent projects of every
+// configured instance followed by their member projects, grouped by instance.
+// An instance that fails to answer is skipped unless all of them fail.
+func (s *projectSwitcher) LoadProjectOptions(ctx context.Context) ([]tui.ProjectOption, error) {
+	instances, err := s.instances()
+	if err != nil {
+		return nil, err
+	}
+
+	options := savedProjectOptions(s.settings.config(), instances)
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		seen[strings.ToLower(option.Host)+" "+option.Path] = true
+	}
+
+	var errs []error
+	for _, instance := range instances {
+		client, err := s.client(instance)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		listCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+		paths, err := listProjectPaths(listCtx, client)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", formatInstanceLabel(instance.Host), err))
+			continue
+		}
+		for _, path := range paths {
+			if seen[strings.ToLower(instance.Host)+" "+path] {
+				continue
+			}
+			options = append(options, tui.ProjectOption{Host: instance.Host, HostLabel: formatInstanceLabel(instance.Host), Path: path})
+		}
+	}
+	if len(errs) > 0 && len(errs) == len(instances) {
+		return nil, errors.Join(errs...)
+	}
+	for _, err := range errs {
+		s.logger.Printf("project switcher: %v", err)
+	}
+	return options, nil
+}
+
+// savedProjectOptions lists the favourite projects of every instance followed
+// by the recent ones that are not favourites. A favourite that is also recent
+// keeps its Recent flag, so unpinning it leaves it marked as recent.
+func savedProjectOptions(cfg config.Config, instances []config.Instance) []tui.ProjectOption {
+	var options []tui.ProjectOption
+	seen := make(map[string]bool)
+	for _, favorites := range []bool{true, false} {
+		for _, instance := range instances {
+			recent := cfg.RecentProjectsFor(instance.Host)
+			paths := recent
+			if favorites {
+				paths = cfg.FavoriteProjectsFor(instance.Host)
+			}
+			for _, path := range paths {
+				key := strings.ToLower(instance.Host) + " " + path
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				options = append(options, tui.ProjectOption{
+					Host:      instance.Host,
+					HostLabel: formatInstanceLabel(instance.Host),
+					Path:      path,
+					Recent:    slices.Contains(recent, path),
+					Favorite:  favorites,
+				})
+			}
+		}
+	}
+	return options
+}
+
+// SwitchProject connects to the option's instance and returns a provider and
+// dashboard context for the project.
+func (s *projectSwitcher) SwitchProject(ctx context.Context, option tui.ProjectOption) (tui.DataProvider, tui.DashboardContext, error) {
+	instances, err := s.instances()
+	if err != nil {
+		return nil, tui.DashboardContext{}, err
+	}
+	var instance config.Instance
+	for _, candidate := range instances {
+		if strings.EqualFold(candidate.Host, option.Host) {
+			instance = candidate
+			break
+		}
+	}
+	if instance.Host == "" {
+		return nil, tui.DashboardContext{}, fmt.Errorf("instance %q is not configured", option.Host)
+	}
+
+	client, err := s.client(instance)
+	if err != nil {
+		return nil, tui.DashboardContext{}, err
+	}
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, tui.DashboardContext{}, fmt.Errorf("validate token for %s: %w", formatInstanceLabel(instance.Host), err)
+	}
+	if _, err := client.GetProject(ctx, option.Path); err != nil {
+		return nil, tui.DashboardContext{}, err
+	}
+
+	cfg, err := s.settings.update(func(cfg *config.Config) {
+		cfg.Host = instance.Host
+		cfg.Token = instance.Token
+		cfg.LastProject = option.Path
+		cfg.AddRecentProject(instance.Host, option.Path)
+	})
+	if err != nil {
+		s.logger.Printf("failed to persist last project: %v", err)
+	}
+
+	return NewProvider(client, option.Path), tui.DashboardContext{
+		ProjectPath:        option.Path,
+		Connection:         fmt.Sprintf("Connected as %s", user.Username),
+		Host:               instance.Host,
+		User:               tui.CurrentUser{ID: user.ID, Username: user.Username, Name: user.Name},
+		IssueBranchPattern: cfg.IssueBranchPattern,
+		Switcher:           s,
+		Filters:            s.settings,
+		Sorts:              s.settings,
+		Views:              s.settings,
+	}, nil
+}
+
+// ToggleFavorite pins or unpins the option's project on its instance and
+// reports whether it is pinned now.
+func (s *projectSwitcher) ToggleFavorite(_ context.Context, option tui.ProjectOption) (bool, error) {
+	var favorite bool
+	if _, err := s.settings.update(func(cfg *config.Config) {
+		favorite = cfg.ToggleFavoriteProject(option.Host, option.Path)
+	}); err != nil {
+		return favorite, fmt.Errorf("save favourite projects: %w", err)
+	}
+	return favorite, nil
+}
+
+func (s *projectSwitcher) instances() ([]config.Instance, error) {
+	instances, err := config.LoadInstances()
+	if err != nil {
+		return nil, fmt.Errorf("load configured instances: %w", err)
+	}
+	cfg := s.settings.config()
+	current := config.Instance{Host: cfg.Host, Token: cfg.Token}
+	for _, instance := range instances {
+		if strings.EqualFold(instance.Host, current.Host) {
+			return instances, nil
+		}
+	}
+	return append([]config.Instance{current}, instances...), nil
+}
+
+func (s *projectSwitcher) client(instance config.Instance) (gitlab.Client, error) {
+	key := strings.ToLower(instance.Host)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if client, ok := s.clients[key]; ok {
+		return client, nil
+	}
+	client, err := gitlab.NewClient(instance.Token, instance.Host, s.logger)
+	if err != nil {
+		return nil, err
+	}
+	s.clients[key] = client
+	return client, nil
+}
+
+// startupProjectOptions lists the instance's favourite and recent projects
+// ahead of the member projects in paths.
+func startupProjectOptions(cfg config.Config, host string, paths []string) []tui.StartupProjectOption {
+	options := make([]tui.StartupProjectOption, 0, len(paths))
+	recent := cfg.RecentProjectsFor(host)
+	for _, path := range cfg.FavoriteProjectsFor(host) {
+		options = append(options, tui.StartupProjectOption{Path: path, Label: path, Favorite: true, Recent: slices.Contains(recent, path)})
+	}
+	for _, path := range recent {
+		options = append(options, tui.StartupProjectOption{Path: path, Label: path, Recent: true})
+	}
+	for _, path := range paths {
+		options = append(options, tui.StartupProjectOption{Path: path, Label: path})
+	}
+	return options
+}
+
+// listProjectPaths returns the paths of the projects the user is a member of.
+func listProjectPaths(ctx context.Context, client gitlab.Client) ([]string, error) {
+	projects, err := client.ListProjects(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(projects))
+	for _, project := range projects {
+		if project == nil {
+			continue
+		}
+		if path := strings.TrimSpace(project.PathWithNamespace); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
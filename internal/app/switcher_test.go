@@ -0,0 +1,56 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/davzucky/lazygitlab/internal/config"
+	"github.com/davzucky/lazygitlab/internal/tui"
+)
+
+func TestSavedProjectOptionsKeepRecentFlagOfFavorites(t *testing.T) {
+	t.Parallel()
+
+	var cfg config.Config
+	cfg.AddRecentProject("gitlab.com", "group/old")
+	cfg.AddRecentProject("gitlab.com", "group/both")
+	cfg.ToggleFavoriteProject("gitlab.com", "group/both")
+	cfg.ToggleFavoriteProject("gitlab.com", "group/pinned")
+	instances := []config.Instance{{Host: "https://gitlab.com"}}
+
+	got := savedProjectOptions(cfg, instances)
+	want := []tui.ProjectOption{
+		{Host: "https://gitlab.com", HostLabel: "gitlab.com", Path: "group/both", Recent: true, Favorite: true},
+		{Host: "https://gitlab.com", HostLabel: "gitlab.com", Path: "group/pinned", Favorite: true},
+		{Host: "https://gitlab.com", HostLabel: "gitlab.com", Path: "group/old", Recent: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("options = %+v want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("option %d = %+v want %+v", i, got[i], want[i])
+		}
+	}
+
+	if cfg.ToggleFavoriteProject("gitlab.com", "group/both") {
+		t.Fatal("ToggleFavoriteProject() should unpin group/both")
+	}
+	got = savedProjectOptions(cfg, instances)
+	if len(got) != 3 || got[1].Path != "group/both" || !got[1].Recent || got[1].Favorite {
+		t.Fatalf("options after unpinning = %+v want group/both listed as recent", got)
+	}
+}
+
+func TestStartupProjectOptionsMarkRecentFavorites(t *testing.T) {
+	t.Parallel()
+
+	var cfg config.Config
+	cfg.AddRecentProject("gitlab.com", "group/both")
+	cfg.ToggleFavoriteProject("gitlab.com", "group/both")
+	cfg.ToggleFavoriteProject("gitlab.com", "group/pinned")
+
+	options := startupProjectOptions(cfg, "https://gitlab.com", []string{"group/member"})
+	if len(options) != 4 || !options[0].Favorite || !options[0].Recent || options[1].Recent {
+		t.Fatalf("options = %+v want group/both pinned and recent, group/pinned only pinned", options)
+	}
+}
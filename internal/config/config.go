@@ -3,9 +3,11 @@ package config
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -16,6 +18,9 @@ const (
 	EnvGitLabHost  = "GITLAB_HOST"
 )
 
+// maxRecentProjects caps the most recently used list of each instance.
+const maxRecentProjects = 10
+
 var errHomeNotFound = errors.New("home directory not found")
 
 type Config struct {
@@ -23,6 +28,46 @@ type Config struct {
 	Token       string `yaml:"token"`
 	LastProject string `yaml:"last_project,omitempty"`
 	Debug       bool   `yaml:"debug,omitempty"`
+	// IssueBranchPattern names the branches created from issues; {iid},
+	// {title} and {username} are replaced with the issue IID, the slugified
+	// title and the current user. Empty uses GitLab's "{iid}-{title}".
+	IssueBranchPattern string `yaml:"issue_branch_pattern,omitempty"`
+	// RecentProjects are keyed by normalized instance host, most recently
+	// used first.
+	RecentProjects map[string][]string `yaml:"recent_projects,omitempty"`
+	// FavoriteProjects are keyed by normalized instance host.
+	FavoriteProjects map[string][]string `yaml:"favorite_projects,omitempty"`
+	// SavedIssueFilters are named filter queries, such as
+	// "label:bug assignee:@me".
+	SavedIssueFilters []SavedFilter `yaml:"saved_issue_filters,omitempty"`
+	// ListSorts holds the sort order picked for a list view, keyed by view,
+	// such as "issues".
+	ListSorts map[string]ListSort `yaml:"list_sort,omitempty"`
+	// SavedViews are named list views, in the order they are offered.
+	SavedViews []SavedView `yaml:"saved_views,omitempty"`
+}
+
+type SavedFilter struct {
+	Name  string `yaml:"name"`
+	Query string `yaml:"query"`
+}
+
+// ListSort uses GitLab's order_by and sort values, such as "due_date" and
+// "asc".
+type ListSort struct {
+	OrderBy string `yaml:"order_by"`
+	Sort    string `yaml:"sort"`
+}
+
+// SavedView is an "issues" or "merge_requests" list with its state, filter
+// query and sort.
+type SavedView struct {
+	Name    string `yaml:"name"`
+	View    string `yaml:"view"`
+	State   string `yaml:"state,omitempty"`
+	Query   string `yaml:"query,omitempty"`
+	OrderBy string `yaml:"order_by,omitempty"`
+	Sort    string `yaml:"sort,omitempty"`
 }
 
 type Instance struct {
@@ -42,6 +87,107 @@ func (c Config) NeedsSetup() bool {
 	return strings.TrimSpace(c.Token) == "" || strings.TrimSpace(c.Host) == ""
 }
 
+// RecentProjectsFor returns the recently used projects of host, most recent
+// first.
+func (c Config) RecentProjectsFor(host string) []string {
+	return c.RecentProjects[hostKey(host)]
+}
+
+// FavoriteProjectsFor returns the pinned projects of host in the order they
+// were pinned.
+func (c Config) FavoriteProjectsFor(host string) []string {
+	return c.FavoriteProjects[hostKey(host)]
+}
+
+// AddRecentProject moves projectPath to the front of the recent projects of
+// host, dropping the oldest entry past maxRecentProjects.
+func (c *Config) AddRecentProject(host string, projectPath string) {
+	projectPath = strings.TrimSpace(projectPath)
+	if projectPath == "" {
+		return
+	}
+	key := hostKey(host)
+	recent := []string{projectPath}
+	for _, path := range c.RecentProjects[key] {
+		if path != projectPath && len(recent) < maxRecentProjects {
+			recent = append(recent, path)
+		}
+	}
+	if c.RecentProjects == nil {
+		c.RecentProjects = make(map[string][]string)
+	}
+	c.RecentProjects[key] = recent
+}
+
+// ToggleFavoriteProject pins projectPath for host, or unpins it when it is
+// already pinned, and reports whether it is pinned now.
+func (c *Config) ToggleFavoriteProject(host string, projectPath string) bool {
+	projectPath = strings.TrimSpace(projectPath)
+	if projectPath == "" {
+		return false
+	}
+	key := hostKey(host)
+	favorites := c.FavoriteProjects[key]
+	if index := slices.Index(favorites, projectPath); index >= 0 {
+		favorites = slices.Delete(slices.Clone(favorites), index, index+1)
+		if len(favorites) == 0 {
+			delete(c.FavoriteProjects, key)
+		} else {
+			c.FavoriteProjects[key] = favorites
+		}
+		return false
+	}
+	if c.FavoriteProjects == nil {
+		c.FavoriteProjects = make(map[string][]string)
+	}
+	c.FavoriteProjects[key] = append(slices.Clone(favorites), projectPath)
+	return true
+}
+
+// SaveIssueFilter stores query under name, replacing a saved filter with the
+// same name.
+func (c *Config) SaveIssueFilter(name string, query string) {
+	filter := SavedFilter{Name: strings.TrimSpace(name), Query: strings.TrimSpace(query)}
+	index := slices.IndexFunc(c.SavedIssueFilters, func(saved SavedFilter) bool {
+		return strings.EqualFold(saved.Name, filter.Name)
+	})
+	c.SavedIssueFilters = slices.Clone(c.SavedIssueFilters)
+	if index >= 0 {
+		c.SavedIssueFilters[index] = filter
+		return
+	}
+	c.SavedIssueFilters = append(c.SavedIssueFilters, filter)
+}
+
+// SaveView stores view, replacing a saved view with the same name in place.
+func (c *Config) SaveView(view SavedView) {
+	view.Name = strings.TrimSpace(view.Name)
+	index := slices.IndexFunc(c.SavedViews, func(saved SavedView) bool {
+		return strings.EqualFold(saved.Name, view.Name)
+	})
+	c.SavedViews = slices.Clone(c.SavedViews)
+	if index >= 0 {
+		c.SavedViews[index] = view
+		return
+	}
+	c.SavedViews = append(c.SavedViews, view)
+}
+
+// SetListSort remembers the sort order of a list view.
+func (c *Config) SetListSort(view string, sort ListSort) {
+	sorts := make(map[string]ListSort, len(c.ListSorts)+1)
+	maps.Copy(sorts, c.ListSorts)
+	sorts[view] = sort
+	c.ListSorts = sorts
+}
+
+func hostKey(host string) string {
+	if normalized, err := NormalizeHost(host); err == nil {
+		return normalized
+	}
+	return strings.TrimSpace(host)
+}
+
 func Load() (Config, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
@@ -263,6 +409,24 @@ func merge(base Config, override Config) Config {
 	if strings.TrimSpace(override.LastProject) != "" {
 		merged.LastProject = strings.TrimSpace(override.LastProject)
 	}
+	if strings.TrimSpace(override.IssueBranchPattern) != "" {
+		merged.IssueBranchPattern = strings.TrimSpace(override.IssueBranchPattern)
+	}
+	if len(override.RecentProjects) > 0 {
+		merged.RecentProjects = override.RecentProjects
+	}
+	if len(override.FavoriteProjects) > 0 {
+		merged.FavoriteProjects = override.FavoriteProjects
+	}
+	if len(override.SavedIssueFilters) > 0 {
+		merged.SavedIssueFilters = override.SavedIssueFilters
+	}
+	if len(override.ListSorts) > 0 {
+		merged.ListSorts = override.ListSorts
+	}
+	if len(override.SavedViews) > 0 {
+		merged.SavedViews = override.SavedViews
+	}
 	merged.Debug = merged.Debug || override.Debug
 	return merged
 }
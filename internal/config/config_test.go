@@ -1,8 +1,11 @@
 package config
 
 import (
+	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -52,7 +55,7 @@ func TestLoadPrecedence(t *testing.T) {
 	if err := os.MkdirAll(lazyDir, 0o755); err != nil {
 		t.Fatal(err)
 	}
-	lazyConfig := "host: https://gitlab.self/api/v4\ntoken: lazy-token\n"
+	lazyConfig := "host: https://gitlab.self/api/v4\ntoken: lazy-token\nissue_branch_pattern: \"{username}/{iid}-{title}\"\n"
 	if err := os.WriteFile(filepath.Join(lazyDir, "config.yml"), []byte(lazyConfig), 0o600); err != nil {
 		t.Fatal(err)
 	}
@@ -71,6 +74,9 @@ func TestLoadPrecedence(t *testing.T) {
 	if cfg.Host != "https://env.gitlab.local/api/v4" {
 		t.Fatalf("Host = %q want https://env.gitlab.local/api/v4", cfg.Host)
 	}
+	if cfg.IssueBranchPattern != "{username}/{iid}-{title}" {
+		t.Fatalf("IssueBranchPattern = %q want the lazygitlab config value", cfg.IssueBranchPattern)
+	}
 }
 
 func TestLoadInstancesIncludesAllSources(t *testing.T) {
@@ -131,3 +137,126 @@ func TestLoadInstancesIncludesAllSources(t *testing.T) {
 		}
 	}
 }
+
+func TestRecentAndFavoriteProjects(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(EnvGitLabHost, "")
+	t.Setenv(EnvGitLabToken, "")
+
+	cfg := Config{Host: "https://gitlab.com/api/v4", Token: "token"}
+	for i := range maxRecentProjects + 2 {
+		cfg.AddRecentProject("gitlab.com", fmt.Sprintf("group/project-%d", i))
+	}
+	cfg.AddRecentProject("https://gitlab.com/api/v4", "group/project-5")
+
+	recent := cfg.RecentProjectsFor("https://gitlab.com")
+	if len(recent) != maxRecentProjects || recent[0] != "group/project-5" || recent[1] != "group/project-11" {
+		t.Fatalf("recent projects = %v want group/project-5 first and %d entries", recent, maxRecentProjects)
+	}
+	if slices.Contains(recent, "group/project-0") {
+		t.Fatalf("recent projects = %v want the oldest entry dropped", recent)
+	}
+
+	if !cfg.ToggleFavoriteProject("gitlab.com", "group/pinned") || !cfg.ToggleFavoriteProject("gitlab.com", "group/other") {
+		t.Fatal("ToggleFavoriteProject() should pin new projects")
+	}
+	if cfg.ToggleFavoriteProject("gitlab.com", "group/other") {
+		t.Fatal("ToggleFavoriteProject() should unpin a pinned project")
+	}
+
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if favorites := loaded.FavoriteProjectsFor("gitlab.com"); !slices.Equal(favorites, []string{"group/pinned"}) {
+		t.Fatalf("favorite projects = %v want [group/pinned]", favorites)
+	}
+	if !slices.Equal(loaded.RecentProjectsFor("gitlab.com"), recent) {
+		t.Fatalf("recent projects after reload = %v want %v", loaded.RecentProjectsFor("gitlab.com"), recent)
+	}
+}
+
+func TestSetListSortPersistsPerView(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(EnvGitLabHost, "")
+	t.Setenv(EnvGitLabToken, "")
+
+	cfg := Config{Host: "https://gitlab.com/api/v4", Token: "token"}
+	cfg.SetListSort("issues", ListSort{OrderBy: "created_at", Sort: "asc"})
+	cfg.SetListSort("merge_requests", ListSort{OrderBy: "title", Sort: "asc"})
+	cfg.SetListSort("issues", ListSort{OrderBy: "due_date", Sort: "asc"})
+
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := map[string]ListSort{
+		"issues":         {OrderBy: "due_date", Sort: "asc"},
+		"merge_requests": {OrderBy: "title", Sort: "asc"},
+	}
+	if !maps.Equal(loaded.ListSorts, want) {
+		t.Fatalf("list sorts = %+v want %+v", loaded.ListSorts, want)
+	}
+}
+
+func TestSaveViewReplacesByNameInPlace(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(EnvGitLabHost, "")
+	t.Setenv(EnvGitLabToken, "")
+
+	cfg := Config{Host: "https://gitlab.com/api/v4", Token: "token"}
+	cfg.SaveView(SavedView{Name: "Triage", View: "issues", State: "opened", Query: "label:bug"})
+	cfg.SaveView(SavedView{Name: "Reviews", View: "merge_requests", Query: "reviewer:@me", OrderBy: "created_at", Sort: "asc"})
+	cfg.SaveView(SavedView{Name: " triage ", View: "issues", State: "all", Query: "label:bug assignee:none"})
+
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []SavedView{
+		{Name: "triage", View: "issues", State: "all", Query: "label:bug assignee:none"},
+		{Name: "Reviews", View: "merge_requests", Query: "reviewer:@me", OrderBy: "created_at", Sort: "asc"},
+	}
+	if !slices.Equal(loaded.SavedViews, want) {
+		t.Fatalf("saved views = %+v want %+v", loaded.SavedViews, want)
+	}
+}
+
+func TestSaveIssueFilterReplacesByName(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(EnvGitLabHost, "")
+	t.Setenv(EnvGitLabToken, "")
+
+	cfg := Config{Host: "https://gitlab.com/api/v4", Token: "token"}
+	cfg.SaveIssueFilter("Triage", "label:bug")
+	cfg.SaveIssueFilter("Mine", "assignee:@me")
+	cfg.SaveIssueFilter("triage", `label:bug -label:wontfix milestone:"16.4"`)
+
+	if err := Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []SavedFilter{
+		{Name: "triage", Query: `label:bug -label:wontfix milestone:"16.4"`},
+		{Name: "Mine", Query: "assignee:@me"},
+	}
+	if !slices.Equal(loaded.SavedIssueFilters, want) {
+		t.Fatalf("saved filters = %+v want %+v", loaded.SavedIssueFilters, want)
+	}
+}
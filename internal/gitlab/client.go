@@ -2,12 +2,16 @@ package gitlab
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
@@ -23,22 +27,208 @@ type Client interface {
 	ListIssues(ctx context.Context, projectPath string, opts IssueListOptions) ([]*gl.Issue, bool, error)
 	ListIssueNotes(ctx context.Context, projectPath string, issueIID int64) ([]*gl.Note, error)
 	ListIssueStateEvents(ctx context.Context, projectPath string, issueIID int64) ([]*gl.StateEvent, error)
+	ListIssueLinks(ctx context.Context, projectPath string, issueIID int64) ([]*gl.IssueRelation, error)
+	ListMergeRequestsClosingIssue(ctx context.Context, projectPath string, issueIID int64) ([]*gl.BasicMergeRequest, error)
+	ListRelatedMergeRequests(ctx context.Context, projectPath string, issueIID int64) ([]*gl.BasicMergeRequest, error)
 	ListMergeRequests(ctx context.Context, projectPath string, opts MergeRequestListOptions) ([]*gl.BasicMergeRequest, bool, error)
+	ListPipelines(ctx context.Context, projectPath string, opts PipelineListOptions) ([]*gl.PipelineInfo, bool, error)
+	GetPipeline(ctx context.Context, projectPath string, pipelineID int64) (*gl.Pipeline, error)
+	ListPipelineJobs(ctx context.Context, projectPath string, pipelineID int64) ([]*gl.Job, error)
+	ListMergeRequestDiffs(ctx context.Context, projectPath string, mergeRequestIID int64) ([]*gl.MergeRequestDiff, error)
+	ListMergeRequestDiscussions(ctx context.Context, projectPath string, mergeRequestIID int64) ([]*gl.Discussion, error)
+	ListMergeRequestPipelines(ctx context.Context, projectPath string, mergeRequestIID int64) ([]*gl.PipelineInfo, error)
+	GetJob(ctx context.Context, projectPath string, jobID int64) (*gl.Job, error)
+	GetJobTrace(ctx context.Context, projectPath string, jobID int64, offset int64) (JobTrace, error)
+	CreateIssueNote(ctx context.Context, projectPath string, issueIID int64, body string) (*gl.Note, error)
+	CreateMergeRequestNote(ctx context.Context, projectPath string, mergeRequestIID int64, body string) (*gl.Note, error)
+	AddDiscussionNote(ctx context.Context, projectPath string, mergeRequestIID int64, discussionID string, body string) (*gl.Note, error)
+	CreateIssue(ctx context.Context, projectPath string, opts CreateIssueOptions) (*gl.Issue, error)
+	ListLabels(ctx context.Context, projectPath string) ([]*gl.Label, error)
+	ListProjectMembers(ctx context.Context, projectPath string) ([]*gl.ProjectMember, error)
+	ListMilestones(ctx context.Context, projectPath string, state string) ([]*gl.Milestone, error)
+	GetMilestoneIssues(ctx context.Context, projectPath string, milestone MilestoneRef) ([]*gl.Issue, error)
+	GetMilestoneMergeRequests(ctx context.Context, projectPath string, milestone MilestoneRef) ([]*gl.BasicMergeRequest, error)
+	ListIssueBoards(ctx context.Context, projectPath string) ([]*gl.IssueBoard, error)
+	ListBoardLists(ctx context.Context, projectPath string, boardID int64) ([]*gl.BoardList, error)
+	UpdateIssue(ctx context.Context, projectPath string, issueIID int64, opts UpdateIssueOptions) (*gl.Issue, error)
+	GetMergeRequestApprovals(ctx context.Context, projectPath string, mergeRequestIID int64) (*gl.MergeRequestApprovals, error)
+	GetMergeRequestApprovalState(ctx context.Context, projectPath string, mergeRequestIID int64) (*gl.MergeRequestApprovalState, error)
+	ApproveMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) error
+	UnapproveMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) error
+	AcceptMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64, opts AcceptMergeRequestOptions) (*gl.MergeRequest, error)
+	RebaseMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) error
+	UpdateMergeRequestState(ctx context.Context, projectPath string, mergeRequestIID int64, stateEvent string) (*gl.MergeRequest, error)
+	CreateMergeRequest(ctx context.Context, projectPath string, opts CreateMergeRequestOptions) (*gl.MergeRequest, error)
+	ListBranches(ctx context.Context, projectPath string) ([]*gl.Branch, error)
+	CreateBranch(ctx context.Context, projectPath string, branch string, ref string) (*gl.Branch, error)
+	GetMergeRequestTemplate(ctx context.Context, projectPath string, name string) (string, error)
+	GetIssue(ctx context.Context, projectPath string, issueIID int64) (*gl.Issue, error)
+	GetMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) (*gl.MergeRequest, error)
+	ListTodos(ctx context.Context, opts TodoListOptions) ([]*gl.Todo, bool, error)
+	MarkTodoDone(ctx context.Context, todoID int64) error
+	MarkAllTodosDone(ctx context.Context) error
+	ListGlobalIssues(ctx context.Context, opts GlobalIssueListOptions) ([]*gl.Issue, bool, error)
+	ListGlobalMergeRequests(ctx context.Context, opts GlobalMergeRequestListOptions) ([]*gl.BasicMergeRequest, bool, error)
+	ListGroups(ctx context.Context, opts GroupListOptions) ([]*gl.Group, bool, error)
+	ListSubgroups(ctx context.Context, groupPath string, opts GroupListOptions) ([]*gl.Group, bool, error)
+	ListGroupProjects(ctx context.Context, groupPath string, opts GroupListOptions) ([]*gl.Project, bool, error)
+	ListGroupIssues(ctx context.Context, groupPath string, opts IssueListOptions) ([]*gl.Issue, bool, error)
+	ListGroupMergeRequests(ctx context.Context, groupPath string, opts MergeRequestListOptions) ([]*gl.BasicMergeRequest, bool, error)
+	ListGroupEpics(ctx context.Context, groupPath string, opts EpicListOptions) ([]*gl.Epic, bool, error)
+	ListEpicChildren(ctx context.Context, groupID int64, epicIID int64) ([]*gl.Epic, error)
+	ListEpicIssues(ctx context.Context, groupID int64, epicIID int64) ([]*gl.Issue, error)
+	ListEpicNotes(ctx context.Context, groupID int64, epicID int64) ([]*gl.Note, error)
 }
 
+// IssueListOptions filters a project's issues. Empty fields do not filter.
+// AssigneeUsername, Milestone and Weight also take GitLab's "None" and "Any",
+// and DueDate takes its due_date values such as "overdue" or "week".
 type IssueListOptions struct {
+	State               string
+	Search              string
+	Labels              []string
+	NotLabels           []string
+	AssigneeUsername    string
+	NotAssigneeUsername string
+	AuthorUsername      string
+	NotAuthorUsername   string
+	Milestone           string
+	NotMilestone        string
+	Weight              string
+	DueDate             string
+	OrderBy             string
+	Sort                string
+	Page                int64
+	PerPage             int
+}
+
+// MergeRequestListOptions lists the merge requests of a project or group.
+// AssigneeUsername, ReviewerUsername and ApprovedByUsername also take
+// GitLab's "None" and "Any"; Draft, when set, keeps only draft or only ready
+// merge requests.
+type MergeRequestListOptions struct {
+	State              string
+	Search             string
+	Labels             []string
+	NotLabels          []string
+	AuthorUsername     string
+	NotAuthorUsername  string
+	AssigneeUsername   string
+	ReviewerUsername   string
+	ApprovedByUsername string
+	TargetBranch       string
+	Draft              *bool
+	OrderBy            string
+	Sort               string
+	Page               int64
+	PerPage            int
+}
+
+// GlobalIssueListOptions lists issues across every project visible to the
+// user. Scope is one of GitLab's created_by_me, assigned_to_me or all.
+type GlobalIssueListOptions struct {
+	Scope   string
 	State   string
-	Search  string
 	Page    int64
 	PerPage int
 }
 
-type MergeRequestListOptions struct {
+// GlobalMergeRequestListOptions lists merge requests across every project
+// visible to the user. ReviewerID, when set, keeps the merge requests that
+// user is a reviewer on.
+type GlobalMergeRequestListOptions struct {
+	Scope      string
+	State      string
+	ReviewerID int64
+	Page       int64
+	PerPage    int
+}
+
+// GroupListOptions pages through groups, subgroups or the projects of a
+// group, which are all listed by name.
+type GroupListOptions struct {
+	Page    int64
+	PerPage int
+}
+
+// EpicListOptions filters the epics of a group by state, "opened", "closed"
+// or "all".
+type EpicListOptions struct {
 	State   string
 	Page    int64
 	PerPage int
 }
 
+// ErrEpicsUnavailable is returned by the epic calls when the instance answers
+// 403 or 404, as GitLab Free does since epics need Premium or Ultimate.
+var ErrEpicsUnavailable = errors.New("epics are not available: they need GitLab Premium or Ultimate and access to the group")
+
+type PipelineListOptions struct {
+	Page    int64
+	PerPage int
+}
+
+// TodoListOptions filters the pending to-do items of the current user. An
+// empty Action lists every kind.
+type TodoListOptions struct {
+	Action  string
+	Page    int64
+	PerPage int
+}
+
+// CreateIssueOptions describes a new issue. Empty fields are not sent.
+type CreateIssueOptions struct {
+	Title        string
+	Description  string
+	Labels       []string
+	AssigneeIDs  []int64
+	MilestoneID  int64
+	DueDate      *time.Time
+	Confidential bool
+}
+
+// UpdateIssueOptions describes a partial issue update. Nil pointers and empty
+// label lists leave the matching attribute untouched; an empty AssigneeIDs
+// slice unassigns everyone and a zero MilestoneID removes the milestone.
+type UpdateIssueOptions struct {
+	StateEvent   string
+	AssigneeIDs  *[]int64
+	AddLabels    []string
+	RemoveLabels []string
+	MilestoneID  *int64
+	Confidential *bool
+}
+
+// AcceptMergeRequestOptions controls how a merge request is merged. AutoMerge
+// sets the merge request to merge once its pipeline succeeds instead of
+// merging straight away.
+type AcceptMergeRequestOptions struct {
+	Squash             bool
+	RemoveSourceBranch bool
+	AutoMerge          bool
+}
+
+// CreateMergeRequestOptions describes a new merge request. Empty fields are
+// not sent.
+type CreateMergeRequestOptions struct {
+	Title        string
+	Description  string
+	SourceBranch string
+	TargetBranch string
+	Labels       []string
+	ReviewerIDs  []int64
+	Squash       bool
+}
+
+// JobTrace is a chunk of a job log. Offset is the byte position to pass to the
+// next GetJobTrace call; Reset reports that the log shrank (for example after a
+// retry) and Content holds the whole trace rather than an appended chunk.
+type JobTrace struct {
+	Content []byte
+	Offset  int64
+	Reset   bool
+}
+
 type client struct {
 	api    *gl.Client
 	logger *log.Logger
@@ -136,10 +326,11 @@ func (c *client) ListIssues(ctx context.Context, projectPath string, opts IssueL
 		opts.PerPage = defaultPerPage
 	}
 
+	orderBy, sort := listOrder(opts.OrderBy, opts.Sort)
 	apiOpts := &gl.ListProjectIssuesOptions{
 		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
-		OrderBy:     gl.Ptr("updated_at"),
-		Sort:        gl.Ptr("desc"),
+		OrderBy:     orderBy,
+		Sort:        sort,
 	}
 	if opts.State != "" {
 		apiOpts.State = gl.Ptr(opts.State)
@@ -147,12 +338,46 @@ func (c *client) ListIssues(ctx context.Context, projectPath string, opts IssueL
 	if opts.Search != "" {
 		apiOpts.Search = gl.Ptr(opts.Search)
 	}
+	if len(opts.Labels) > 0 {
+		apiOpts.Labels = gl.Ptr(gl.LabelOptions(opts.Labels))
+	}
+	if len(opts.NotLabels) > 0 {
+		apiOpts.NotLabels = gl.Ptr(gl.LabelOptions(opts.NotLabels))
+	}
+	if value, ok := userIDKeyword(opts.AssigneeUsername); ok {
+		apiOpts.AssigneeID = gl.AssigneeID(value)
+	} else if opts.AssigneeUsername != "" {
+		apiOpts.AssigneeUsername = gl.Ptr(opts.AssigneeUsername)
+	}
+	if opts.NotAssigneeUsername != "" {
+		apiOpts.NotAssigneeUsername = gl.Ptr(opts.NotAssigneeUsername)
+	}
+	if opts.AuthorUsername != "" {
+		apiOpts.AuthorUsername = gl.Ptr(opts.AuthorUsername)
+	}
+	if opts.NotAuthorUsername != "" {
+		apiOpts.NotAuthorUsername = gl.Ptr(opts.NotAuthorUsername)
+	}
+	if opts.Milestone != "" {
+		apiOpts.Milestone = gl.Ptr(opts.Milestone)
+	}
+	if opts.NotMilestone != "" {
+		apiOpts.NotMilestone = gl.Ptr(opts.NotMilestone)
+	}
+	if opts.DueDate != "" {
+		apiOpts.DueDate = gl.Ptr(opts.DueDate)
+	}
+	requestOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+	if opts.Weight != "" {
+		// The client library has no weight option, the API does.
+		requestOpts = append(requestOpts, withQueryParam("weight", opts.Weight))
+	}
 
 	var issues []*gl.Issue
 	var resp *gl.Response
 	err := c.withRetry(ctx, "ListIssues", func() (*gl.Response, error) {
 		var err error
-		issues, resp, err = c.api.Issues.ListProjectIssues(projectPath, apiOpts, gl.WithContext(ctx))
+		issues, resp, err = c.api.Issues.ListProjectIssues(projectPath, apiOpts, requestOpts...)
 		return resp, err
 	})
 	if err != nil {
@@ -223,6 +448,86 @@ func (c *client) ListIssueStateEvents(ctx context.Context, projectPath string, i
 	return all, nil
 }
 
+// ListIssueLinks returns the issues linked to an issue, each with the type of
+// its link.
+func (c *client) ListIssueLinks(ctx context.Context, projectPath string, issueIID int64) ([]*gl.IssueRelation, error) {
+	var relations []*gl.IssueRelation
+	err := c.withRetry(ctx, "ListIssueLinks", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		relations, resp, err = c.api.IssueLinks.ListIssueRelations(projectPath, issueIID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list links of issue %d in project %q: %w", issueIID, projectPath, err)
+	}
+	return relations, nil
+}
+
+// ListMergeRequestsClosingIssue returns every merge request that closes an
+// issue once merged.
+func (c *client) ListMergeRequestsClosingIssue(ctx context.Context, projectPath string, issueIID int64) ([]*gl.BasicMergeRequest, error) {
+	all := make([]*gl.BasicMergeRequest, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListMergeRequestsClosingIssueOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var mrs []*gl.BasicMergeRequest
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListMergeRequestsClosingIssue", func() (*gl.Response, error) {
+			var err error
+			mrs, resp, err = c.api.Issues.ListMergeRequestsClosingIssue(projectPath, issueIID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list merge requests closing issue %d in project %q: %w", issueIID, projectPath, err)
+		}
+
+		all = append(all, mrs...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListRelatedMergeRequests returns every merge request that mentions an
+// issue.
+func (c *client) ListRelatedMergeRequests(ctx context.Context, projectPath string, issueIID int64) ([]*gl.BasicMergeRequest, error) {
+	all := make([]*gl.BasicMergeRequest, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListMergeRequestsRelatedToIssueOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var mrs []*gl.BasicMergeRequest
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListRelatedMergeRequests", func() (*gl.Response, error) {
+			var err error
+			mrs, resp, err = c.api.Issues.ListMergeRequestsRelatedToIssue(projectPath, issueIID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list merge requests related to issue %d in project %q: %w", issueIID, projectPath, err)
+		}
+
+		all = append(all, mrs...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
 func (c *client) ListMergeRequests(ctx context.Context, projectPath string, opts MergeRequestListOptions) ([]*gl.BasicMergeRequest, bool, error) {
 	if opts.Page <= 0 {
 		opts.Page = 1
@@ -231,20 +536,57 @@ func (c *client) ListMergeRequests(ctx context.Context, projectPath string, opts
 		opts.PerPage = defaultPerPage
 	}
 
+	orderBy, sort := listOrder(opts.OrderBy, opts.Sort)
 	apiOpts := &gl.ListProjectMergeRequestsOptions{
 		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
-		OrderBy:     gl.Ptr("updated_at"),
-		Sort:        gl.Ptr("desc"),
+		OrderBy:     orderBy,
+		Sort:        sort,
+		Draft:       opts.Draft,
 	}
 	if opts.State != "" {
 		apiOpts.State = gl.Ptr(opts.State)
 	}
+	if opts.Search != "" {
+		apiOpts.Search = gl.Ptr(opts.Search)
+	}
+	if len(opts.Labels) > 0 {
+		apiOpts.Labels = gl.Ptr(gl.LabelOptions(opts.Labels))
+	}
+	if len(opts.NotLabels) > 0 {
+		apiOpts.NotLabels = gl.Ptr(gl.LabelOptions(opts.NotLabels))
+	}
+	if opts.AuthorUsername != "" {
+		apiOpts.AuthorUsername = gl.Ptr(opts.AuthorUsername)
+	}
+	if opts.NotAuthorUsername != "" {
+		apiOpts.NotAuthorUsername = gl.Ptr(opts.NotAuthorUsername)
+	}
+	if opts.TargetBranch != "" {
+		apiOpts.TargetBranch = gl.Ptr(opts.TargetBranch)
+	}
+	requestOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+	if value, ok := userIDKeyword(opts.AssigneeUsername); ok {
+		apiOpts.AssigneeID = gl.AssigneeID(value)
+	} else if opts.AssigneeUsername != "" {
+		// The client library only filters merge requests by assignee ID.
+		requestOpts = append(requestOpts, withQueryParam("assignee_username", opts.AssigneeUsername))
+	}
+	if value, ok := userIDKeyword(opts.ReviewerUsername); ok {
+		apiOpts.ReviewerID = gl.ReviewerID(value)
+	} else if opts.ReviewerUsername != "" {
+		apiOpts.ReviewerUsername = gl.Ptr(opts.ReviewerUsername)
+	}
+	if value, ok := userIDKeyword(opts.ApprovedByUsername); ok {
+		apiOpts.ApprovedByIDs = gl.ApproverIDs(value)
+	} else if opts.ApprovedByUsername != "" {
+		requestOpts = append(requestOpts, withQueryParam("approved_by_usernames[]", opts.ApprovedByUsername))
+	}
 
 	var mrs []*gl.BasicMergeRequest
 	var resp *gl.Response
 	err := c.withRetry(ctx, "ListMergeRequests", func() (*gl.Response, error) {
 		var err error
-		mrs, resp, err = c.api.MergeRequests.ListProjectMergeRequests(projectPath, apiOpts, gl.WithContext(ctx))
+		mrs, resp, err = c.api.MergeRequests.ListProjectMergeRequests(projectPath, apiOpts, requestOpts...)
 		return resp, err
 	})
 	if err != nil {
@@ -255,57 +597,1384 @@ func (c *client) ListMergeRequests(ctx context.Context, projectPath string, opts
 	return mrs, hasNextPage, nil
 }
 
-func (c *client) withRetry(ctx context.Context, operation string, fn func() (*gl.Response, error)) error {
-	var lastErr error
+func (c *client) ListPipelines(ctx context.Context, projectPath string, opts PipelineListOptions) ([]*gl.PipelineInfo, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		if ctx.Err() != nil {
-			return ctx.Err()
+	apiOpts := &gl.ListProjectPipelinesOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     gl.Ptr("id"),
+		Sort:        gl.Ptr("desc"),
+	}
+
+	var pipelines []*gl.PipelineInfo
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListPipelines", func() (*gl.Response, error) {
+		var err error
+		pipelines, resp, err = c.api.Pipelines.ListProjectPipelines(projectPath, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list pipelines for project %q: %w", projectPath, err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return pipelines, hasNextPage, nil
+}
+
+func (c *client) GetPipeline(ctx context.Context, projectPath string, pipelineID int64) (*gl.Pipeline, error) {
+	var pipeline *gl.Pipeline
+	err := c.withRetry(ctx, "GetPipeline", func() (*gl.Response, error) {
+		var err error
+		var resp *gl.Response
+		pipeline, resp, err = c.api.Pipelines.GetPipeline(projectPath, pipelineID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline %d in project %q: %w", pipelineID, projectPath, err)
+	}
+	if pipeline == nil {
+		return nil, fmt.Errorf("pipeline not found: %d", pipelineID)
+	}
+
+	return pipeline, nil
+}
+
+func (c *client) ListPipelineJobs(ctx context.Context, projectPath string, pipelineID int64) ([]*gl.Job, error) {
+	all := make([]*gl.Job, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListJobsOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
 		}
 
-		resp, err := fn()
-		if err == nil {
-			return nil
+		var jobs []*gl.Job
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListPipelineJobs", func() (*gl.Response, error) {
+			var err error
+			jobs, resp, err = c.api.Jobs.ListPipelineJobs(projectPath, pipelineID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list jobs for pipeline %d in project %q: %w", pipelineID, projectPath, err)
 		}
 
-		lastErr = err
-		if !isRetryable(resp) || attempt == maxRetries-1 {
+		all = append(all, jobs...)
+		if resp == nil || resp.NextPage == 0 {
 			break
 		}
+		page = resp.NextPage
+	}
 
-		delay := retryDelay(resp, attempt)
-		c.logger.Printf("retrying %s attempt=%d delay=%s err=%v", operation, attempt+2, delay, err)
-		timer := time.NewTimer(delay)
-		select {
-		case <-ctx.Done():
-			timer.Stop()
-			return ctx.Err()
-		case <-timer.C:
+	return all, nil
+}
+
+func (c *client) ListMergeRequestDiffs(ctx context.Context, projectPath string, mergeRequestIID int64) ([]*gl.MergeRequestDiff, error) {
+	all := make([]*gl.MergeRequestDiff, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListMergeRequestDiffsOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var diffs []*gl.MergeRequestDiff
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListMergeRequestDiffs", func() (*gl.Response, error) {
+			var err error
+			diffs, resp, err = c.api.MergeRequests.ListMergeRequestDiffs(projectPath, mergeRequestIID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list diffs for merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+		}
+
+		all = append(all, diffs...)
+		if resp == nil || resp.NextPage == 0 {
+			break
 		}
+		page = resp.NextPage
 	}
 
-	return lastErr
+	return all, nil
 }
 
-func isRetryable(resp *gl.Response) bool {
-	if resp == nil || resp.Response == nil {
-		return true
+func (c *client) ListMergeRequestDiscussions(ctx context.Context, projectPath string, mergeRequestIID int64) ([]*gl.Discussion, error) {
+	all := make([]*gl.Discussion, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListMergeRequestDiscussionsOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var discussions []*gl.Discussion
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListMergeRequestDiscussions", func() (*gl.Response, error) {
+			var err error
+			discussions, resp, err = c.api.Discussions.ListMergeRequestDiscussions(projectPath, mergeRequestIID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list discussions for merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+		}
+
+		all = append(all, discussions...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
 	}
 
-	status := resp.StatusCode
-	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+	return all, nil
 }
 
-func retryDelay(resp *gl.Response, attempt int) time.Duration {
-	if resp != nil && resp.Response != nil {
-		retryAfter := resp.Header.Get("Retry-After")
-		if retryAfter != "" {
-			if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds > 0 {
-				return time.Duration(seconds) * time.Second
-			}
+func (c *client) ListMergeRequestPipelines(ctx context.Context, projectPath string, mergeRequestIID int64) ([]*gl.PipelineInfo, error) {
+	var pipelines []*gl.PipelineInfo
+	err := c.withRetry(ctx, "ListMergeRequestPipelines", func() (*gl.Response, error) {
+		var err error
+		var resp *gl.Response
+		pipelines, resp, err = c.api.MergeRequests.ListMergeRequestPipelines(projectPath, mergeRequestIID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines for merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+
+	return pipelines, nil
+}
+
+func (c *client) GetJob(ctx context.Context, projectPath string, jobID int64) (*gl.Job, error) {
+	var job *gl.Job
+	err := c.withRetry(ctx, "GetJob", func() (*gl.Response, error) {
+		var err error
+		var resp *gl.Response
+		job, resp, err = c.api.Jobs.GetJob(projectPath, jobID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get job %d in project %q: %w", jobID, projectPath, err)
+	}
+	if job == nil {
+		return nil, fmt.Errorf("job not found: %d", jobID)
+	}
+
+	return job, nil
+}
+
+// GetJobTrace fetches the job log starting at offset. It asks for a byte range
+// so polling a running job only transfers new output, and slices the body
+// itself when the server ignores the Range header and returns the full log.
+func (c *client) GetJobTrace(ctx context.Context, projectPath string, jobID int64, offset int64) (JobTrace, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	options := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+	if offset > 0 {
+		options = append(options, gl.WithHeader("Range", fmt.Sprintf("bytes=%d-", offset)))
+	}
+
+	var body []byte
+	var resp *gl.Response
+	err := c.withRetry(ctx, "GetJobTrace", func() (*gl.Response, error) {
+		reader, traceResp, err := c.api.Jobs.GetTraceFile(projectPath, jobID, options...)
+		resp = traceResp
+		if err != nil {
+			return traceResp, err
+		}
+		body, err = io.ReadAll(reader)
+		return traceResp, err
+	})
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return JobTrace{Offset: offset}, nil
 		}
+		return JobTrace{}, fmt.Errorf("get trace for job %d in project %q: %w", jobID, projectPath, err)
 	}
 
-	base := 300 * time.Millisecond
-	return base * time.Duration(1<<attempt)
+	if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusPartialContent {
+		return JobTrace{Content: body, Offset: offset + int64(len(body))}, nil
+	}
+
+	size := int64(len(body))
+	if size < offset {
+		return JobTrace{Content: body, Offset: size, Reset: true}, nil
+	}
+	return JobTrace{Content: body[offset:], Offset: size}, nil
+}
+
+func (c *client) CreateIssueNote(ctx context.Context, projectPath string, issueIID int64, body string) (*gl.Note, error) {
+	var note *gl.Note
+	err := c.withWriteRetry(ctx, "CreateIssueNote", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		note, resp, err = c.api.Notes.CreateIssueNote(projectPath, issueIID, &gl.CreateIssueNoteOptions{Body: gl.Ptr(body)}, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create note on issue %d in project %q: %w", issueIID, projectPath, err)
+	}
+	return note, nil
+}
+
+func (c *client) CreateMergeRequestNote(ctx context.Context, projectPath string, mergeRequestIID int64, body string) (*gl.Note, error) {
+	var note *gl.Note
+	err := c.withWriteRetry(ctx, "CreateMergeRequestNote", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		note, resp, err = c.api.Notes.CreateMergeRequestNote(projectPath, mergeRequestIID, &gl.CreateMergeRequestNoteOptions{Body: gl.Ptr(body)}, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create note on merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return note, nil
+}
+
+// AddDiscussionNote replies to an existing merge request discussion thread.
+func (c *client) AddDiscussionNote(ctx context.Context, projectPath string, mergeRequestIID int64, discussionID string, body string) (*gl.Note, error) {
+	var note *gl.Note
+	err := c.withWriteRetry(ctx, "AddDiscussionNote", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		note, resp, err = c.api.Discussions.AddMergeRequestDiscussionNote(projectPath, mergeRequestIID, discussionID, &gl.AddMergeRequestDiscussionNoteOptions{Body: gl.Ptr(body)}, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reply to discussion %s on merge request %d in project %q: %w", discussionID, mergeRequestIID, projectPath, err)
+	}
+	return note, nil
+}
+
+func (c *client) CreateIssue(ctx context.Context, projectPath string, opts CreateIssueOptions) (*gl.Issue, error) {
+	apiOpts := &gl.CreateIssueOptions{
+		Title: gl.Ptr(opts.Title),
+	}
+	if opts.Description != "" {
+		apiOpts.Description = gl.Ptr(opts.Description)
+	}
+	if len(opts.Labels) > 0 {
+		labels := gl.LabelOptions(opts.Labels)
+		apiOpts.Labels = &labels
+	}
+	if len(opts.AssigneeIDs) > 0 {
+		apiOpts.AssigneeIDs = gl.Ptr(opts.AssigneeIDs)
+	}
+	if opts.MilestoneID > 0 {
+		apiOpts.MilestoneID = gl.Ptr(opts.MilestoneID)
+	}
+	if opts.DueDate != nil {
+		dueDate := gl.ISOTime(*opts.DueDate)
+		apiOpts.DueDate = &dueDate
+	}
+	if opts.Confidential {
+		apiOpts.Confidential = gl.Ptr(true)
+	}
+
+	var issue *gl.Issue
+	err := c.withWriteRetry(ctx, "CreateIssue", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		issue, resp, err = c.api.Issues.CreateIssue(projectPath, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create issue in project %q: %w", projectPath, err)
+	}
+	return issue, nil
+}
+
+func (c *client) UpdateIssue(ctx context.Context, projectPath string, issueIID int64, opts UpdateIssueOptions) (*gl.Issue, error) {
+	apiOpts := &gl.UpdateIssueOptions{
+		AssigneeIDs:  opts.AssigneeIDs,
+		Confidential: opts.Confidential,
+	}
+	if opts.MilestoneID != nil {
+		if *opts.MilestoneID > 0 {
+			apiOpts.MilestoneID = opts.MilestoneID
+		} else {
+			apiOpts.ResetMilestoneID = true
+		}
+	}
+	if opts.StateEvent != "" {
+		apiOpts.StateEvent = gl.Ptr(opts.StateEvent)
+	}
+	if len(opts.AddLabels) > 0 {
+		labels := gl.LabelOptions(opts.AddLabels)
+		apiOpts.AddLabels = &labels
+	}
+	if len(opts.RemoveLabels) > 0 {
+		labels := gl.LabelOptions(opts.RemoveLabels)
+		apiOpts.RemoveLabels = &labels
+	}
+
+	var issue *gl.Issue
+	err := c.withWriteRetry(ctx, "UpdateIssue", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		issue, resp, err = c.api.Issues.UpdateIssue(projectPath, issueIID, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update issue %d in project %q: %w", issueIID, projectPath, err)
+	}
+	return issue, nil
+}
+
+func (c *client) GetMergeRequestApprovals(ctx context.Context, projectPath string, mergeRequestIID int64) (*gl.MergeRequestApprovals, error) {
+	var approvals *gl.MergeRequestApprovals
+	err := c.withRetry(ctx, "GetMergeRequestApprovals", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		approvals, resp, err = c.api.MergeRequestApprovals.GetConfiguration(projectPath, mergeRequestIID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get approvals for merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return approvals, nil
+}
+
+// GetMergeRequestApprovalState returns the approval rules of a merge request.
+// Approval rules are a paid feature, so callers should expect this to fail on
+// instances or projects without them.
+func (c *client) GetMergeRequestApprovalState(ctx context.Context, projectPath string, mergeRequestIID int64) (*gl.MergeRequestApprovalState, error) {
+	var state *gl.MergeRequestApprovalState
+	err := c.withRetry(ctx, "GetMergeRequestApprovalState", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		state, resp, err = c.api.MergeRequestApprovals.GetApprovalState(projectPath, mergeRequestIID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get approval rules for merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return state, nil
+}
+
+func (c *client) ApproveMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) error {
+	err := c.withWriteRetry(ctx, "ApproveMergeRequest", func() (*gl.Response, error) {
+		_, resp, err := c.api.MergeRequestApprovals.ApproveMergeRequest(projectPath, mergeRequestIID, nil, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return fmt.Errorf("approve merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return nil
+}
+
+func (c *client) UnapproveMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) error {
+	err := c.withWriteRetry(ctx, "UnapproveMergeRequest", func() (*gl.Response, error) {
+		return c.api.MergeRequestApprovals.UnapproveMergeRequest(projectPath, mergeRequestIID, gl.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unapprove merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return nil
+}
+
+func (c *client) AcceptMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64, opts AcceptMergeRequestOptions) (*gl.MergeRequest, error) {
+	apiOpts := &gl.AcceptMergeRequestOptions{
+		Squash:                   gl.Ptr(opts.Squash),
+		ShouldRemoveSourceBranch: gl.Ptr(opts.RemoveSourceBranch),
+	}
+	if opts.AutoMerge {
+		apiOpts.AutoMerge = gl.Ptr(true)
+	}
+
+	var mr *gl.MergeRequest
+	err := c.withWriteRetry(ctx, "AcceptMergeRequest", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		mr, resp, err = c.api.MergeRequests.AcceptMergeRequest(projectPath, mergeRequestIID, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("merge merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return mr, nil
+}
+
+// RebaseMergeRequest only queues the rebase; GitLab runs it in the background
+// and reports progress through the merge request's rebase_in_progress flag.
+func (c *client) RebaseMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) error {
+	err := c.withWriteRetry(ctx, "RebaseMergeRequest", func() (*gl.Response, error) {
+		return c.api.MergeRequests.RebaseMergeRequest(projectPath, mergeRequestIID, nil, gl.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("rebase merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return nil
+}
+
+// UpdateMergeRequestState closes or reopens a merge request.
+func (c *client) UpdateMergeRequestState(ctx context.Context, projectPath string, mergeRequestIID int64, stateEvent string) (*gl.MergeRequest, error) {
+	var mr *gl.MergeRequest
+	err := c.withWriteRetry(ctx, "UpdateMergeRequestState", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		mr, resp, err = c.api.MergeRequests.UpdateMergeRequest(projectPath, mergeRequestIID, &gl.UpdateMergeRequestOptions{StateEvent: gl.Ptr(stateEvent)}, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s merge request %d in project %q: %w", stateEvent, mergeRequestIID, projectPath, err)
+	}
+	return mr, nil
+}
+
+func (c *client) CreateMergeRequest(ctx context.Context, projectPath string, opts CreateMergeRequestOptions) (*gl.MergeRequest, error) {
+	apiOpts := &gl.CreateMergeRequestOptions{
+		Title:        gl.Ptr(opts.Title),
+		SourceBranch: gl.Ptr(opts.SourceBranch),
+		TargetBranch: gl.Ptr(opts.TargetBranch),
+	}
+	if opts.Description != "" {
+		apiOpts.Description = gl.Ptr(opts.Description)
+	}
+	if len(opts.Labels) > 0 {
+		labels := gl.LabelOptions(opts.Labels)
+		apiOpts.Labels = &labels
+	}
+	if len(opts.ReviewerIDs) > 0 {
+		apiOpts.ReviewerIDs = gl.Ptr(opts.ReviewerIDs)
+	}
+	if opts.Squash {
+		apiOpts.Squash = gl.Ptr(true)
+	}
+
+	var mr *gl.MergeRequest
+	err := c.withWriteRetry(ctx, "CreateMergeRequest", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		mr, resp, err = c.api.MergeRequests.CreateMergeRequest(projectPath, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create merge request in project %q: %w", projectPath, err)
+	}
+	return mr, nil
+}
+
+func (c *client) ListBranches(ctx context.Context, projectPath string) ([]*gl.Branch, error) {
+	all := make([]*gl.Branch, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListBranchesOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var branches []*gl.Branch
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListBranches", func() (*gl.Response, error) {
+			var err error
+			branches, resp, err = c.api.Branches.ListBranches(projectPath, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list branches for project %q: %w", projectPath, err)
+		}
+
+		all = append(all, branches...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// CreateBranch creates branch in the project, starting from ref.
+func (c *client) CreateBranch(ctx context.Context, projectPath string, branch string, ref string) (*gl.Branch, error) {
+	var created *gl.Branch
+	err := c.withWriteRetry(ctx, "CreateBranch", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		created, resp, err = c.api.Branches.CreateBranch(projectPath, &gl.CreateBranchOptions{Branch: gl.Ptr(branch), Ref: gl.Ptr(ref)}, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create branch %q from %q in project %q: %w", branch, ref, projectPath, err)
+	}
+	return created, nil
+}
+
+// GetMergeRequestTemplate returns the content of the named merge request
+// description template, or an empty string when the project has none by that
+// name.
+func (c *client) GetMergeRequestTemplate(ctx context.Context, projectPath string, name string) (string, error) {
+	var template *gl.ProjectTemplate
+	err := c.withRetry(ctx, "GetMergeRequestTemplate", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		template, resp, err = c.api.ProjectTemplates.GetProjectTemplate(projectPath, "merge_requests", name, gl.WithContext(ctx))
+		return resp, err
+	})
+	if errors.Is(err, gl.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get merge request template %q for project %q: %w", name, projectPath, err)
+	}
+	if template == nil {
+		return "", nil
+	}
+	return template.Content, nil
+}
+
+func (c *client) ListLabels(ctx context.Context, projectPath string) ([]*gl.Label, error) {
+	all := make([]*gl.Label, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListLabelsOptions{
+			ListOptions:           gl.ListOptions{Page: page, PerPage: defaultPerPage},
+			IncludeAncestorGroups: gl.Ptr(true),
+		}
+
+		var labels []*gl.Label
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListLabels", func() (*gl.Response, error) {
+			var err error
+			labels, resp, err = c.api.Labels.ListLabels(projectPath, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list labels for project %q: %w", projectPath, err)
+		}
+
+		all = append(all, labels...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListProjectMembers includes members inherited from parent groups, since
+// they can be assigned to issues as well.
+func (c *client) ListProjectMembers(ctx context.Context, projectPath string) ([]*gl.ProjectMember, error) {
+	all := make([]*gl.ProjectMember, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListProjectMembersOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var members []*gl.ProjectMember
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListProjectMembers", func() (*gl.Response, error) {
+			var err error
+			members, resp, err = c.api.ProjectMembers.ListAllProjectMembers(projectPath, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list members for project %q: %w", projectPath, err)
+		}
+
+		all = append(all, members...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListMilestones returns the milestones of the project and its parent groups
+// in state, "active" or "closed", or in any state when it is empty.
+func (c *client) ListMilestones(ctx context.Context, projectPath string, state string) ([]*gl.Milestone, error) {
+	all := make([]*gl.Milestone, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListMilestonesOptions{
+			ListOptions:      gl.ListOptions{Page: page, PerPage: defaultPerPage},
+			IncludeAncestors: gl.Ptr(true),
+		}
+		if state != "" {
+			opts.State = gl.Ptr(state)
+		}
+
+		var milestones []*gl.Milestone
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListMilestones", func() (*gl.Response, error) {
+			var err error
+			milestones, resp, err = c.api.Milestones.ListMilestones(projectPath, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list milestones for project %q: %w", projectPath, err)
+		}
+
+		all = append(all, milestones...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// MilestoneRef identifies a milestone listed for a project. Milestones
+// inherited from a parent group carry the group's ID and are read through
+// the group milestone API.
+type MilestoneRef struct {
+	ID      int64
+	GroupID int64
+}
+
+// GetMilestoneIssues returns every issue of a project or group milestone.
+func (c *client) GetMilestoneIssues(ctx context.Context, projectPath string, milestone MilestoneRef) ([]*gl.Issue, error) {
+	all := make([]*gl.Issue, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		listOpts := gl.ListOptions{Page: page, PerPage: defaultPerPage}
+
+		var issues []*gl.Issue
+		var resp *gl.Response
+		err := c.withRetry(ctx, "GetMilestoneIssues", func() (*gl.Response, error) {
+			var err error
+			if milestone.GroupID > 0 {
+				issues, resp, err = c.api.GroupMilestones.GetGroupMilestoneIssues(milestone.GroupID, milestone.ID, &gl.GetGroupMilestoneIssuesOptions{ListOptions: listOpts}, gl.WithContext(ctx))
+			} else {
+				issues, resp, err = c.api.Milestones.GetMilestoneIssues(projectPath, milestone.ID, &gl.GetMilestoneIssuesOptions{ListOptions: listOpts}, gl.WithContext(ctx))
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list issues of milestone %d for project %q: %w", milestone.ID, projectPath, err)
+		}
+
+		all = append(all, issues...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// GetMilestoneMergeRequests returns every merge request of a project or group
+// milestone.
+func (c *client) GetMilestoneMergeRequests(ctx context.Context, projectPath string, milestone MilestoneRef) ([]*gl.BasicMergeRequest, error) {
+	all := make([]*gl.BasicMergeRequest, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		listOpts := gl.ListOptions{Page: page, PerPage: defaultPerPage}
+
+		var mrs []*gl.BasicMergeRequest
+		var resp *gl.Response
+		err := c.withRetry(ctx, "GetMilestoneMergeRequests", func() (*gl.Response, error) {
+			var err error
+			if milestone.GroupID > 0 {
+				mrs, resp, err = c.api.GroupMilestones.GetGroupMilestoneMergeRequests(milestone.GroupID, milestone.ID, &gl.GetGroupMilestoneMergeRequestsOptions{ListOptions: listOpts}, gl.WithContext(ctx))
+			} else {
+				mrs, resp, err = c.api.Milestones.GetMilestoneMergeRequests(projectPath, milestone.ID, &gl.GetMilestoneMergeRequestsOptions{ListOptions: listOpts}, gl.WithContext(ctx))
+			}
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list merge requests of milestone %d for project %q: %w", milestone.ID, projectPath, err)
+		}
+
+		all = append(all, mrs...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListIssueBoards returns the issue boards of a project.
+func (c *client) ListIssueBoards(ctx context.Context, projectPath string) ([]*gl.IssueBoard, error) {
+	all := make([]*gl.IssueBoard, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListIssueBoardsOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var boards []*gl.IssueBoard
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListIssueBoards", func() (*gl.Response, error) {
+			var err error
+			boards, resp, err = c.api.Boards.ListIssueBoards(projectPath, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list issue boards for project %q: %w", projectPath, err)
+		}
+
+		all = append(all, boards...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListBoardLists returns the lists of an issue board in board order, without
+// the open and closed lists every board has.
+func (c *client) ListBoardLists(ctx context.Context, projectPath string, boardID int64) ([]*gl.BoardList, error) {
+	all := make([]*gl.BoardList, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.GetIssueBoardListsOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var lists []*gl.BoardList
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListBoardLists", func() (*gl.Response, error) {
+			var err error
+			lists, resp, err = c.api.Boards.GetIssueBoardLists(projectPath, boardID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list lists of board %d for project %q: %w", boardID, projectPath, err)
+		}
+
+		all = append(all, lists...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+func (c *client) withRetry(ctx context.Context, operation string, fn func() (*gl.Response, error)) error {
+	return c.retry(ctx, operation, isRetryable, fn)
+}
+
+// withWriteRetry only retries requests the server explicitly rejected with a
+// rate limit, since replaying a write after a timeout or 5xx could apply it
+// twice.
+func (c *client) withWriteRetry(ctx context.Context, operation string, fn func() (*gl.Response, error)) error {
+	return c.retry(ctx, operation, isRateLimited, fn)
+}
+
+func (c *client) retry(ctx context.Context, operation string, retryable func(*gl.Response) bool, fn func() (*gl.Response, error)) error {
+	var lastErr error
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		resp, err := fn()
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+		if !retryable(resp) || attempt == maxRetries-1 {
+			break
+		}
+
+		delay := retryDelay(resp, attempt)
+		c.logger.Printf("retrying %s attempt=%d delay=%s err=%v", operation, attempt+2, delay, err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return lastErr
+}
+
+// listOrder defaults an issue or merge request list to the most recently
+// updated first.
+func listOrder(orderBy string, sort string) (*string, *string) {
+	if orderBy == "" {
+		orderBy = "updated_at"
+	}
+	if sort == "" {
+		sort = "desc"
+	}
+	return gl.Ptr(orderBy), gl.Ptr(sort)
+}
+
+// userIDKeyword reports whether value is GitLab's "None" or "Any" user
+// filter rather than a username.
+func userIDKeyword(value string) (gl.UserIDValue, bool) {
+	switch {
+	case strings.EqualFold(value, string(gl.UserIDNone)):
+		return gl.UserIDNone, true
+	case strings.EqualFold(value, string(gl.UserIDAny)):
+		return gl.UserIDAny, true
+	}
+	return "", false
+}
+
+// withQueryParam sets a query parameter the client library does not expose.
+func withQueryParam(key string, value string) gl.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		query := req.URL.Query()
+		query.Set(key, value)
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+func isRetryable(resp *gl.Response) bool {
+	if resp == nil || resp.Response == nil {
+		return true
+	}
+
+	status := resp.StatusCode
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+func isRateLimited(resp *gl.Response) bool {
+	return resp != nil && resp.Response != nil && resp.StatusCode == http.StatusTooManyRequests
+}
+
+func retryDelay(resp *gl.Response, attempt int) time.Duration {
+	if resp != nil && resp.Response != nil {
+		retryAfter := resp.Header.Get("Retry-After")
+		if retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds > 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	base := 300 * time.Millisecond
+	return base * time.Duration(1<<attempt)
+}
+
+func (c *client) GetIssue(ctx context.Context, projectPath string, issueIID int64) (*gl.Issue, error) {
+	var issue *gl.Issue
+	err := c.withRetry(ctx, "GetIssue", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		issue, resp, err = c.api.Issues.GetIssue(projectPath, issueIID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get issue %d in project %q: %w", issueIID, projectPath, err)
+	}
+	return issue, nil
+}
+
+func (c *client) GetMergeRequest(ctx context.Context, projectPath string, mergeRequestIID int64) (*gl.MergeRequest, error) {
+	var mr *gl.MergeRequest
+	err := c.withRetry(ctx, "GetMergeRequest", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		mr, resp, err = c.api.MergeRequests.GetMergeRequest(projectPath, mergeRequestIID, nil, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get merge request %d in project %q: %w", mergeRequestIID, projectPath, err)
+	}
+	return mr, nil
+}
+
+// ListTodos returns one page of the current user's pending to-do items,
+// newest first, and whether there is another page.
+func (c *client) ListTodos(ctx context.Context, opts TodoListOptions) ([]*gl.Todo, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListTodosOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		State:       gl.Ptr("pending"),
+	}
+	if opts.Action != "" {
+		apiOpts.Action = gl.Ptr(gl.TodoAction(opts.Action))
+	}
+
+	var todos []*gl.Todo
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListTodos", func() (*gl.Response, error) {
+		var err error
+		todos, resp, err = c.api.Todos.ListTodos(apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list todos: %w", err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return todos, hasNextPage, nil
+}
+
+func (c *client) MarkTodoDone(ctx context.Context, todoID int64) error {
+	err := c.withWriteRetry(ctx, "MarkTodoDone", func() (*gl.Response, error) {
+		return c.api.Todos.MarkTodoAsDone(todoID, gl.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("mark todo %d as done: %w", todoID, err)
+	}
+	return nil
+}
+
+func (c *client) MarkAllTodosDone(ctx context.Context) error {
+	err := c.withWriteRetry(ctx, "MarkAllTodosDone", func() (*gl.Response, error) {
+		return c.api.Todos.MarkAllTodosAsDone(gl.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("mark all todos as done: %w", err)
+	}
+	return nil
+}
+
+func (c *client) ListGlobalIssues(ctx context.Context, opts GlobalIssueListOptions) ([]*gl.Issue, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListIssuesOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     gl.Ptr("updated_at"),
+		Sort:        gl.Ptr("desc"),
+	}
+	if opts.Scope != "" {
+		apiOpts.Scope = gl.Ptr(opts.Scope)
+	}
+	if opts.State != "" {
+		apiOpts.State = gl.Ptr(opts.State)
+	}
+
+	var issues []*gl.Issue
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGlobalIssues", func() (*gl.Response, error) {
+		var err error
+		issues, resp, err = c.api.Issues.ListIssues(apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list issues: %w", err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return issues, hasNextPage, nil
+}
+
+func (c *client) ListGlobalMergeRequests(ctx context.Context, opts GlobalMergeRequestListOptions) ([]*gl.BasicMergeRequest, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListMergeRequestsOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     gl.Ptr("updated_at"),
+		Sort:        gl.Ptr("desc"),
+	}
+	if opts.Scope != "" {
+		apiOpts.Scope = gl.Ptr(opts.Scope)
+	}
+	if opts.State != "" {
+		apiOpts.State = gl.Ptr(opts.State)
+	}
+	if opts.ReviewerID > 0 {
+		apiOpts.ReviewerID = gl.ReviewerID(opts.ReviewerID)
+	}
+
+	var mrs []*gl.BasicMergeRequest
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGlobalMergeRequests", func() (*gl.Response, error) {
+		var err error
+		mrs, resp, err = c.api.MergeRequests.ListMergeRequests(apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list merge requests: %w", err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return mrs, hasNextPage, nil
+}
+
+// ListGroups lists the top-level groups the user is a member of.
+func (c *client) ListGroups(ctx context.Context, opts GroupListOptions) ([]*gl.Group, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListGroupsOptions{
+		ListOptions:  gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		TopLevelOnly: gl.Ptr(true),
+		OrderBy:      gl.Ptr("name"),
+		Sort:         gl.Ptr("asc"),
+	}
+
+	var groups []*gl.Group
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGroups", func() (*gl.Response, error) {
+		var err error
+		groups, resp, err = c.api.Groups.ListGroups(apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list groups: %w", err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return groups, hasNextPage, nil
+}
+
+func (c *client) ListSubgroups(ctx context.Context, groupPath string, opts GroupListOptions) ([]*gl.Group, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListSubGroupsOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     gl.Ptr("name"),
+		Sort:        gl.Ptr("asc"),
+	}
+
+	var groups []*gl.Group
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListSubgroups", func() (*gl.Response, error) {
+		var err error
+		groups, resp, err = c.api.Groups.ListSubGroups(groupPath, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list subgroups of %q: %w", groupPath, err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return groups, hasNextPage, nil
+}
+
+// ListGroupProjects lists the projects directly in a group, leaving out
+// those of its subgroups and archived ones.
+func (c *client) ListGroupProjects(ctx context.Context, groupPath string, opts GroupListOptions) ([]*gl.Project, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListGroupProjectsOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		Archived:    gl.Ptr(false),
+		OrderBy:     gl.Ptr("name"),
+		Sort:        gl.Ptr("asc"),
+	}
+
+	var projects []*gl.Project
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGroupProjects", func() (*gl.Response, error) {
+		var err error
+		projects, resp, err = c.api.Groups.ListGroupProjects(groupPath, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list projects of group %q: %w", groupPath, err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return projects, hasNextPage, nil
+}
+
+// ListGroupIssues lists the issues of every project in a group and its
+// subgroups.
+func (c *client) ListGroupIssues(ctx context.Context, groupPath string, opts IssueListOptions) ([]*gl.Issue, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	orderBy, sort := listOrder(opts.OrderBy, opts.Sort)
+	apiOpts := &gl.ListGroupIssuesOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     orderBy,
+		Sort:        sort,
+	}
+	if opts.State != "" {
+		apiOpts.State = gl.Ptr(opts.State)
+	}
+	if opts.Search != "" {
+		apiOpts.Search = gl.Ptr(opts.Search)
+	}
+	if len(opts.Labels) > 0 {
+		apiOpts.Labels = gl.Ptr(gl.LabelOptions(opts.Labels))
+	}
+	if len(opts.NotLabels) > 0 {
+		apiOpts.NotLabels = gl.Ptr(gl.LabelOptions(opts.NotLabels))
+	}
+	if value, ok := userIDKeyword(opts.AssigneeUsername); ok {
+		apiOpts.AssigneeID = gl.AssigneeID(value)
+	} else if opts.AssigneeUsername != "" {
+		apiOpts.AssigneeUsername = gl.Ptr(opts.AssigneeUsername)
+	}
+	if opts.NotAssigneeUsername != "" {
+		apiOpts.NotAssigneeUsername = gl.Ptr(opts.NotAssigneeUsername)
+	}
+	if opts.AuthorUsername != "" {
+		apiOpts.AuthorUsername = gl.Ptr(opts.AuthorUsername)
+	}
+	if opts.NotAuthorUsername != "" {
+		apiOpts.NotAuthorUsername = gl.Ptr(opts.NotAuthorUsername)
+	}
+	if opts.Milestone != "" {
+		apiOpts.Milestone = gl.Ptr(opts.Milestone)
+	}
+	if opts.NotMilestone != "" {
+		apiOpts.NotMilestone = gl.Ptr(opts.NotMilestone)
+	}
+	if opts.DueDate != "" {
+		apiOpts.DueDate = gl.Ptr(opts.DueDate)
+	}
+	requestOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+	if opts.Weight != "" {
+		requestOpts = append(requestOpts, withQueryParam("weight", opts.Weight))
+	}
+
+	var issues []*gl.Issue
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGroupIssues", func() (*gl.Response, error) {
+		var err error
+		issues, resp, err = c.api.Issues.ListGroupIssues(groupPath, apiOpts, requestOpts...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list issues for group %q: %w", groupPath, err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return issues, hasNextPage, nil
+}
+
+// ListGroupMergeRequests lists the merge requests of every project in a
+// group and its subgroups.
+func (c *client) ListGroupMergeRequests(ctx context.Context, groupPath string, opts MergeRequestListOptions) ([]*gl.BasicMergeRequest, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	orderBy, sort := listOrder(opts.OrderBy, opts.Sort)
+	apiOpts := &gl.ListGroupMergeRequestsOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     orderBy,
+		Sort:        sort,
+		Draft:       opts.Draft,
+	}
+	if opts.State != "" {
+		apiOpts.State = gl.Ptr(opts.State)
+	}
+	if opts.Search != "" {
+		apiOpts.Search = gl.Ptr(opts.Search)
+	}
+	if len(opts.Labels) > 0 {
+		apiOpts.Labels = gl.Ptr(gl.LabelOptions(opts.Labels))
+	}
+	if len(opts.NotLabels) > 0 {
+		apiOpts.NotLabels = gl.Ptr(gl.LabelOptions(opts.NotLabels))
+	}
+	if opts.AuthorUsername != "" {
+		apiOpts.AuthorUsername = gl.Ptr(opts.AuthorUsername)
+	}
+	if opts.NotAuthorUsername != "" {
+		apiOpts.NotAuthorUsername = gl.Ptr(opts.NotAuthorUsername)
+	}
+	if opts.TargetBranch != "" {
+		apiOpts.TargetBranch = gl.Ptr(opts.TargetBranch)
+	}
+	requestOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+	if value, ok := userIDKeyword(opts.AssigneeUsername); ok {
+		apiOpts.AssigneeID = gl.AssigneeID(value)
+	} else if opts.AssigneeUsername != "" {
+		requestOpts = append(requestOpts, withQueryParam("assignee_username", opts.AssigneeUsername))
+	}
+	if value, ok := userIDKeyword(opts.ReviewerUsername); ok {
+		apiOpts.ReviewerID = gl.ReviewerID(value)
+	} else if opts.ReviewerUsername != "" {
+		apiOpts.ReviewerUsername = gl.Ptr(opts.ReviewerUsername)
+	}
+	if value, ok := userIDKeyword(opts.ApprovedByUsername); ok {
+		apiOpts.ApprovedByIDs = gl.ApproverIDs(value)
+	} else if opts.ApprovedByUsername != "" {
+		requestOpts = append(requestOpts, withQueryParam("approved_by_usernames[]", opts.ApprovedByUsername))
+	}
+
+	var mrs []*gl.BasicMergeRequest
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGroupMergeRequests", func() (*gl.Response, error) {
+		var err error
+		mrs, resp, err = c.api.MergeRequests.ListGroupMergeRequests(groupPath, apiOpts, requestOpts...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list merge requests for group %q: %w", groupPath, err)
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return mrs, hasNextPage, nil
+}
+
+// ListGroupEpics lists the epics of a group and its subgroups, most recently
+// updated first.
+func (c *client) ListGroupEpics(ctx context.Context, groupPath string, opts EpicListOptions) ([]*gl.Epic, bool, error) {
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultPerPage
+	}
+
+	apiOpts := &gl.ListGroupEpicsOptions{
+		ListOptions: gl.ListOptions{Page: opts.Page, PerPage: int64(opts.PerPage)},
+		OrderBy:     gl.Ptr("updated_at"),
+		Sort:        gl.Ptr("desc"),
+	}
+	if opts.State != "" {
+		apiOpts.State = gl.Ptr(opts.State)
+	}
+
+	var epics []*gl.Epic
+	var resp *gl.Response
+	err := c.withRetry(ctx, "ListGroupEpics", func() (*gl.Response, error) {
+		var err error
+		epics, resp, err = c.api.Epics.ListGroupEpics(groupPath, apiOpts, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("list epics for group %q: %w", groupPath, epicsError(err))
+	}
+
+	hasNextPage := resp != nil && resp.NextPage > 0
+	return epics, hasNextPage, nil
+}
+
+// ListEpicChildren returns the child epics of an epic.
+func (c *client) ListEpicChildren(ctx context.Context, groupID int64, epicIID int64) ([]*gl.Epic, error) {
+	var epics []*gl.Epic
+	err := c.withRetry(ctx, "ListEpicChildren", func() (*gl.Response, error) {
+		var resp *gl.Response
+		var err error
+		epics, resp, err = c.api.Epics.GetEpicLinks(groupID, epicIID, gl.WithContext(ctx))
+		return resp, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list child epics of epic &%d in group %d: %w", epicIID, groupID, epicsError(err))
+	}
+	return epics, nil
+}
+
+// ListEpicIssues returns every issue linked to an epic.
+func (c *client) ListEpicIssues(ctx context.Context, groupID int64, epicIID int64) ([]*gl.Issue, error) {
+	all := make([]*gl.Issue, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListOptions{Page: page, PerPage: defaultPerPage}
+
+		var issues []*gl.Issue
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListEpicIssues", func() (*gl.Response, error) {
+			var err error
+			issues, resp, err = c.api.EpicIssues.ListEpicIssues(groupID, epicIID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list issues of epic &%d in group %d: %w", epicIID, groupID, epicsError(err))
+		}
+
+		all = append(all, issues...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// ListEpicNotes returns every note of an epic, which the API addresses by
+// the epic's ID rather than its IID.
+func (c *client) ListEpicNotes(ctx context.Context, groupID int64, epicID int64) ([]*gl.Note, error) {
+	all := make([]*gl.Note, 0, defaultPerPage)
+	page := int64(1)
+
+	for {
+		opts := &gl.ListEpicNotesOptions{
+			ListOptions: gl.ListOptions{Page: page, PerPage: defaultPerPage},
+		}
+
+		var notes []*gl.Note
+		var resp *gl.Response
+		err := c.withRetry(ctx, "ListEpicNotes", func() (*gl.Response, error) {
+			var err error
+			notes, resp, err = c.api.Notes.ListEpicNotes(groupID, epicID, opts, gl.WithContext(ctx))
+			return resp, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list notes of epic %d in group %d: %w", epicID, groupID, epicsError(err))
+		}
+
+		all = append(all, notes...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// epicsError turns the 403 and 404 of an instance without epics into
+// ErrEpicsUnavailable.
+func epicsError(err error) error {
+	if errors.Is(err, gl.ErrNotFound) {
+		return ErrEpicsUnavailable
+	}
+	var apiErr *gl.ErrorResponse
+	if errors.As(err, &apiErr) && apiErr.Response != nil && apiErr.Response.StatusCode == http.StatusForbidden {
+		return ErrEpicsUnavailable
+	}
+	return err
 }
@@ -0,0 +1,166 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// OriginProjectPath returns the GitLab project path of the origin remote of
+// the git checkout in dir, or the current directory when dir is empty.
+func OriginProjectPath(ctx context.Context, dir string) (string, error) {
+	out, err := git(ctx, dir, "remote", "get-url", "origin")
+	if err != nil {
+		return "", fmt.Errorf("read git origin remote: %w", err)
+	}
+	_, projectPath, err := ParseRemoteURL(out)
+	if err != nil {
+		return "", err
+	}
+	return projectPath, nil
+}
+
+// CheckoutMergeRequest fetches the head of a merge request from origin and
+// checks it out as branch. Fetching refs/merge-requests/<iid>/head also works
+// for merge requests from forks, whose source branch is not on origin. An
+// existing local branch is only fast-forwarded, never reset, and only when it
+// has no upstream or tracks origin/<branch>; callers should give merge
+// requests from forks a branch name of their own so that a fork's main never
+// lands on the local main.
+//
+// The returned transcript lists every git command that ran with its output,
+// and is filled in even when an error is returned.
+func CheckoutMergeRequest(ctx context.Context, dir string, mergeRequestIID int64, branch string) (string, error) {
+	transcript := &gitTranscript{ctx: ctx, dir: dir}
+
+	if _, err := git(ctx, dir, "check-ref-format", "--branch", branch); err != nil {
+		return "", fmt.Errorf("invalid branch name %q", branch)
+	}
+	status, err := git(ctx, dir, "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return "", fmt.Errorf("read working tree status: %w", err)
+	}
+	if status != "" {
+		transcript.out.WriteString("$ git status --porcelain --untracked-files=no\n" + status + "\n")
+		return transcript.String(), fmt.Errorf("working tree has uncommitted changes; commit or stash them first")
+	}
+
+	ref := fmt.Sprintf("refs/merge-requests/%d/head", mergeRequestIID)
+	if err := transcript.run("fetch", "origin", ref); err != nil {
+		return transcript.String(), fmt.Errorf("fetch %s: %w", ref, err)
+	}
+
+	if _, err := git(ctx, dir, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err != nil {
+		if err := transcript.run("checkout", "-b", branch, "FETCH_HEAD"); err != nil {
+			return transcript.String(), fmt.Errorf("check out %s: %w", branch, err)
+		}
+		return transcript.String(), nil
+	}
+	if upstream, err := git(ctx, dir, "rev-parse", "--abbrev-ref", "--symbolic-full-name", branch+"@{upstream}"); err == nil && upstream != "origin/"+branch {
+		return transcript.String(), fmt.Errorf("local branch %s tracks %s, not origin/%s; not updating it", branch, upstream, branch)
+	}
+	if err := transcript.run("checkout", branch); err != nil {
+		return transcript.String(), fmt.Errorf("check out %s: %w", branch, err)
+	}
+	if err := transcript.run("merge", "--ff-only", "FETCH_HEAD"); err != nil {
+		return transcript.String(), fmt.Errorf("fast-forward %s: %w", branch, err)
+	}
+	return transcript.String(), nil
+}
+
+// CurrentBranch returns the branch checked out in dir.
+func CurrentBranch(ctx context.Context, dir string) (string, error) {
+	branch, err := git(ctx, dir, "symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil || branch == "" {
+		return "", fmt.Errorf("HEAD is not on a branch; check out a branch first")
+	}
+	return branch, nil
+}
+
+// HasUpstream reports whether branch tracks a remote branch.
+func HasUpstream(ctx context.Context, dir string, branch string) bool {
+	_, err := git(ctx, dir, "rev-parse", "--abbrev-ref", "--symbolic-full-name", branch+"@{upstream}")
+	return err == nil
+}
+
+// CommitMessages returns the full messages of the commits on HEAD that are not
+// on base, oldest first. When base does not exist locally only the message of
+// HEAD is returned.
+func CommitMessages(ctx context.Context, dir string, base string) ([]string, error) {
+	args := []string{"log", "--reverse", "--format=%B%x00"}
+	if _, err := git(ctx, dir, "rev-parse", "--verify", "--quiet", base+"^{commit}"); err == nil {
+		args = append(args, base+"..HEAD")
+	} else {
+		args = append(args, "-1", "HEAD")
+	}
+	out, err := git(ctx, dir, args...)
+	if err != nil {
+		return nil, fmt.Errorf("read commit messages: %w", err)
+	}
+	messages := make([]string, 0)
+	for _, message := range strings.Split(out, "\x00") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// PushBranch pushes branch to origin and sets it as the upstream. Like
+// CheckoutMergeRequest it returns the git transcript alongside any error.
+func PushBranch(ctx context.Context, dir string, branch string) (string, error) {
+	transcript := &gitTranscript{ctx: ctx, dir: dir}
+	if err := transcript.run("push", "--set-upstream", "origin", branch); err != nil {
+		return transcript.String(), fmt.Errorf("push %s to origin: %w", branch, err)
+	}
+	return transcript.String(), nil
+}
+
+// CheckoutRemoteBranch fetches branch from origin and checks it out tracking
+// the remote branch. An existing local branch is checked out as it is.
+func CheckoutRemoteBranch(ctx context.Context, dir string, branch string) (string, error) {
+	if _, err := git(ctx, dir, "check-ref-format", "--branch", branch); err != nil {
+		return "", fmt.Errorf("invalid branch name %q", branch)
+	}
+	transcript := &gitTranscript{ctx: ctx, dir: dir}
+	if err := transcript.run("fetch", "origin", branch); err != nil {
+		return transcript.String(), fmt.Errorf("fetch %s: %w", branch, err)
+	}
+	args := []string{"checkout", "-b", branch, "--track", "origin/" + branch}
+	if _, err := git(ctx, dir, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err == nil {
+		args = []string{"checkout", branch}
+	}
+	if err := transcript.run(args...); err != nil {
+		return transcript.String(), fmt.Errorf("check out %s: %w", branch, err)
+	}
+	return transcript.String(), nil
+}
+
+// gitTranscript runs git commands in dir and records each command line followed
+// by its combined output.
+type gitTranscript struct {
+	ctx context.Context
+	dir string
+	out strings.Builder
+}
+
+func (t *gitTranscript) run(args ...string) error {
+	t.out.WriteString("$ git " + strings.Join(args, " ") + "\n")
+	cmd := exec.CommandContext(t.ctx, "git", args...)
+	cmd.Dir = t.dir
+	out, err := cmd.CombinedOutput()
+	t.out.Write(out)
+	return err
+}
+
+func (t *gitTranscript) String() string {
+	return t.out.String()
+}
+
+func git(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
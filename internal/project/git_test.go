@@ -0,0 +1,225 @@
+package project
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckoutMergeRequest(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	ctx := context.Background()
+	root := t.TempDir()
+	origin := filepath.Join(root, "origin")
+	clone := filepath.Join(root, "clone")
+
+	runGit(t, root, "init", "--quiet", "--initial-branch=main", origin)
+	writeFile(t, filepath.Join(origin, "README.md"), "hello\n")
+	runGit(t, origin, "add", "README.md")
+	runGit(t, origin, "commit", "--quiet", "-m", "initial")
+	runGit(t, root, "clone", "--quiet", origin, clone)
+
+	// The merge request head only exists under refs/merge-requests, as it
+	// would for a merge request opened from a fork.
+	runGit(t, origin, "checkout", "--quiet", "-b", "fork-feature")
+	writeFile(t, filepath.Join(origin, "feature.txt"), "feature\n")
+	runGit(t, origin, "add", "feature.txt")
+	runGit(t, origin, "commit", "--quiet", "-m", "feature")
+	runGit(t, origin, "update-ref", "refs/merge-requests/7/head", "HEAD")
+	runGit(t, origin, "checkout", "--quiet", "main")
+	runGit(t, origin, "branch", "--quiet", "-D", "fork-feature")
+
+	writeFile(t, filepath.Join(clone, "README.md"), "local edit\n")
+	transcript, err := CheckoutMergeRequest(ctx, clone, 7, "feature")
+	if err == nil || !strings.Contains(err.Error(), "uncommitted changes") {
+		t.Fatalf("dirty checkout error = %v want uncommitted changes", err)
+	}
+	if !strings.Contains(transcript, "README.md") {
+		t.Fatalf("transcript = %q want the dirty file listed", transcript)
+	}
+	runGit(t, clone, "checkout", "--quiet", "--", "README.md")
+
+	transcript, err = CheckoutMergeRequest(ctx, clone, 7, "feature")
+	if err != nil {
+		t.Fatalf("CheckoutMergeRequest() error = %v\n%s", err, transcript)
+	}
+	if !strings.Contains(transcript, "$ git fetch origin refs/merge-requests/7/head") {
+		t.Fatalf("transcript = %q want the fetch command", transcript)
+	}
+	if branch := runGit(t, clone, "rev-parse", "--abbrev-ref", "HEAD"); branch != "feature" {
+		t.Fatalf("checked out branch = %q want feature", branch)
+	}
+	if _, err := os.Stat(filepath.Join(clone, "feature.txt")); err != nil {
+		t.Fatalf("feature.txt missing after checkout: %v", err)
+	}
+
+	if _, err := CheckoutMergeRequest(ctx, clone, 7, "feature"); err != nil {
+		t.Fatalf("second checkout of the same branch error = %v", err)
+	}
+}
+
+func TestCheckoutForkMergeRequestKeepsLocalBranch(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	ctx := context.Background()
+	root := t.TempDir()
+	origin := filepath.Join(root, "origin")
+	clone := filepath.Join(root, "clone")
+
+	runGit(t, root, "init", "--quiet", "--initial-branch=main", origin)
+	writeFile(t, filepath.Join(origin, "README.md"), "hello\n")
+	runGit(t, origin, "add", "README.md")
+	runGit(t, origin, "commit", "--quiet", "-m", "initial")
+	runGit(t, root, "clone", "--quiet", origin, clone)
+
+	// A fork's main, one commit ahead of origin/main, so checking it out as
+	// main would fast-forward the local default branch.
+	runGit(t, origin, "checkout", "--quiet", "-b", "fork-main")
+	writeFile(t, filepath.Join(origin, "fork.txt"), "fork\n")
+	runGit(t, origin, "add", "fork.txt")
+	runGit(t, origin, "commit", "--quiet", "-m", "fork")
+	runGit(t, origin, "update-ref", "refs/merge-requests/8/head", "HEAD")
+	runGit(t, origin, "checkout", "--quiet", "main")
+	runGit(t, origin, "branch", "--quiet", "-D", "fork-main")
+
+	mainBefore := runGit(t, clone, "rev-parse", "main")
+	transcript, err := CheckoutMergeRequest(ctx, clone, 8, "mr-8-main")
+	if err != nil {
+		t.Fatalf("CheckoutMergeRequest() error = %v\n%s", err, transcript)
+	}
+	if branch, _ := CurrentBranch(ctx, clone); branch != "mr-8-main" {
+		t.Fatalf("checked out branch = %q want mr-8-main", branch)
+	}
+	if mainAfter := runGit(t, clone, "rev-parse", "main"); mainAfter != mainBefore {
+		t.Fatalf("local main moved from %s to %s", mainBefore, mainAfter)
+	}
+	if _, err := CheckoutMergeRequest(ctx, clone, 8, "mr-8-main"); err != nil {
+		t.Fatalf("second checkout of the fork branch error = %v", err)
+	}
+
+	// An existing branch that tracks something other than origin/<branch> is
+	// left alone.
+	runGit(t, clone, "branch", "--quiet", "--track", "feature", "origin/main")
+	featureBefore := runGit(t, clone, "rev-parse", "feature")
+	transcript, err = CheckoutMergeRequest(ctx, clone, 8, "feature")
+	if err == nil || !strings.Contains(err.Error(), "tracks origin/main") {
+		t.Fatalf("checkout over a branch tracking origin/main error = %v want refusal\n%s", err, transcript)
+	}
+	if featureAfter := runGit(t, clone, "rev-parse", "feature"); featureAfter != featureBefore {
+		t.Fatalf("local feature moved from %s to %s", featureBefore, featureAfter)
+	}
+}
+
+func TestBranchCommitsAndPush(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	ctx := context.Background()
+	root := t.TempDir()
+	origin := filepath.Join(root, "origin")
+	clone := filepath.Join(root, "clone")
+
+	runGit(t, root, "init", "--quiet", "--initial-branch=main", origin)
+	writeFile(t, filepath.Join(origin, "README.md"), "hello\n")
+	runGit(t, origin, "add", "README.md")
+	runGit(t, origin, "commit", "--quiet", "-m", "initial")
+	runGit(t, root, "clone", "--quiet", origin, clone)
+
+	runGit(t, clone, "checkout", "--quiet", "-b", "feature/login")
+	for i, message := range []string{"Add login form\n\nUses the existing session store.", "Validate passwords"} {
+		writeFile(t, filepath.Join(clone, "file"+string(rune('a'+i))), message)
+		runGit(t, clone, "add", ".")
+		runGit(t, clone, "commit", "--quiet", "-m", message)
+	}
+
+	branch, err := CurrentBranch(ctx, clone)
+	if err != nil || branch != "feature/login" {
+		t.Fatalf("CurrentBranch() = %q, %v want feature/login", branch, err)
+	}
+	if HasUpstream(ctx, clone, branch) {
+		t.Fatal("new branch should not have an upstream")
+	}
+
+	messages, err := CommitMessages(ctx, clone, "origin/main")
+	if err != nil {
+		t.Fatalf("CommitMessages() error = %v", err)
+	}
+	want := []string{"Add login form\n\nUses the existing session store.", "Validate passwords"}
+	if strings.Join(messages, "|") != strings.Join(want, "|") {
+		t.Fatalf("messages = %q want %q", messages, want)
+	}
+	if messages, _ := CommitMessages(ctx, clone, "origin/missing"); len(messages) != 1 || messages[0] != "Validate passwords" {
+		t.Fatalf("messages without base = %q want only HEAD", messages)
+	}
+
+	transcript, err := PushBranch(ctx, clone, branch)
+	if err != nil {
+		t.Fatalf("PushBranch() error = %v\n%s", err, transcript)
+	}
+	if !HasUpstream(ctx, clone, branch) {
+		t.Fatal("pushed branch should track origin")
+	}
+}
+
+func TestCheckoutRemoteBranch(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	ctx := context.Background()
+	root := t.TempDir()
+	origin := filepath.Join(root, "origin")
+	clone := filepath.Join(root, "clone")
+
+	runGit(t, root, "init", "--quiet", "--initial-branch=main", origin)
+	writeFile(t, filepath.Join(origin, "README.md"), "hello\n")
+	runGit(t, origin, "add", "README.md")
+	runGit(t, origin, "commit", "--quiet", "-m", "initial")
+	runGit(t, root, "clone", "--quiet", origin, clone)
+	runGit(t, origin, "branch", "12-fix-login")
+
+	transcript, err := CheckoutRemoteBranch(ctx, clone, "12-fix-login")
+	if err != nil {
+		t.Fatalf("CheckoutRemoteBranch() error = %v\n%s", err, transcript)
+	}
+	if branch, _ := CurrentBranch(ctx, clone); branch != "12-fix-login" {
+		t.Fatalf("checked out branch = %q want 12-fix-login", branch)
+	}
+	if !HasUpstream(ctx, clone, "12-fix-login") {
+		t.Fatal("checked out branch should track origin")
+	}
+	if _, err := CheckoutRemoteBranch(ctx, clone, "12-fix-login"); err != nil {
+		t.Fatalf("second checkout of the same branch error = %v", err)
+	}
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
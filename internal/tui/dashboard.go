@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -26,7 +27,7 @@ type loadedMsg struct {
 }
 
 type issueDetailLoadedMsg struct {
-	issueIID  int64
+	key       int64
 	data      IssueDetailData
 	err       error
 	requestID int
@@ -48,53 +49,183 @@ const (
 )
 
 const (
-	focusMain   focusTarget = "main"
-	focusDetail focusTarget = "detail"
-	focusSearch focusTarget = "search"
-	focusHelp   focusTarget = "help"
-	focusError  focusTarget = "error"
+	focusMain     focusTarget = "main"
+	focusDetail   focusTarget = "detail"
+	focusSearch   focusTarget = "search"
+	focusHelp     focusTarget = "help"
+	focusComposer focusTarget = "composer"
+	focusForm     focusTarget = "form"
+	focusError    focusTarget = "error"
 )
 
 const maxMarkdownPreloadComments = 3
 const approxCommentRows = 6
 
 type DashboardModel struct {
-	provider                 DataProvider
-	ctx                      DashboardContext
-	styles                   styles
-	view                     ViewMode
-	primaryIndex             int
-	items                    []ListItem
-	selected                 int
-	width                    int
-	height                   int
-	loading                  bool
-	errorMessage             string
-	showHelp                 bool
-	spinner                  spinner.Model
-	searchInput              textinput.Model
-	searchMode               bool
-	issueState               IssueState
-	mergeRequestState        MergeRequestState
-	issueSearch              string
-	issuePage                int
-	issueHasNext             bool
-	mergeRequestPage         int
-	mergeRequestHasNext      bool
-	issueDetail              bool
-	mergeRequestDetail       bool
-	detailScroll             int
-	mergeRequestDetailScroll int
-	detailTab                issueDetailTab
-	detailData               map[int64]IssueDetailData
-	detailCache              map[string][]string
-	markdownBody             map[string][]string
-	detailLoad               bool
-	detailErr                string
-	loadingMore              bool
-	requestSeq               int
-	requestID                int
-	focus                    focusTarget
+	provider                       DataProvider
+	ctx                            DashboardContext
+	styles                         styles
+	view                           ViewMode
+	primaryIndex                   int
+	items                          []ListItem
+	selected                       int
+	width                          int
+	height                         int
+	loading                        bool
+	errorMessage                   string
+	showHelp                       bool
+	spinner                        spinner.Model
+	searchInput                    textinput.Model
+	searchMode                     bool
+	issueState                     IssueState
+	mergeRequestState              MergeRequestState
+	issueSearch                    string
+	mergeRequestSearch             string
+	issueSort                      ListSort
+	mergeRequestSort               ListSort
+	savedViews                     []SavedView
+	issuePage                      int
+	issueHasNext                   bool
+	mergeRequestPage               int
+	mergeRequestHasNext            bool
+	pipelinePage                   int
+	pipelineHasNext                bool
+	todoAction                     TodoAction
+	todoPage                       int
+	todoHasNext                    bool
+	todoMarkAllArmed               bool
+	myWorkScope                    MyWorkScope
+	myWorkPage                     int
+	myWorkHasNext                  bool
+	groupPath                      string
+	groupScope                     GroupScope
+	groupPage                      int
+	groupHasNext                   bool
+	groupReturnTo                  string
+	milestoneState                 MilestoneState
+	milestone                      *ListItem
+	milestoneScope                 MilestoneScope
+	milestonePage                  int
+	milestoneHasNext               bool
+	milestoneReturnTo              int64
+	boards                         []IssueBoard
+	boardID                        int64
+	boardColumns                   []BoardColumn
+	boardColumn                    int
+	boardRow                       int
+	epicState                      EpicState
+	epicPage                       int
+	epicHasNext                    bool
+	jumpTarget                     *ListItem
+	issueDetail                    bool
+	mergeRequestDetail             bool
+	pipelineDetail                 bool
+	detailScroll                   int
+	mergeRequestDetailScroll       int
+	mergeRequestDetailTab          mergeRequestDetailTab
+	mergeRequestDetailData         map[int64]MergeRequestDetailData
+	mergeRequestDetailLoad         bool
+	mergeRequestDetailErr          string
+	mergeRequestThread             int
+	composer                       bool
+	composerInput                  textarea.Model
+	composerTarget                 composerTarget
+	composerPreview                bool
+	composerSending                bool
+	composerErr                    string
+	composerDraftPath              string
+	issueForm                      bool
+	issueFormField                 issueFormField
+	issueFormTitle                 textinput.Model
+	issueFormDescription           textarea.Model
+	issueFormDueDate               textinput.Model
+	issueFormLabels                []string
+	issueFormAssignees             []int64
+	issueFormMilestone             int64
+	issueFormConfidential          bool
+	issueFormOptions               IssueFormOptions
+	issueFormOptionsReady          bool
+	issueFormOptionsLoad           bool
+	issueFormSending               bool
+	issueFormErr                   string
+	issueFormDraftPath             string
+	mergeRequestForm               bool
+	mergeRequestFormField          mergeRequestFormField
+	mergeRequestFormTitle          textinput.Model
+	mergeRequestFormDescription    textarea.Model
+	mergeRequestFormTarget         string
+	mergeRequestFormReviewers      []int64
+	mergeRequestFormLabels         []string
+	mergeRequestFormDraft          bool
+	mergeRequestFormSquash         bool
+	mergeRequestFormPush           bool
+	mergeRequestFormOptions        MergeRequestFormOptions
+	mergeRequestFormReady          bool
+	mergeRequestFormLoad           bool
+	mergeRequestFormSending        bool
+	mergeRequestFormErr            string
+	mergeRequestFormDraftPath      string
+	picker                         fuzzyPicker
+	pickerActive                   bool
+	pickerPurpose                  pickerPurpose
+	pickerPending                  pickerPurpose
+	pickerIssueIID                 int64
+	projectOptions                 []ProjectOption
+	notice                         string
+	mergeRequestConfirm            mergeRequestConfirm
+	mergeRequestConfirmOpen        bool
+	issueBranchConfirm             issueBranchConfirm
+	issueBranchConfirmOpen         bool
+	issueBranchInput               textinput.Model
+	filterErr                      string
+	namePrompt                     namePromptPurpose
+	nameInput                      textinput.Model
+	gitOutputOpen                  bool
+	gitOutputTitle                 string
+	gitOutput                      string
+	gitOutputErr                   string
+	gitOutputScroll                int
+	mergeRequestDiffs              map[int64][]MergeRequestDiffFile
+	mergeRequestDiffStats          map[int64][]diffFileStats
+	mergeRequestDiffFile           int
+	mergeRequestDiffSplit          bool
+	mergeRequestDiffLoad           bool
+	mergeRequestDiffErr            string
+	diffCache                      map[string][]string
+	pipelineDetailScroll           int
+	detailTab                      issueDetailTab
+	detailLink                     int
+	detailData                     map[int64]IssueDetailData
+	detailCache                    map[string][]string
+	markdownBody                   map[string][]string
+	detailLoad                     bool
+	detailErr                      string
+	pipelineDetailID               int64
+	pipelineDetailFromMergeRequest bool
+	pipelineJobCursor              int
+	pipelineDetailData             map[int64]PipelineDetailData
+	pipelineDetailLoad             bool
+	pipelineDetailErr              string
+	jobLog                         bool
+	jobLogJob                      PipelineJob
+	jobLogContent                  string
+	jobLogDoc                      jobLogDocument
+	jobLogOffset                   int64
+	jobLogStatus                   string
+	jobLogComplete                 bool
+	jobLogScroll                   int
+	jobLogFollow                   bool
+	jobLogFolds                    map[int]bool
+	jobLogSection                  int
+	jobLogCache                    map[string]jobLogView
+	jobLogLoad                     bool
+	jobLogErr                      string
+	jobLogFailures                 int
+	jobLogRequest                  int
+	loadingMore                    bool
+	requestSeq                     int
+	requestID                      int
+	focus                          focusTarget
 }
 
 func NewDashboardModel(provider DataProvider, ctx DashboardContext) DashboardModel {
@@ -103,31 +234,62 @@ func NewDashboardModel(provider DataProvider, ctx DashboardContext) DashboardMod
 	sp.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
 
 	search := textinput.New()
-	search.Prompt = "Search: "
-	search.Placeholder = "type and press Enter"
-	search.CharLimit = 120
+	search.Prompt = "Filter: "
+	search.Placeholder = "label:bug assignee:@me or text, then Enter"
+	search.CharLimit = 240
 	search.Width = 30
 
 	return DashboardModel{
-		provider:          provider,
-		ctx:               ctx,
-		styles:            newStyles(),
-		view:              IssuesView,
-		width:             100,
-		height:            40,
-		loading:           true,
-		spinner:           sp,
-		searchInput:       search,
-		issueState:        IssueStateOpened,
-		mergeRequestState: MergeRequestStateOpened,
-		detailData:        make(map[int64]IssueDetailData),
-		detailCache:       make(map[string][]string),
-		markdownBody:      make(map[string][]string),
-		requestSeq:        1,
-		requestID:         1,
-		issuePage:         1,
-		mergeRequestPage:  1,
-		focus:             focusMain,
+		provider:                    provider,
+		ctx:                         ctx,
+		styles:                      newStyles(),
+		view:                        IssuesView,
+		width:                       100,
+		height:                      40,
+		loading:                     true,
+		spinner:                     sp,
+		searchInput:                 search,
+		issueSort:                   savedListSort(ctx.Sorts, IssuesView),
+		mergeRequestSort:            savedListSort(ctx.Sorts, MergeRequestsView),
+		savedViews:                  loadSavedViews(ctx.Views),
+		composerInput:               newComposerInput(),
+		issueFormTitle:              newIssueFormTitleInput(),
+		issueFormDescription:        newIssueFormDescriptionInput(),
+		issueFormDueDate:            newIssueFormDueDateInput(),
+		issueBranchInput:            newIssueBranchInput(),
+		nameInput:                   newNameInput(),
+		mergeRequestFormTitle:       newMergeRequestFormTitleInput(),
+		mergeRequestFormDescription: newMergeRequestFormDescriptionInput(),
+		issueState:                  IssueStateOpened,
+		mergeRequestState:           MergeRequestStateOpened,
+		detailData:                  make(map[int64]IssueDetailData),
+		detailCache:                 make(map[string][]string),
+		markdownBody:                make(map[string][]string),
+		pipelineDetailData:          make(map[int64]PipelineDetailData),
+		mergeRequestDetailData:      make(map[int64]MergeRequestDetailData),
+		mergeRequestDiffs:           make(map[int64][]MergeRequestDiffFile),
+		mergeRequestDiffStats:       make(map[int64][]diffFileStats),
+		mergeRequestDiffSplit:       true,
+		diffCache:                   make(map[string][]string),
+		jobLogFolds:                 make(map[int]bool),
+		jobLogSection:               -1,
+		jobLogCache:                 make(map[string]jobLogView),
+		requestSeq:                  1,
+		requestID:                   1,
+		issuePage:                   1,
+		mergeRequestPage:            1,
+		pipelinePage:                1,
+		todoPage:                    1,
+		myWorkScope:                 MyWorkAssignedIssues,
+		myWorkPage:                  1,
+		groupScope:                  GroupContents,
+		groupPage:                   1,
+		milestoneState:              MilestoneStateActive,
+		milestoneScope:              MilestoneIssues,
+		milestonePage:               1,
+		epicState:                   EpicStateOpened,
+		epicPage:                    1,
+		focus:                       focusMain,
 	}
 }
 
@@ -143,6 +305,18 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		width, rows := m.composerViewport()
+		m.composerInput.SetWidth(width)
+		m.composerInput.SetHeight(rows)
+		width, rows = m.issueFormViewport()
+		m.issueFormTitle.Width = width - 1
+		m.issueFormDueDate.Width = width - 1
+		m.issueFormDescription.SetWidth(width)
+		m.issueFormDescription.SetHeight(rows)
+		width, rows = m.mergeRequestFormViewport()
+		m.mergeRequestFormTitle.Width = width - 1
+		m.mergeRequestFormDescription.SetWidth(width)
+		m.mergeRequestFormDescription.SetHeight(rows)
 		return m, nil
 
 	case spinner.TickMsg:
@@ -158,6 +332,9 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loadingMore = false
 		if msg.err != nil {
 			m.errorMessage = msg.err.Error()
+			m.jumpTarget = nil
+			m.groupReturnTo = ""
+			m.milestoneReturnTo = 0
 			if msg.replace {
 				m.items = nil
 				m.selected = 0
@@ -185,10 +362,61 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else {
 				m.mergeRequestPage++
 			}
+		} else if m.view == PipelinesView {
+			m.pipelineHasNext = msg.hasNextPage
+			if msg.replace {
+				m.pipelinePage = 1
+			} else {
+				m.pipelinePage++
+			}
+		} else if m.view == TodosView {
+			m.todoHasNext = msg.hasNextPage
+			if msg.replace {
+				m.todoPage = 1
+			} else {
+				m.todoPage++
+			}
+		} else if m.view == MyWorkView {
+			m.myWorkHasNext = msg.hasNextPage
+			if msg.replace {
+				m.myWorkPage = 1
+			} else {
+				m.myWorkPage++
+			}
+		} else if m.view == GroupsView {
+			m.groupHasNext = msg.hasNextPage
+			if msg.replace {
+				m.groupPage = 1
+			} else {
+				m.groupPage++
+			}
+		} else if m.view == MilestonesView {
+			m.milestoneHasNext = msg.hasNextPage
+			if msg.replace {
+				m.milestonePage = 1
+			} else {
+				m.milestonePage++
+			}
+		} else if m.view == EpicsView {
+			m.epicHasNext = msg.hasNextPage
+			if msg.replace {
+				m.epicPage = 1
+			} else {
+				m.epicPage++
+			}
 		}
 		if m.selected >= len(m.items) {
 			m.selected = 0
 		}
+		if m.groupReturnTo != "" && msg.replace {
+			m = m.selectGroupReturn()
+		}
+		if m.milestoneReturnTo != 0 && msg.replace {
+			m = m.selectMilestoneReturn()
+		}
+		if m.jumpTarget != nil && msg.replace {
+			return m.openJumpTarget()
+		}
 		if !m.hasIssueDetailsSelection() {
 			m.issueDetail = false
 			m.detailScroll = 0
@@ -199,6 +427,18 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if !m.hasMergeRequestDetailsSelection() {
 			m.mergeRequestDetail = false
 			m.mergeRequestDetailScroll = 0
+			m.mergeRequestDetailTab = mergeRequestDetailTabOverview
+			m.mergeRequestDiffLoad = false
+			m.mergeRequestDiffErr = ""
+			m.mergeRequestDetailLoad = false
+			m.mergeRequestDetailErr = ""
+		}
+		if !m.pipelineDetailFromMergeRequest && !m.hasPipelineDetailsSelection() {
+			m.pipelineDetail = false
+			m.jobLog = false
+			m.pipelineDetailScroll = 0
+			m.pipelineDetailLoad = false
+			m.pipelineDetailErr = ""
 		}
 		return m, nil
 
@@ -207,19 +447,174 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		item, ok := m.selectedIssueItem()
-		if !ok || item.Issue == nil || item.Issue.IID != msg.issueIID {
+		if !ok || issueDetailKey(item) != msg.key {
 			return m, nil
 		}
 		m.detailLoad = false
 		if msg.err != nil {
 			m.detailErr = msg.err.Error()
+			m.invalidateDetailCacheForIssue(msg.key)
 			return m, nil
 		}
 		m.detailErr = ""
-		m.detailData[msg.issueIID] = msg.data
-		m.invalidateDetailCacheForIssue(msg.issueIID)
+		m.detailData[msg.key] = msg.data
+		m.invalidateDetailCacheForIssue(msg.key)
 		return m, m.preloadMarkdownCmd()
 
+	case pipelineDetailLoadedMsg:
+		if msg.requestID != m.requestID || !m.pipelineDetail {
+			return m, nil
+		}
+		if msg.pipelineID != m.pipelineDetailID {
+			return m, nil
+		}
+		m.pipelineDetailLoad = false
+		if msg.err != nil {
+			m.pipelineDetailErr = msg.err.Error()
+			return m, nil
+		}
+		m.pipelineDetailErr = ""
+		m.pipelineDetailData[msg.pipelineID] = msg.data
+		m.pipelineJobCursor = firstFailedJobIndex(msg.data)
+		return m.scrollPipelineJobIntoView(), nil
+
+	case mergeRequestPipelineLoadedMsg:
+		if msg.requestID != m.requestID || !m.pipelineDetail || !m.pipelineDetailFromMergeRequest {
+			return m, nil
+		}
+		item, ok := m.selectedMergeRequestItem()
+		if !ok || item.MergeRequest.IID != msg.mergeRequestIID {
+			return m, nil
+		}
+		m.pipelineDetailLoad = false
+		if msg.err != nil {
+			m.pipelineDetailErr = msg.err.Error()
+			return m, nil
+		}
+		m.pipelineDetailErr = ""
+		m.pipelineDetailID = msg.data.Pipeline.ID
+		m.pipelineDetailData[msg.data.Pipeline.ID] = msg.data
+		m.pipelineJobCursor = firstFailedJobIndex(msg.data)
+		return m.scrollPipelineJobIntoView(), nil
+
+	case noteCreatedMsg:
+		return m.applyNoteCreated(msg)
+
+	case editorFinishedMsg:
+		return m.applyEditorFinished(msg)
+
+	case issueFormOptionsLoadedMsg:
+		return m.applyIssueFormOptions(msg)
+
+	case issueCreatedMsg:
+		return m.applyIssueCreated(msg)
+
+	case issueFormEditorFinishedMsg:
+		return m.applyIssueFormEditorFinished(msg)
+
+	case mergeRequestFormEditorFinishedMsg:
+		return m.applyMergeRequestFormEditorFinished(msg)
+
+	case issueUpdatedMsg:
+		return m.applyIssueUpdated(msg)
+
+	case boardLoadedMsg:
+		return m.applyBoardLoaded(msg)
+
+	case boardCardMovedMsg:
+		return m.applyBoardCardMoved(msg)
+
+	case epicChildrenLoadedMsg:
+		return m.applyEpicChildrenLoaded(msg)
+
+	case mergeRequestActionDoneMsg:
+		return m.applyMergeRequestActionDone(msg)
+
+	case mergeRequestCheckedOutMsg:
+		return m.applyMergeRequestCheckedOut(msg)
+
+	case todoDoneMsg:
+		return m.applyTodoDone(msg)
+
+	case todoTargetLoadedMsg:
+		return m.applyTodoTargetLoaded(msg)
+
+	case groupProjectCheckedMsg:
+		return m.applyGroupProjectChecked(msg)
+
+	case projectOptionsLoadedMsg:
+		return m.applyProjectOptionsLoaded(msg)
+
+	case projectSwitchedMsg:
+		return m.applyProjectSwitched(msg)
+
+	case projectFavoriteToggledMsg:
+		return m.applyProjectFavoriteToggled(msg)
+
+	case issueFilterSavedMsg:
+		return m.applyIssueFilterSaved(msg)
+	case listSortSavedMsg:
+		return m.applyListSortSaved(msg)
+	case savedViewSavedMsg:
+		return m.applySavedViewSaved(msg)
+
+	case issueBranchCreatedMsg:
+		return m.applyIssueBranchCreated(msg)
+
+	case mergeRequestFormOptionsLoadedMsg:
+		return m.applyMergeRequestFormOptions(msg)
+
+	case mergeRequestBranchPushedMsg:
+		return m.applyMergeRequestBranchPushed(msg)
+
+	case mergeRequestCreatedMsg:
+		return m.applyMergeRequestCreated(msg)
+
+	case mergeRequestDetailLoadedMsg:
+		if msg.requestID != m.requestID || !m.mergeRequestDetail {
+			return m, nil
+		}
+		item, ok := m.selectedMergeRequestItem()
+		if !ok || item.MergeRequest.IID != msg.mergeRequestIID {
+			return m, nil
+		}
+		m.mergeRequestDetailLoad = false
+		if msg.err != nil {
+			m.mergeRequestDetailErr = msg.err.Error()
+			return m, nil
+		}
+		m.mergeRequestDetailErr = ""
+		m.mergeRequestDetailData[msg.mergeRequestIID] = msg.data
+		return m, nil
+
+	case mergeRequestDiffsLoadedMsg:
+		if msg.requestID != m.requestID || !m.mergeRequestDetail {
+			return m, nil
+		}
+		item, ok := m.selectedMergeRequestItem()
+		if !ok || item.MergeRequest.IID != msg.mergeRequestIID {
+			return m, nil
+		}
+		m.mergeRequestDiffLoad = false
+		if msg.err != nil {
+			m.mergeRequestDiffErr = msg.err.Error()
+			return m, nil
+		}
+		m.mergeRequestDiffErr = ""
+		m.mergeRequestDiffs[msg.mergeRequestIID] = msg.files
+		m.mergeRequestDiffStats[msg.mergeRequestIID] = countDiffFileStats(msg.files)
+		m.invalidateDiffCache(msg.mergeRequestIID)
+		return m, nil
+
+	case jobTraceLoadedMsg:
+		return m.applyJobTrace(msg)
+
+	case jobTracePollMsg:
+		if !m.jobLog || msg.requestID != m.jobLogRequest || msg.jobID != m.jobLogJob.ID {
+			return m, nil
+		}
+		return m, m.loadJobTraceCmd()
+
 	case markdownRenderedMsg:
 		if msg.cacheKey == "" || len(msg.lines) == 0 {
 			return m, nil
@@ -255,6 +650,38 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if m.pickerActive {
+			return m.handlePickerKey(msg)
+		}
+
+		if m.mergeRequestConfirmOpen {
+			return m.handleMergeRequestConfirmKey(msg)
+		}
+
+		if m.issueBranchConfirmOpen {
+			return m.handleIssueBranchConfirmKey(msg)
+		}
+
+		if m.namePrompt != namePromptClosed {
+			return m.handleNamePromptKey(msg)
+		}
+
+		if m.gitOutputOpen {
+			return m.handleGitOutputKey(msg.String())
+		}
+
+		if m.composer {
+			return m.handleComposerKey(msg)
+		}
+
+		if m.issueForm {
+			return m.handleIssueFormKey(msg)
+		}
+
+		if m.mergeRequestForm {
+			return m.handleMergeRequestFormKey(msg)
+		}
+
 		if m.showHelp {
 			m.focus = focusHelp
 			switch msg.String() {
@@ -271,22 +698,30 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.searchInput, cmd = m.searchInput.Update(msg)
 			switch msg.String() {
 			case "enter":
-				m.searchMode = false
-				m.focus = focusMain
-				m.searchInput.Blur()
-				m.issueSearch = strings.TrimSpace(m.searchInput.Value())
-				m.selected = 0
-				return m.startLoadCurrentView()
+				if m.view == MergeRequestsView {
+					return m.applyMergeRequestFilterQuery(strings.TrimSpace(m.searchInput.Value()))
+				}
+				return m.applyIssueFilterQuery(strings.TrimSpace(m.searchInput.Value()))
 			case "esc":
 				m.searchMode = false
 				m.focus = focusMain
 				m.searchInput.Blur()
-				m.searchInput.SetValue(m.issueSearch)
+				m.filterErr = ""
 				return m, nil
 			}
 			return m, cmd
 		}
 
+		if m.jobLog {
+			m.focus = focusDetail
+			return m.handleJobLogKey(msg.String())
+		}
+
+		if m.pipelineDetail {
+			m.focus = focusDetail
+			return m.handlePipelineDetailKey(msg.String())
+		}
+
 		if m.issueDetail {
 			m.focus = focusDetail
 			switch msg.String() {
@@ -329,9 +764,9 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			case "r":
 				item, ok := m.selectedIssueItem()
-				if ok && item.Issue != nil {
-					delete(m.detailData, item.Issue.IID)
-					m.invalidateMarkdownCacheForIssue(item.Issue.IID)
+				if ok {
+					delete(m.detailData, issueDetailKey(item))
+					m.invalidateMarkdownCacheForIssue(issueDetailKey(item))
 					m.clearDetailCache()
 				}
 				cmd := m.loadIssueDetailDataCmd()
@@ -380,37 +815,23 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.detailErr = ""
 				}
 				return m, tea.Batch(cmd, m.preloadMarkdownCmd())
+			case "C":
+				return m.openIssueComposer()
+			case "b":
+				return m.openIssueBranchConfirm()
+			}
+			if model, cmd, handled := m.handleIssueLinkKey(msg.String()); handled {
+				return model, cmd
+			}
+			if model, cmd, handled := m.handleIssueEditKey(msg.String()); handled {
+				return model, cmd
 			}
 			return m, nil
 		}
 
 		if m.mergeRequestDetail {
 			m.focus = focusDetail
-			switch msg.String() {
-			case "esc":
-				m.mergeRequestDetail = false
-				m.focus = focusMain
-				m.mergeRequestDetailScroll = 0
-				return m, nil
-			case "q", "ctrl+c":
-				return m, tea.Quit
-			case "j", "down":
-				m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll + 1)
-				return m, nil
-			case "k", "up":
-				m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll - 1)
-				return m, nil
-			case "pgdown":
-				m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll + 8)
-				return m, nil
-			case "pgup":
-				m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll - 8)
-				return m, nil
-			case "?":
-				m.showHelp = true
-				return m, nil
-			}
-			return m, nil
+			return m.handleMergeRequestDetailKey(msg.String())
 		}
 
 		if model, cmd, handled := m.handleIssueScreenKey(msg.String()); handled {
@@ -419,6 +840,27 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if model, cmd, handled := m.handleMergeRequestScreenKey(msg.String()); handled {
 			return model, cmd
 		}
+		if model, cmd, handled := m.handlePipelineScreenKey(msg.String()); handled {
+			return model, cmd
+		}
+		if model, cmd, handled := m.handleTodoScreenKey(msg.String()); handled {
+			return model, cmd
+		}
+		if model, cmd, handled := m.handleMyWorkScreenKey(msg.String()); handled {
+			return model, cmd
+		}
+		if model, cmd, handled := m.handleGroupScreenKey(msg.String()); handled {
+			return model, cmd
+		}
+		if model, cmd, handled := m.handleMilestoneScreenKey(msg.String()); handled {
+			return model, cmd
+		}
+		if model, cmd, handled := m.handleBoardScreenKey(msg.String()); handled {
+			return model, cmd
+		}
+		if model, cmd, handled := m.handleEpicScreenKey(msg.String()); handled {
+			return model, cmd
+		}
 
 		switch msg.String() {
 		case "q", "ctrl+c":
@@ -426,7 +868,7 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "j", "down":
 			if m.selected < len(m.items)-1 {
 				m.selected++
-				if m.shouldLoadMoreIssues() || m.shouldLoadMoreMergeRequests() {
+				if m.shouldLoadMoreIssues() || m.shouldLoadMoreMergeRequests() || m.shouldLoadMorePipelines() || m.shouldLoadMoreTodos() || m.shouldLoadMoreMyWork() || m.shouldLoadMoreGroups() || m.shouldLoadMoreMilestones() || m.shouldLoadMoreEpics() {
 					return m.startLoadMoreCurrentView()
 				}
 			}
@@ -435,14 +877,14 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selected--
 			}
 		case "h", "left":
-			if m.view == MergeRequestsView {
-				m.view = IssuesView
+			if prev := prevDashboardView(m.view); prev != m.view {
+				m.view = prev
 				m.selected = 0
 				return m.startLoadCurrentView()
 			}
 		case "l", "right":
-			if m.view == IssuesView {
-				m.view = MergeRequestsView
+			if next := nextDashboardView(m.view); next != m.view {
+				m.view = next
 				m.selected = 0
 				return m.startLoadCurrentView()
 			}
@@ -483,6 +925,40 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.view = MergeRequestsView
 			m.selected = 0
 			return m.startLoadCurrentView()
+		case "4":
+			if m.view == PipelinesView {
+				return m, nil
+			}
+			m.view = PipelinesView
+			m.selected = 0
+			return m.startLoadCurrentView()
+		case "5":
+			if m.view == TodosView {
+				return m, nil
+			}
+			m.view = TodosView
+			m.selected = 0
+			return m.startLoadCurrentView()
+		case "6":
+			if m.view == MyWorkView {
+				return m, nil
+			}
+			m.view = MyWorkView
+			m.selected = 0
+			return m.startLoadCurrentView()
+		case "7":
+			if m.view == GroupsView {
+				return m, nil
+			}
+			m.view = GroupsView
+			m.selected = 0
+			return m.startLoadCurrentView()
+		case "8", "9", "0":
+			return m.openSavedViewKey(msg.String())
+		case "v":
+			return m.openSavedViewPicker()
+		case "P":
+			return m.openProjectSwitcher()
 		case "?":
 			m.showHelp = true
 		}
@@ -500,6 +976,46 @@ func (m DashboardModel) View() string {
 	contentHeight := max(8, m.height-5)
 	status := m.renderStatusBar(totalWidth)
 
+	if m.composer {
+		composer := m.renderComposerFullscreen(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, composer, status))
+	}
+	if m.issueForm {
+		form := m.renderIssueFormFullscreen(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, form, status))
+	}
+	if m.pickerActive {
+		picker := m.renderPickerFullscreen(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, picker, status))
+	}
+	if m.mergeRequestConfirmOpen {
+		confirm := m.renderMergeRequestConfirm(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, confirm, status))
+	}
+	if m.issueBranchConfirmOpen {
+		confirm := m.renderIssueBranchConfirm(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, confirm, status))
+	}
+	if m.namePrompt != namePromptClosed {
+		popup := m.renderNamePrompt(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, popup, status))
+	}
+	if m.gitOutputOpen {
+		output := m.renderGitOutput(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, output, status))
+	}
+	if m.mergeRequestForm {
+		form := m.renderMergeRequestFormFullscreen(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, form, status))
+	}
+	if m.jobLog {
+		detail := m.renderJobLogFullscreen(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, detail, status))
+	}
+	if m.pipelineDetail {
+		detail := m.renderPipelineDetailFullscreen(totalWidth, contentHeight)
+		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, detail, status))
+	}
 	if m.issueDetail {
 		detail := m.renderIssueDetailFullscreen(totalWidth, contentHeight)
 		return m.styles.app.Render(lipgloss.JoinVertical(lipgloss.Left, detail, status))
@@ -522,18 +1038,30 @@ func (m DashboardModel) renderSidebar(width int, height int) string {
 		m.styles.title.Render("Navigation"),
 		"",
 		m.navLabel(IssuesView, fitLine("1. Issues", width-6)),
-		m.navLabel(MergeRequestsView, fitLine("2. Merge Requests", width-6)),
+	}
+	items = append(items, m.savedViewNavLines(IssuesView, width)...)
+	items = append(items, m.navLabel(MergeRequestsView, fitLine("2. Merge Requests", width-6)))
+	items = append(items, m.savedViewNavLines(MergeRequestsView, width)...)
+	items = append(items,
+		m.navLabel(PipelinesView, fitLine("4. Pipelines", width-6)),
+		m.navLabel(TodosView, fitLine("5. Todos", width-6)),
+		m.navLabel(MyWorkView, fitLine("6. My Work", width-6)),
+		m.navLabel(GroupsView, fitLine("7. Groups", width-6)),
+		m.navLabel(MilestonesView, fitLine("   Milestones", width-6)),
+		m.navLabel(BoardsView, fitLine("   Boards", width-6)),
+		m.navLabel(EpicsView, fitLine("   Epics", width-6)),
 		"",
 		m.styles.dim.Render("j/k or arrows to move"),
 		m.styles.dim.Render("h/l tab to switch"),
+		m.styles.dim.Render("P switch project"),
 		m.styles.dim.Render("q quit, ? help"),
-	}
+	)
 
 	return renderSizedBox(m.styles.panel, width, height, strings.Join(items, "\n"))
 }
 
 func (m DashboardModel) navLabel(view ViewMode, label string) string {
-	if m.view == view {
+	if m.view == view && m.activeSavedView() < 0 {
 		return m.styles.sidebarActive.Render("› " + label)
 	}
 	return m.styles.sidebar.Render("  " + label)
@@ -543,18 +1071,35 @@ func (m DashboardModel) renderMain(width int, height int) string {
 	header := m.styles.header.Render(m.viewTitle())
 
 	lines := []string{header}
-	if m.view == IssuesView {
+	switch m.view {
+	case IssuesView:
 		lines = append(lines, m.renderIssueBody(width)...)
-	} else {
+	case PipelinesView:
+		lines = append(lines, m.renderPipelineBody(width)...)
+	case TodosView:
+		lines = append(lines, m.renderTodoBody(width)...)
+	case MyWorkView:
+		lines = append(lines, m.renderMyWorkBody(width)...)
+	case GroupsView:
+		lines = append(lines, m.renderGroupBody(width)...)
+	case MilestonesView:
+		lines = append(lines, m.renderMilestoneBody(width)...)
+	case BoardsView:
+		lines = append(lines, m.renderBoardBody(width)...)
+	case EpicsView:
+		lines = append(lines, m.renderEpicBody(width)...)
+	default:
 		lines = append(lines, m.renderMergeRequestBody(width)...)
 	}
 	if m.errorMessage != "" {
-		lines = append(lines, m.styles.errorPopup.UnsetWidth().UnsetBackground().BorderForeground(lipgloss.Color("196")).Render(" Load error: "+fitLine(m.errorMessage, max(12, width-16))))
+		lines = append(lines, m.styles.errorPopup.UnsetWidth().UnsetBackground().BorderForeground(lipgloss.Color("196")).Render(" Error: "+fitLine(m.errorMessage, max(12, width-16))))
 		lines = append(lines, m.styles.dim.Render(" press r to retry, Esc to dismiss"))
 	}
 	bodyRows := max(1, height-len(lines)-2)
 	if m.loading {
 		lines = append(lines, "  "+m.spinner.View()+" Loading...")
+	} else if m.view == BoardsView {
+		lines = append(lines, m.renderBoardColumns(max(10, width-8), bodyRows)...)
 	} else if len(m.items) == 0 {
 		lines = append(lines, "  No items")
 	} else {
@@ -573,7 +1118,7 @@ func (m DashboardModel) renderMain(width int, height int) string {
 func (m DashboardModel) renderListLines(contentWidth int, bodyRows int) []string {
 	rowWidth := listRowWidth(contentWidth)
 	rowsPerItem := 1
-	if m.view == IssuesView || m.view == MergeRequestsView {
+	if m.view == IssuesView || m.view == MergeRequestsView || m.view == PipelinesView || m.view == TodosView || m.view == MyWorkView || m.view == GroupsView || m.view == MilestonesView || m.view == EpicsView {
 		rowsPerItem = 2
 	}
 	visibleItems := max(1, bodyRows/rowsPerItem)
@@ -587,6 +1132,12 @@ func (m DashboardModel) renderListLines(contentWidth int, bodyRows int) []string
 			prefix = "› "
 			rowStyle = m.styles.selectedRow
 		}
+		if m.view == EpicsView {
+			lines = append(lines, rowStyle.Render(prefix+fitLine(epicTreeTitle(item), rowWidth)))
+			meta := "  " + fitLine(epicTreeIndent(item)+epicListMeta(item), rowWidth)
+			lines = append(lines, m.styles.dim.Render(meta))
+			continue
+		}
 		line := prefix + fitLine(item.Title, rowWidth)
 		lines = append(lines, rowStyle.Render(line))
 		if m.view == IssuesView {
@@ -598,6 +1149,21 @@ func (m DashboardModel) renderListLines(contentWidth int, bodyRows int) []string
 				meta = "-"
 			}
 			lines = append(lines, m.styles.dim.Render("  "+fitLine(meta, rowWidth)))
+		} else if m.view == PipelinesView {
+			meta := "  " + fitLine(pipelineListMeta(item), rowWidth)
+			lines = append(lines, m.styles.dim.Render(meta))
+		} else if m.view == TodosView {
+			meta := "  " + fitLine(todoListMeta(item), rowWidth)
+			lines = append(lines, m.styles.dim.Render(meta))
+		} else if m.view == MyWorkView {
+			meta := "  " + fitLine(myWorkListMeta(item), rowWidth)
+			lines = append(lines, m.styles.dim.Render(meta))
+		} else if m.view == GroupsView {
+			meta := "  " + fitLine(groupListMeta(item), rowWidth)
+			lines = append(lines, m.styles.dim.Render(meta))
+		} else if m.view == MilestonesView {
+			meta := "  " + fitLine(milestoneListMeta(item), rowWidth)
+			lines = append(lines, m.styles.dim.Render(meta))
 		}
 	}
 	if len(m.items) > visibleItems {
@@ -608,14 +1174,21 @@ func (m DashboardModel) renderListLines(contentWidth int, bodyRows int) []string
 }
 
 func (m DashboardModel) renderIssueDetailFullscreen(width int, height int) string {
+	title, hints := "Issue Detail", "Esc return | j/k scroll | tab shift+tab or d/a/c tabs | [/] linked item, enter open | x close/reopen | m assign me | L labels | M milestone | b branch"
+	if m.view == EpicsView {
+		title, hints = "Epic Detail", "Esc return | j/k scroll | tab shift+tab or d/a/c tabs"
+	}
 	contentWidth := max(10, width-6)
 	viewportWidth := max(8, contentWidth-2)
 	lines := []string{
-		m.styles.header.Render("Issue Detail"),
-		m.styles.dim.Render("Esc return | j/k scroll | tab shift+tab or d/a/c tabs"),
+		m.styles.header.Render(title),
+		m.styles.dim.Render(hints),
 		m.renderIssueDetailTabs(contentWidth),
 		"",
 	}
+	if m.errorMessage != "" {
+		lines = append(lines, m.styles.statusFailed.Render(fitLine("Error: "+m.errorMessage+" (esc to dismiss)", contentWidth)), "")
+	}
 	detailLines := m.issueDetailLines(viewportWidth)
 	if len(detailLines) == 0 {
 		lines = append(lines, m.styles.dim.Render("No issue details available"))
@@ -653,16 +1226,58 @@ func (m DashboardModel) renderStatusBar(width int) string {
 	} else if m.view == IssuesView {
 		status += fmt.Sprintf(" | issues: %s", issueStateLabel(m.issueState))
 		if strings.TrimSpace(m.issueSearch) != "" {
-			status += fmt.Sprintf(" | search=%q", m.issueSearch)
+			status += fmt.Sprintf(" | filter=%q", m.issueSearch)
 		}
 		if m.loadingMore {
 			status += " | loading more"
 		}
 	} else if m.view == MergeRequestsView {
 		status += fmt.Sprintf(" | merge requests: %s", mergeRequestStateLabel(m.mergeRequestState))
+		if strings.TrimSpace(m.mergeRequestSearch) != "" {
+			status += fmt.Sprintf(" | filter=%q", m.mergeRequestSearch)
+		}
 		if m.loadingMore {
 			status += " | loading more"
 		}
+	} else if m.view == PipelinesView {
+		status += fmt.Sprintf(" | pipelines: %d loaded", len(m.items))
+		if m.loadingMore {
+			status += " | loading more"
+		}
+	} else if m.view == TodosView {
+		status += fmt.Sprintf(" | todos: %s, %d pending", todoActionLabel(m.todoAction), len(m.items))
+		if m.loadingMore {
+			status += " | loading more"
+		}
+	} else if m.view == MyWorkView {
+		status += fmt.Sprintf(" | my work: %s", myWorkScopeLabel(m.myWorkScope))
+		if m.loadingMore {
+			status += " | loading more"
+		}
+	} else if m.view == GroupsView {
+		status += fmt.Sprintf(" | groups: %s, %s", fallbackValue(m.groupPath, "top level"), groupScopeLabel(m.groupScope))
+		if m.loadingMore {
+			status += " | loading more"
+		}
+	} else if m.view == MilestonesView {
+		if m.milestone != nil {
+			status += fmt.Sprintf(" | milestone: %s, %s", m.milestone.Title, milestoneScopeLabel(m.milestoneScope))
+		} else {
+			status += fmt.Sprintf(" | milestones: %s", milestoneStateLabel(m.milestoneState))
+		}
+		if m.loadingMore {
+			status += " | loading more"
+		}
+	} else if m.view == BoardsView {
+		status += " | board: " + m.boardStatus()
+	} else if m.view == EpicsView {
+		status += fmt.Sprintf(" | epics: %s", epicStateLabel(m.epicState))
+		if m.loadingMore {
+			status += " | loading more"
+		}
+	}
+	if m.notice != "" {
+		status += " | " + m.notice
 	}
 	innerWidth := max(1, width-m.styles.status.GetHorizontalFrameSize())
 	return m.styles.status.Width(innerWidth).Render(fitLine(status, innerWidth))
@@ -683,7 +1298,8 @@ func (m DashboardModel) renderHelp() string {
 		"  j/k or up/down      Move in list/selection",
 		"  h/l or left/right   Switch view",
 		"  tab/shift+tab       Toggle issues and merge requests",
-		"  1/2                 Jump to issues/merge requests",
+		"  1/2/4/5/6/7         Jump to issues/merge requests/pipelines/todos/my work/groups",
+		"  P                   Switch project or GitLab instance",
 		"",
 		"Issues:",
 	}
@@ -691,16 +1307,69 @@ func (m DashboardModel) renderHelp() string {
 	for _, hint := range issueKeyHints {
 		lines = append(lines, "  "+hint)
 	}
+	for _, hint := range issueEditKeyHints {
+		lines = append(lines, "  "+hint)
+	}
 	lines = append(lines, "", "Merge Requests:")
 	for _, hint := range mergeRequestKeyHints {
 		lines = append(lines, "  "+hint)
 	}
+	for _, hint := range mergeRequestActionKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Pipelines:")
+	for _, hint := range pipelineKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Todos:")
+	for _, hint := range todoKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "My Work:")
+	for _, hint := range myWorkKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Groups:")
+	for _, hint := range groupKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Milestones:")
+	for _, hint := range milestoneKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Boards:")
+	for _, hint := range boardKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Epics:")
+	for _, hint := range epicKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Job Log:")
+	for _, hint := range jobLogKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "Comment Composer:")
+	for _, hint := range composerKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "New Issue:")
+	for _, hint := range issueFormKeyHints {
+		lines = append(lines, "  "+hint)
+	}
+	lines = append(lines, "", "New Merge Request:")
+	for _, hint := range mergeRequestFormKeyHints {
+		lines = append(lines, "  "+hint)
+	}
 	lines = append(lines,
 		"",
 		"Common:",
 		"  esc                 Close detail",
 		"  d/a/c               Jump Detail/Activities/Comments",
-		"  /                   Search issues",
+		"  /                   Filter issues and merge requests",
+		"  s/O                 Sort issues and merge requests by the next field/reverse",
+		"  V                   Save the issue or merge request list as a view",
+		"  v or 8/9/0          Open a saved view",
 		"  r                   Retry load (errors)",
 		"  q                   Quit",
 		"  ?                   Toggle help",
@@ -709,6 +1378,7 @@ func (m DashboardModel) renderHelp() string {
 }
 
 func (m DashboardModel) startLoadCurrentView() (tea.Model, tea.Cmd) {
+	m.notice = ""
 	if m.view == PrimaryView {
 		m.loading = false
 		m.loadingMore = false
@@ -716,11 +1386,25 @@ func (m DashboardModel) startLoadCurrentView() (tea.Model, tea.Cmd) {
 		m.errorMessage = ""
 		m.issueDetail = false
 		m.mergeRequestDetail = false
+		m.pipelineDetail = false
 		m.detailScroll = 0
 		m.mergeRequestDetailScroll = 0
+		m.mergeRequestDetailTab = mergeRequestDetailTabOverview
+		m.mergeRequestDiffLoad = false
+		m.mergeRequestDiffErr = ""
+		m.mergeRequestDetailLoad = false
+		m.mergeRequestDetailErr = ""
+		m.pipelineDetailScroll = 0
 		m.detailTab = issueDetailTabOverview
 		m.detailLoad = false
 		m.detailErr = ""
+		m.pipelineDetailLoad = false
+		m.pipelineDetailErr = ""
+		m.pipelineDetailID = 0
+		m.pipelineDetailFromMergeRequest = false
+		m.pipelineJobCursor = 0
+		m.jobLog = false
+		m.jobLogRequest++
 		return m, nil
 	}
 
@@ -728,11 +1412,25 @@ func (m DashboardModel) startLoadCurrentView() (tea.Model, tea.Cmd) {
 	m.loadingMore = false
 	m.issueDetail = false
 	m.mergeRequestDetail = false
+	m.pipelineDetail = false
 	m.detailScroll = 0
 	m.mergeRequestDetailScroll = 0
+	m.mergeRequestDetailTab = mergeRequestDetailTabOverview
+	m.mergeRequestDiffLoad = false
+	m.mergeRequestDiffErr = ""
+	m.mergeRequestDetailLoad = false
+	m.mergeRequestDetailErr = ""
+	m.pipelineDetailScroll = 0
 	m.detailTab = issueDetailTabOverview
 	m.detailLoad = false
 	m.detailErr = ""
+	m.pipelineDetailLoad = false
+	m.pipelineDetailErr = ""
+	m.pipelineDetailID = 0
+	m.pipelineDetailFromMergeRequest = false
+	m.pipelineJobCursor = 0
+	m.jobLog = false
+	m.jobLogRequest++
 	m.clearDetailCache()
 	m.requestSeq++
 	m.requestID = m.requestSeq
@@ -743,6 +1441,43 @@ func (m DashboardModel) startLoadCurrentView() (tea.Model, tea.Cmd) {
 	if m.view == MergeRequestsView {
 		m.mergeRequestPage = 1
 		m.mergeRequestHasNext = false
+		for key := range m.mergeRequestDetailData {
+			delete(m.mergeRequestDetailData, key)
+		}
+		for key := range m.mergeRequestDiffs {
+			delete(m.mergeRequestDiffs, key)
+		}
+		clear(m.mergeRequestDiffStats)
+		for key := range m.diffCache {
+			delete(m.diffCache, key)
+		}
+	}
+	if m.view == PipelinesView {
+		m.pipelinePage = 1
+		m.pipelineHasNext = false
+		for key := range m.pipelineDetailData {
+			delete(m.pipelineDetailData, key)
+		}
+	}
+	if m.view == TodosView {
+		m.todoPage = 1
+		m.todoHasNext = false
+	}
+	if m.view == MyWorkView {
+		m.myWorkPage = 1
+		m.myWorkHasNext = false
+	}
+	if m.view == GroupsView {
+		m.groupPage = 1
+		m.groupHasNext = false
+	}
+	if m.view == MilestonesView {
+		m.milestonePage = 1
+		m.milestoneHasNext = false
+	}
+	if m.view == EpicsView {
+		m.epicPage = 1
+		m.epicHasNext = false
 	}
 	return m, m.loadCurrentViewCmd(m.requestID, true, 1)
 }
@@ -754,29 +1489,79 @@ func (m DashboardModel) startLoadMoreCurrentView() (tea.Model, tea.Cmd) {
 	if m.view == MergeRequestsView && !m.shouldLoadMoreMergeRequests() {
 		return m, nil
 	}
-	if m.view != IssuesView && m.view != MergeRequestsView {
+	if m.view == PipelinesView && !m.shouldLoadMorePipelines() {
+		return m, nil
+	}
+	if m.view == TodosView && !m.shouldLoadMoreTodos() {
+		return m, nil
+	}
+	if m.view == MyWorkView && !m.shouldLoadMoreMyWork() {
+		return m, nil
+	}
+	if m.view == GroupsView && !m.shouldLoadMoreGroups() {
+		return m, nil
+	}
+	if m.view == MilestonesView && !m.shouldLoadMoreMilestones() {
+		return m, nil
+	}
+	if m.view == EpicsView && !m.shouldLoadMoreEpics() {
+		return m, nil
+	}
+	if m.view != IssuesView && m.view != MergeRequestsView && m.view != PipelinesView && m.view != TodosView && m.view != MyWorkView && m.view != GroupsView && m.view != MilestonesView && m.view != EpicsView {
 		return m, nil
 	}
 	m.loadingMore = true
 	m.requestSeq++
 	m.requestID = m.requestSeq
 	nextPage := 2
-	if m.view == IssuesView {
+	switch m.view {
+	case IssuesView:
 		nextPage = m.issuePage + 1
-	} else {
+	case PipelinesView:
+		nextPage = m.pipelinePage + 1
+	case TodosView:
+		nextPage = m.todoPage + 1
+	case MyWorkView:
+		nextPage = m.myWorkPage + 1
+	case GroupsView:
+		nextPage = m.groupPage + 1
+	case MilestonesView:
+		nextPage = m.milestonePage + 1
+	case EpicsView:
+		nextPage = m.epicPage + 1
+	default:
 		nextPage = m.mergeRequestPage + 1
 	}
 	return m, m.loadCurrentViewCmd(m.requestID, false, nextPage)
 }
 
+// hasIssueDetailsSelection reports whether the selection can be shown in the
+// issue detail view: an issue in the issue list or an epic in the epics view.
 func (m DashboardModel) hasIssueDetailsSelection() bool {
-	if m.view != IssuesView {
+	if m.view != IssuesView && m.view != EpicsView {
 		return false
 	}
 	if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
 		return false
 	}
-	return m.items[m.selected].Issue != nil
+	item := m.items[m.selected]
+	if m.view == EpicsView {
+		return item.Epic != nil && item.Issue != nil
+	}
+	return item.Issue != nil
+}
+
+// issueDetailKey keys the detail data and rendered markdown of an item in the
+// issue detail view: the issue IID, or the negated epic ID so epics never
+// share an entry with an issue.
+func issueDetailKey(item ListItem) int64 {
+	if item.Epic != nil {
+		return -item.Epic.ID
+	}
+	if item.Issue != nil {
+		return item.Issue.IID
+	}
+	return 0
 }
 
 func (m DashboardModel) selectedIssueItem() (ListItem, bool) {
@@ -820,7 +1605,7 @@ func (m DashboardModel) mergeRequestDetailViewport() (int, int) {
 	totalWidth := max(60, m.width-2)
 	contentHeight := max(8, m.height-5)
 	contentWidth := max(10, totalWidth-m.styles.panel.GetHorizontalFrameSize()-2)
-	bodyRows := max(1, contentHeight-m.styles.panel.GetVerticalFrameSize()-3)
+	bodyRows := max(1, contentHeight-m.styles.panel.GetVerticalFrameSize()-4)
 	return contentWidth, bodyRows
 }
 
@@ -833,6 +1618,14 @@ func (m DashboardModel) mergeRequestDetailLines(width int) []string {
 	if details == nil {
 		return nil
 	}
+	switch m.mergeRequestDetailTab {
+	case mergeRequestDetailTabChanges:
+		return m.mergeRequestChangesLines(width)
+	case mergeRequestDetailTabThreads:
+		return m.mergeRequestThreadLines(width, details.IID)
+	case mergeRequestDetailTabActivity:
+		return m.mergeRequestActivityLines(width, details.IID)
+	}
 
 	iid := "-"
 	if details.IID > 0 {
@@ -850,9 +1643,22 @@ func (m DashboardModel) mergeRequestDetailLines(width int) []string {
 		fmt.Sprintf("Created: %s", fallbackValue(details.CreatedAt, "-")),
 		fmt.Sprintf("Updated: %s", fallbackValue(details.UpdatedAt, "-")),
 		fmt.Sprintf("URL: %s", fallbackValue(details.URL, "-")),
-		"",
-		"Description:",
 	}
+	if details.State == "opened" {
+		status := fallbackValue(details.MergeStatus, "-")
+		if details.Draft {
+			status += " (draft)"
+		}
+		if details.AutoMerge {
+			status += " • merges when the pipeline succeeds"
+		}
+		lines = append(lines, fmt.Sprintf("Merge status: %s", status))
+	}
+	if data, ok := m.mergeRequestDetailData[details.IID]; ok {
+		lines = append(lines, fmt.Sprintf("Threads: %s", mergeRequestThreadSummary(data.Threads)))
+		lines = append(lines, mergeRequestApprovalLines(data.Approvals)...)
+	}
+	lines = append(lines, "", "Description:")
 
 	description := strings.TrimSpace(details.Description)
 	if description == "" {
@@ -869,9 +1675,13 @@ func (m DashboardModel) renderMergeRequestDetailFullscreen(width int, height int
 	viewportWidth := max(8, contentWidth-2)
 	lines := []string{
 		m.styles.header.Render("Merge Request Detail"),
-		m.styles.dim.Render("Esc return | j/k scroll"),
+		m.styles.dim.Render(fitLine("Esc return | j/k scroll | tab or d/c/t/a tabs | ]/[ file | s split | p pipeline | A/U/M/B/x approve/unapprove/merge/rebase/close", contentWidth)),
+		m.renderMergeRequestDetailTabs(),
 		"",
 	}
+	if m.errorMessage != "" {
+		lines = append(lines, m.styles.statusFailed.Render(fitLine("Error: "+m.errorMessage+" (esc to dismiss)", contentWidth)), "")
+	}
 	detailLines := m.mergeRequestDetailLines(viewportWidth)
 	if len(detailLines) == 0 {
 		lines = append(lines, m.styles.dim.Render("No merge request details available"))
@@ -957,7 +1767,8 @@ func (m DashboardModel) issueDetailLines(width int) []string {
 		return nil
 	}
 
-	cacheKey := fmt.Sprintf("%d:%d:%d", details.IID, m.detailTab, width)
+	key := issueDetailKey(item)
+	cacheKey := fmt.Sprintf("%d:%d:%d:%d", key, m.detailTab, width, m.detailLink)
 	if cached, found := m.detailCache[cacheKey]; found {
 		return cached
 	}
@@ -977,6 +1788,11 @@ func (m DashboardModel) issueDetailLines(width int) []string {
 	author := fallbackValue(details.Author, "-")
 	assignees := joinOrFallback(details.Assignees, "Unassigned")
 	labels := joinOrFallback(details.Labels, "None")
+	milestone := fallbackValue(details.Milestone, "None")
+	confidential := "no"
+	if details.Confidential {
+		confidential = "yes"
+	}
 	createdAt := fallbackValue(details.CreatedAt, "-")
 	updatedAt := fallbackValue(details.UpdatedAt, "-")
 	url := fallbackValue(details.URL, "-")
@@ -985,6 +1801,9 @@ func (m DashboardModel) issueDetailLines(width int) []string {
 	if details.IID > 0 {
 		iid = fmt.Sprintf("#%d", details.IID)
 	}
+	if item.Epic != nil {
+		iid = fmt.Sprintf("&%d", item.Epic.IID)
+	}
 
 	metadata := []string{
 		fmt.Sprintf("Title: %s", fallbackValue(item.Title, "-")),
@@ -993,6 +1812,8 @@ func (m DashboardModel) issueDetailLines(width int) []string {
 		fmt.Sprintf("Author: %s", author),
 		fmt.Sprintf("Assignees: %s", assignees),
 		fmt.Sprintf("Labels: %s", labels),
+		fmt.Sprintf("Milestone: %s", milestone),
+		fmt.Sprintf("Confidential: %s", confidential),
 		fmt.Sprintf("Created: %s", createdAt),
 		fmt.Sprintf("Updated: %s", updatedAt),
 		fmt.Sprintf("URL: %s", url),
@@ -1000,27 +1821,28 @@ func (m DashboardModel) issueDetailLines(width int) []string {
 
 	switch m.detailTab {
 	case issueDetailTabActivities:
-		computed := m.issueActivityLines(width, details.IID)
+		computed := m.issueActivityLines(width, key)
 		m.detailCache[cacheKey] = computed
 		return computed
 	case issueDetailTabComments:
-		computed := m.issueCommentLines(width, details.IID)
+		computed := m.issueCommentLines(width, key)
 		m.detailCache[cacheKey] = computed
 		return computed
 	}
 
-	lines := append([]string{"Info:"}, metadata...)
-	lines = append(lines, "", "Description:")
+	computed := wrapLines(append([]string{"Info:"}, metadata...), width)
+	if item.Epic == nil {
+		computed = append(computed, m.issueLinkLines(key, width)...)
+	}
+	computed = append(computed, "", "Description:")
 
 	description := strings.TrimSpace(details.Description)
 	if description == "" {
-		lines = append(lines, "No description provided.")
-		return wrapLines(lines, width)
+		return append(computed, wrapLines([]string{"No description provided."}, width)...)
 	}
 
-	wrappedMeta := wrapLines(lines, width)
-	wrappedDescription := m.markdownOrWrapped(details.IID, "description", 0, description, width)
-	computed := append(wrappedMeta, wrappedDescription...)
+	wrappedDescription := m.markdownOrWrapped(key, "description", 0, description, width)
+	computed = append(computed, wrappedDescription...)
 	m.detailCache[cacheKey] = computed
 	return computed
 }
@@ -1078,14 +1900,27 @@ func (m DashboardModel) issueActivityLines(width int, issueIID int64) []string {
 
 func (m DashboardModel) renderIssueDetailTabs(_ int) string {
 	tabs := []issueDetailTab{issueDetailTabOverview, issueDetailTabActivities, issueDetailTabComments}
-	parts := make([]string, 0, len(tabs))
-	for _, tab := range tabs {
-		label := issueDetailTabLabel(tab)
+	labels := make([]string, 0, len(tabs))
+	active := 0
+	for i, tab := range tabs {
+		labels = append(labels, issueDetailTabLabel(tab))
+		if tab == m.detailTab {
+			active = i
+		}
+	}
+	return m.renderDetailTabLabels(labels, active)
+}
+
+// renderDetailTabLabels draws a tab strip with the first letter of each label
+// underlined as its mnemonic key.
+func (m DashboardModel) renderDetailTabLabels(labels []string, active int) string {
+	parts := make([]string, 0, len(labels))
+	for i, label := range labels {
 		runes := []rune(label)
 		if len(runes) > 0 {
 			mnemonic := string(runes[0])
 			rest := string(runes[1:])
-			if tab == m.detailTab {
+			if i == active {
 				letter := m.styles.selectedRow.Underline(true).Render(mnemonic)
 				parts = append(parts, m.styles.selectedRow.Render(letter+rest))
 				continue
@@ -1094,7 +1929,7 @@ func (m DashboardModel) renderIssueDetailTabs(_ int) string {
 			parts = append(parts, m.styles.dim.Render(letter+rest))
 			continue
 		}
-		if tab == m.detailTab {
+		if i == active {
 			parts = append(parts, m.styles.selectedRow.Render(label))
 			continue
 		}
@@ -1170,7 +2005,7 @@ func (m DashboardModel) preloadMarkdownCmd() tea.Cmd {
 	if !ok || item.Issue == nil {
 		return nil
 	}
-	issueIID := item.Issue.IID
+	issueIID := issueDetailKey(item)
 	width, _ := m.issueDetailViewport()
 	cmds := make([]tea.Cmd, 0, 8)
 
@@ -1250,7 +2085,7 @@ func parseMarkdownCacheKey(cacheKey string) (int64, string, bool) {
 		return 0, "", false
 	}
 	value, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil || value <= 0 {
+	if err != nil || value == 0 {
 		return 0, "", false
 	}
 	return value, parts[1], true
@@ -1269,23 +2104,30 @@ func issueDetailTabForMarkdownSection(section string) (issueDetailTab, bool) {
 
 func (m DashboardModel) loadIssueDetailDataCmd() tea.Cmd {
 	item, ok := m.selectedIssueItem()
-	if !ok || item.Issue == nil || item.Issue.IID <= 0 {
+	key := issueDetailKey(item)
+	if !ok || key == 0 {
 		return nil
 	}
-	if _, exists := m.detailData[item.Issue.IID]; exists {
+	if _, exists := m.detailData[key]; exists {
 		return nil
 	}
 	if m.detailLoad {
 		return nil
 	}
 	requestID := m.requestID
-	issueIID := item.Issue.IID
+	epic := item.Epic
 	provider := m.provider
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 		defer cancel()
-		data, err := provider.LoadIssueDetailData(ctx, issueIID)
-		return issueDetailLoadedMsg{issueIID: issueIID, data: data, err: err, requestID: requestID}
+		var data IssueDetailData
+		var err error
+		if epic != nil {
+			data, err = provider.LoadEpicDetailData(ctx, *epic)
+		} else {
+			data, err = provider.LoadIssueDetailData(ctx, key)
+		}
+		return issueDetailLoadedMsg{key: key, data: data, err: err, requestID: requestID}
 	}
 }
 
@@ -1462,7 +2304,23 @@ func (m DashboardModel) loadCurrentViewCmd(requestID int, replace bool, page int
 	provider := m.provider
 	issueState := m.issueState
 	mergeRequestState := m.mergeRequestState
-	issueSearch := m.issueSearch
+	issueFilter, issueSearch, _ := parseIssueFilter(m.issueSearch)
+	issueFilter = issueFilter.withCurrentUser(m.ctx.User.Username)
+	mergeRequestFilter, mergeRequestSearch, _ := parseMergeRequestFilter(m.mergeRequestSearch)
+	mergeRequestFilter = mergeRequestFilter.withCurrentUser(m.ctx.User.Username)
+	issueSort := m.issueSort
+	mergeRequestSort := m.mergeRequestSort
+	todoAction := m.todoAction
+	myWorkScope := m.myWorkScope
+	groupPath := m.groupPath
+	groupScope := m.groupScope
+	groupSort := m.groupListSort()
+	boardID := m.boardID
+	epicState := m.epicState
+	milestoneQuery := MilestoneQuery{State: m.milestoneState, Scope: m.milestoneScope}
+	if m.milestone != nil {
+		milestoneQuery.Milestone = m.milestone.Milestone
+	}
 	return func() tea.Msg {
 		ctx := context.Background()
 		var (
@@ -1478,6 +2336,8 @@ func (m DashboardModel) loadCurrentViewCmd(requestID int, replace bool, page int
 			result, issueErr := provider.LoadIssues(ctx, IssueQuery{
 				State:   issueState,
 				Search:  issueSearch,
+				Filter:  issueFilter,
+				Sort:    issueSort,
 				Page:    page,
 				PerPage: 25,
 			})
@@ -1485,10 +2345,52 @@ func (m DashboardModel) loadCurrentViewCmd(requestID int, replace bool, page int
 			items = result.Items
 			hasNextPage = result.HasNextPage
 		case MergeRequestsView:
-			result, mergeRequestErr := provider.LoadMergeRequests(ctx, MergeRequestQuery{State: mergeRequestState, Page: page, PerPage: 25})
+			result, mergeRequestErr := provider.LoadMergeRequests(ctx, MergeRequestQuery{
+				State:   mergeRequestState,
+				Search:  mergeRequestSearch,
+				Filter:  mergeRequestFilter,
+				Sort:    mergeRequestSort,
+				Page:    page,
+				PerPage: 25,
+			})
 			err = mergeRequestErr
 			items = result.Items
 			hasNextPage = result.HasNextPage
+		case PipelinesView:
+			result, pipelineErr := provider.LoadPipelines(ctx, PipelineQuery{Page: page, PerPage: 25})
+			err = pipelineErr
+			items = result.Items
+			hasNextPage = result.HasNextPage
+		case TodosView:
+			result, todoErr := provider.LoadTodos(ctx, TodoQuery{Action: todoAction, Page: page, PerPage: 25})
+			err = todoErr
+			items = result.Items
+			hasNextPage = result.HasNextPage
+		case MyWorkView:
+			result, myWorkErr := provider.LoadMyWork(ctx, MyWorkQuery{Scope: myWorkScope, Page: page, PerPage: 25})
+			err = myWorkErr
+			items = result.Items
+			hasNextPage = result.HasNextPage
+		case GroupsView:
+			result, groupErr := provider.LoadGroup(ctx, GroupQuery{Path: groupPath, Scope: groupScope, Sort: groupSort, Page: page, PerPage: 25})
+			err = groupErr
+			items = result.Items
+			hasNextPage = result.HasNextPage
+		case BoardsView:
+			result, boardErr := provider.LoadBoard(ctx, BoardQuery{BoardID: boardID, PerList: boardIssuesPerList})
+			return boardLoadedMsg{requestID: requestID, result: result, err: boardErr}
+		case MilestonesView:
+			milestoneQuery.Page = page
+			milestoneQuery.PerPage = 25
+			result, milestoneErr := provider.LoadMilestones(ctx, milestoneQuery)
+			err = milestoneErr
+			items = result.Items
+			hasNextPage = result.HasNextPage
+		case EpicsView:
+			result, epicErr := provider.LoadEpics(ctx, EpicQuery{State: epicState, Page: page, PerPage: 25})
+			err = epicErr
+			items = result.Items
+			hasNextPage = result.HasNextPage
 		}
 
 		return loadedMsg{view: view, items: items, err: err, requestID: requestID, replace: replace, hasNextPage: hasNextPage}
@@ -1501,11 +2403,45 @@ func (m DashboardModel) viewTitle() string {
 		return "Issues"
 	case MergeRequestsView:
 		return "Merge Requests"
+	case PipelinesView:
+		return "Pipelines"
+	case TodosView:
+		return "Todos"
+	case MyWorkView:
+		return "My Work"
+	case GroupsView:
+		return "Groups"
+	case MilestonesView:
+		return "Milestones"
+	case BoardsView:
+		return "Boards"
+	case EpicsView:
+		return "Epics"
 	default:
 		return "Issues"
 	}
 }
 
+var dashboardViewOrder = []ViewMode{IssuesView, MergeRequestsView, PipelinesView, TodosView, MyWorkView, GroupsView, MilestonesView, BoardsView, EpicsView}
+
+func nextDashboardView(current ViewMode) ViewMode {
+	for i, view := range dashboardViewOrder {
+		if view == current && i < len(dashboardViewOrder)-1 {
+			return dashboardViewOrder[i+1]
+		}
+	}
+	return current
+}
+
+func prevDashboardView(current ViewMode) ViewMode {
+	for i, view := range dashboardViewOrder {
+		if view == current && i > 0 {
+			return dashboardViewOrder[i-1]
+		}
+	}
+	return current
+}
+
 func (m DashboardModel) shouldLoadMoreIssues() bool {
 	if m.view != IssuesView || m.loading || m.loadingMore || !m.issueHasNext {
 		return false
@@ -1540,16 +2476,6 @@ func (m DashboardModel) renderIssueTabs(width int) string {
 	return fitLine(strings.Join(parts, "  "), width)
 }
 
-func (m DashboardModel) renderIssueSearch(width int) string {
-	if m.searchMode {
-		return fitLine(m.searchInput.View(), width)
-	}
-	if strings.TrimSpace(m.issueSearch) == "" {
-		return fitLine("Search: (press /)", width)
-	}
-	return fitLine(fmt.Sprintf("Search: %s (press / to edit)", m.issueSearch), width)
-}
-
 func issueStateLabel(state IssueState) string {
 	switch state {
 	case IssueStateClosed:
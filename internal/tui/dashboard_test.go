@@ -2,8 +2,14 @@ package tui
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -11,21 +17,60 @@ import (
 type issueCall struct {
 	State  IssueState
 	Search string
+	Filter IssueFilter
+	Sort   ListSort
 	Page   int
 }
 
 type mergeRequestCall struct {
-	State MergeRequestState
-	Page  int
+	State  MergeRequestState
+	Search string
+	Filter MergeRequestFilter
+	Sort   ListSort
+	Page   int
 }
 
 type stubProvider struct {
 	issueCalls        []issueCall
 	mergeRequestCalls []mergeRequestCall
+	pipelineCalls     []int
+	traceOffsets      []int64
+	notes             []string
+	noteErr           error
+	createdIssues     []NewIssue
+	createErr         error
+	issueUpdates      []IssueUpdate
+	updateErr         error
+	merges            []MergeOptions
+	mergeErr          error
+	approvals         int
+	checkoutErr       error
+	traceErr          error
+	checkouts         []string
+	formOptions       MergeRequestFormOptions
+	pushes            []string
+	createdMRs        []NewMergeRequest
+	issueBranches     []IssueBranchRequest
+	todoActions       []TodoAction
+	todosDone         []int64
+	projects          []string
+	myWorkScopes      []MyWorkScope
+	groupQueries      []GroupQuery
+	milestoneQueries  []MilestoneQuery
+	boardQueries      []BoardQuery
+	epicQueries       []EpicQuery
+	epicErr           error
+	issueLinks        []IssueLink
+	issueLinksErr     string
+	loadItemErr       error
+	issuesErr         error
 }
 
 func (s *stubProvider) LoadIssues(_ context.Context, query IssueQuery) (IssueResult, error) {
-	s.issueCalls = append(s.issueCalls, issueCall{State: query.State, Search: query.Search, Page: query.Page})
+	s.issueCalls = append(s.issueCalls, issueCall{State: query.State, Search: query.Search, Filter: query.Filter, Sort: query.Sort, Page: query.Page})
+	if s.issuesErr != nil {
+		return IssueResult{}, s.issuesErr
+	}
 	if query.Page == 2 {
 		return IssueResult{Items: []ListItem{{ID: 12, Title: "Issue two", Issue: &IssueDetails{IID: 102, State: "opened", Description: "second issue"}}}, HasNextPage: false}, nil
 	}
@@ -33,7 +78,7 @@ func (s *stubProvider) LoadIssues(_ context.Context, query IssueQuery) (IssueRes
 }
 
 func (s *stubProvider) LoadMergeRequests(_ context.Context, query MergeRequestQuery) (MergeRequestResult, error) {
-	s.mergeRequestCalls = append(s.mergeRequestCalls, mergeRequestCall{State: query.State, Page: query.Page})
+	s.mergeRequestCalls = append(s.mergeRequestCalls, mergeRequestCall{State: query.State, Search: query.Search, Filter: query.Filter, Sort: query.Sort, Page: query.Page})
 	if query.State == "" {
 		query.State = MergeRequestStateOpened
 	}
@@ -74,6 +119,289 @@ func (s *stubProvider) LoadIssueDetailData(context.Context, int64) (IssueDetailD
 	return IssueDetailData{
 		Activities: []IssueActivity{{Actor: "alice", CreatedAt: "2026-01-02 10:00 UTC", Action: "closed"}},
 		Comments:   []IssueComment{{Author: "bob", CreatedAt: "2026-01-02 10:05 UTC", Body: "**hello**"}},
+		Links:      s.issueLinks,
+		LinksErr:   s.issueLinksErr,
+	}, nil
+}
+
+func (s *stubProvider) LoadPipelines(_ context.Context, query PipelineQuery) (PipelineResult, error) {
+	s.pipelineCalls = append(s.pipelineCalls, query.Page)
+	return PipelineResult{Items: []ListItem{{
+		ID:       31,
+		Title:    "#31 main",
+		Subtitle: "failed • abc12345",
+		Pipeline: &PipelineDetails{ID: 31, Status: "failed", Ref: "main", SHA: "abc12345", Duration: "1m 05s", TriggeredBy: "alice"},
+	}}, HasNextPage: false}, nil
+}
+
+func (s *stubProvider) LoadPipelineDetailData(_ context.Context, pipelineID int64) (PipelineDetailData, error) {
+	return PipelineDetailData{
+		Pipeline: PipelineDetails{ID: pipelineID, Status: "failed", Ref: "main"},
+		Stages: []PipelineStage{
+			{Name: "build", Status: "success", Jobs: []PipelineJob{{ID: 1, Name: "compile", Stage: "build", Status: "success"}}},
+			{Name: "test", Status: "failed", Jobs: []PipelineJob{{ID: 2, Name: "unit", Stage: "test", Status: "failed"}}},
+		},
+	}, nil
+}
+
+func (s *stubProvider) LoadMergeRequestPipeline(ctx context.Context, _ int64) (PipelineDetailData, error) {
+	return s.LoadPipelineDetailData(ctx, 41)
+}
+
+func (s *stubProvider) LoadJobTrace(_ context.Context, jobID int64, offset int64) (JobTrace, error) {
+	s.traceOffsets = append(s.traceOffsets, offset)
+	if s.traceErr != nil {
+		return JobTrace{}, s.traceErr
+	}
+	if offset > 0 {
+		return JobTrace{Content: "\x1b[31;1mERROR: Job failed\x1b[0;m\n", Offset: offset + 32, Status: "failed", Complete: true}, nil
+	}
+	content := "\x1b[0Ksection_start:100:step_script\r\x1b[0K\x1b[36;1mExecuting step_script\x1b[0;m\n$ go test ./...\nFAIL\n\x1b[0Ksection_end:160:step_script\r\x1b[0K\n"
+	return JobTrace{Content: content, Offset: int64(len(content)), Status: "running"}, nil
+}
+
+func (s *stubProvider) CreateIssueNote(_ context.Context, issueIID int64, body string) error {
+	s.notes = append(s.notes, fmt.Sprintf("issue:%d:%s", issueIID, body))
+	return s.noteErr
+}
+
+func (s *stubProvider) CreateMergeRequestNote(_ context.Context, mergeRequestIID int64, body string) error {
+	s.notes = append(s.notes, fmt.Sprintf("mr:%d:%s", mergeRequestIID, body))
+	return s.noteErr
+}
+
+func (s *stubProvider) AddDiscussionNote(_ context.Context, mergeRequestIID int64, discussionID string, body string) error {
+	s.notes = append(s.notes, fmt.Sprintf("reply:%d:%s:%s", mergeRequestIID, discussionID, body))
+	return s.noteErr
+}
+
+func (s *stubProvider) LoadIssueFormOptions(context.Context) (IssueFormOptions, error) {
+	return IssueFormOptions{
+		Labels:     []ProjectLabel{{Name: "bug"}, {Name: "backend"}, {Name: "frontend"}},
+		Members:    []ProjectMember{{ID: 7, Username: "alice", Name: "Alice"}, {ID: 8, Username: "bob", Name: "Bob"}},
+		Milestones: []ProjectMilestone{{ID: 5, Title: "v1.0"}},
+	}, nil
+}
+
+func (s *stubProvider) CreateIssue(_ context.Context, issue NewIssue) (ListItem, error) {
+	s.createdIssues = append(s.createdIssues, issue)
+	if s.createErr != nil {
+		return ListItem{}, s.createErr
+	}
+	return ListItem{ID: 99, Title: issue.Title, Issue: &IssueDetails{IID: 199, State: "opened", Labels: issue.Labels}}, nil
+}
+
+func (s *stubProvider) UpdateIssue(_ context.Context, issueIID int64, update IssueUpdate) (ListItem, error) {
+	s.issueUpdates = append(s.issueUpdates, update)
+	if s.updateErr != nil {
+		return ListItem{}, s.updateErr
+	}
+	return ListItem{}, nil
+}
+
+func (s *stubProvider) ApproveMergeRequest(context.Context, int64) error {
+	s.approvals++
+	return nil
+}
+
+func (s *stubProvider) UnapproveMergeRequest(context.Context, int64) error {
+	s.approvals--
+	return nil
+}
+
+func (s *stubProvider) MergeMergeRequest(_ context.Context, mergeRequestIID int64, opts MergeOptions) (ListItem, error) {
+	s.merges = append(s.merges, opts)
+	if s.mergeErr != nil {
+		return ListItem{}, s.mergeErr
+	}
+	return ListItem{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: mergeRequestIID, State: "merged"}}, nil
+}
+
+func (s *stubProvider) RebaseMergeRequest(context.Context, int64) error {
+	return nil
+}
+
+func (s *stubProvider) SetMergeRequestState(_ context.Context, mergeRequestIID int64, stateEvent string) (ListItem, error) {
+	state := "closed"
+	if stateEvent == "reopen" {
+		state = "opened"
+	}
+	return ListItem{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: mergeRequestIID, State: state}}, nil
+}
+
+func (s *stubProvider) CheckoutMergeRequest(_ context.Context, mergeRequestIID int64, sourceBranch string) (string, error) {
+	s.checkouts = append(s.checkouts, sourceBranch)
+	return fmt.Sprintf("$ git fetch origin refs/merge-requests/%d/head\n", mergeRequestIID), s.checkoutErr
+}
+
+func (s *stubProvider) LoadMergeRequestFormOptions(context.Context) (MergeRequestFormOptions, error) {
+	return s.formOptions, nil
+}
+
+func (s *stubProvider) PushBranch(_ context.Context, branch string) (string, error) {
+	s.pushes = append(s.pushes, branch)
+	return "$ git push --set-upstream origin " + branch + "\n", nil
+}
+
+func (s *stubProvider) CreateMergeRequest(_ context.Context, mr NewMergeRequest) (ListItem, error) {
+	s.createdMRs = append(s.createdMRs, mr)
+	return ListItem{ID: 99, Title: mr.Title, MergeRequest: &MergeRequestDetails{IID: 299, State: "opened", SourceBranch: mr.SourceBranch, TargetBranch: mr.TargetBranch}}, nil
+}
+
+func (s *stubProvider) CreateIssueBranch(_ context.Context, req IssueBranchRequest) (IssueBranchResult, error) {
+	s.issueBranches = append(s.issueBranches, req)
+	result := IssueBranchResult{Branch: req.Branch, Output: "$ git checkout -b " + req.Branch + " --track origin/" + req.Branch + "\n"}
+	if req.DraftMergeRequest {
+		result.MergeRequest = &ListItem{ID: 98, Title: "Draft: Resolve \"" + req.IssueTitle + "\"", MergeRequest: &MergeRequestDetails{IID: 298, State: "opened", Draft: true}}
+	}
+	return result, nil
+}
+
+func (s *stubProvider) LoadTodos(_ context.Context, query TodoQuery) (TodoResult, error) {
+	s.todoActions = append(s.todoActions, query.Action)
+	return TodoResult{Items: []ListItem{
+		{ID: 31, Title: "Review MR one", Subtitle: "group/project!201 • review_requested", Todo: &TodoDetails{ID: 31, Action: TodoActionReviewRequested, TargetType: TodoTargetMergeRequest, TargetIID: 201, ProjectPath: "group/project", Author: "alice"}},
+		{ID: 32, Title: "Other issue", Subtitle: "group/other#7 • mentioned", Todo: &TodoDetails{ID: 32, Action: TodoActionMentioned, TargetType: TodoTargetIssue, TargetIID: 7, ProjectPath: "group/other", Author: "bob"}},
+	}}, nil
+}
+
+func (s *stubProvider) MarkTodoDone(_ context.Context, todoID int64) error {
+	s.todosDone = append(s.todosDone, todoID)
+	return nil
+}
+
+func (s *stubProvider) MarkAllTodosDone(context.Context) error {
+	s.todosDone = append(s.todosDone, 0)
+	return nil
+}
+
+func (s *stubProvider) LoadIssue(_ context.Context, issueIID int64) (ListItem, error) {
+	if s.loadItemErr != nil {
+		return ListItem{}, s.loadItemErr
+	}
+	return ListItem{ID: 70, Title: "Other issue", Issue: &IssueDetails{IID: issueIID, State: "opened"}}, nil
+}
+
+func (s *stubProvider) LoadMergeRequest(_ context.Context, mergeRequestIID int64) (ListItem, error) {
+	return ListItem{ID: 71, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: mergeRequestIID, State: "opened"}}, nil
+}
+
+func (s *stubProvider) LoadGroup(_ context.Context, query GroupQuery) (GroupResult, error) {
+	s.groupQueries = append(s.groupQueries, query)
+	switch {
+	case query.Scope == GroupIssues:
+		return GroupResult{Items: []ListItem{
+			{ID: 51, Title: "Broken deploy", Subtitle: "org/team/api#5 • opened", ProjectPath: "org/team/api", Issue: &IssueDetails{IID: 5, State: "opened"}},
+		}}, nil
+	case query.Path == "":
+		return GroupResult{Items: []ListItem{
+			{ID: 1, Title: "org", Group: &GroupEntry{Kind: GroupEntrySubgroup, FullPath: "org"}},
+		}}, nil
+	case query.Path == "org":
+		return GroupResult{Items: []ListItem{
+			{ID: 2, Title: "team", Group: &GroupEntry{Kind: GroupEntrySubgroup, FullPath: "org/team"}},
+			{ID: 3, Title: "site", Group: &GroupEntry{Kind: GroupEntryProject, FullPath: "org/site"}},
+		}}, nil
+	}
+	return GroupResult{Items: []ListItem{
+		{ID: 4, Title: "api", Group: &GroupEntry{Kind: GroupEntryProject, FullPath: "org/team/api"}},
+	}}, nil
+}
+
+func (s *stubProvider) LoadMilestones(_ context.Context, query MilestoneQuery) (MilestoneResult, error) {
+	s.milestoneQueries = append(s.milestoneQueries, query)
+	switch {
+	case query.Milestone == nil:
+		return MilestoneResult{Items: []ListItem{
+			{ID: 7, Title: "v1.0", Milestone: &MilestoneDetails{ID: 7, State: "active", DueDate: "2026-10-21", DaysLeft: 5, OpenIssues: 2, ClosedIssues: 2, TotalWeight: 8, ClosedWeight: 3}},
+			{ID: 8, Title: "Platform", Milestone: &MilestoneDetails{ID: 8, GroupID: 3, State: "active", OpenIssues: 1}},
+		}}, nil
+	case query.Scope == MilestoneMergeRequests:
+		return MilestoneResult{Items: []ListItem{
+			{ID: 82, Title: "Add login", Subtitle: "org/web!4 • opened", ProjectPath: "org/web", MergeRequest: &MergeRequestDetails{IID: 4, State: "opened"}},
+		}}, nil
+	}
+	return MilestoneResult{Items: []ListItem{
+		{ID: 81, Title: "Login page", Subtitle: "org/web#3 • opened", ProjectPath: "org/web", Issue: &IssueDetails{IID: 3, State: "opened"}},
+	}}, nil
+}
+
+func (s *stubProvider) LoadEpics(_ context.Context, query EpicQuery) (EpicResult, error) {
+	s.epicQueries = append(s.epicQueries, query)
+	if s.epicErr != nil {
+		return EpicResult{}, s.epicErr
+	}
+	return EpicResult{Items: []ListItem{
+		{ID: 501, Title: "Checkout revamp", Subtitle: "org&1 • opened", Issue: &IssueDetails{State: "opened", Description: "Rework **checkout**"}, Epic: &EpicDetails{ID: 501, IID: 1, GroupID: 2, Reference: "org&1"}},
+		{ID: 504, Title: "Onboarding", Subtitle: "org&4 • opened", Issue: &IssueDetails{State: "opened"}, Epic: &EpicDetails{ID: 504, IID: 4, GroupID: 2, Reference: "org&4"}},
+	}}, nil
+}
+
+func (s *stubProvider) LoadEpicChildren(_ context.Context, epic EpicDetails) ([]ListItem, error) {
+	if epic.IID != 1 {
+		return nil, nil
+	}
+	return []ListItem{
+		{ID: 502, Title: "Payment providers", Subtitle: "org/team&2 • opened", Issue: &IssueDetails{State: "opened"}, Epic: &EpicDetails{ID: 502, IID: 2, GroupID: 3, Reference: "org/team&2"}},
+		{ID: 95, Title: "Card form", Subtitle: "org/web#9 • opened", ProjectPath: "org/web", Issue: &IssueDetails{IID: 9, State: "opened"}},
+	}, nil
+}
+
+func (s *stubProvider) LoadEpicDetailData(context.Context, EpicDetails) (IssueDetailData, error) {
+	return IssueDetailData{
+		Comments: []IssueComment{{Author: "carol", CreatedAt: "2026-01-03 09:00 UTC", Body: "split into child epics"}},
+	}, nil
+}
+
+func (s *stubProvider) LoadBoard(_ context.Context, query BoardQuery) (BoardResult, error) {
+	s.boardQueries = append(s.boardQueries, query)
+	return BoardResult{
+		Boards: []IssueBoard{{ID: 1, Name: "Development"}, {ID: 2, Name: "Release"}},
+		Board:  IssueBoard{ID: 1, Name: "Development"},
+		Columns: []BoardColumn{
+			{Kind: BoardListOpen, Title: "Open", Items: []ListItem{
+				{ID: 91, Title: "Triage crash", Issue: &IssueDetails{IID: 21, State: "opened"}},
+			}},
+			{Kind: BoardListLabel, Title: "Doing", Label: "Doing", HasMore: true, Items: []ListItem{
+				{ID: 92, Title: "Login page", Issue: &IssueDetails{IID: 22, State: "opened", Labels: []string{"Doing", "frontend"}, Assignees: []string{"Alice"}}},
+			}},
+			{Kind: BoardListLabel, Title: "Review", Label: "Review"},
+			{Kind: BoardListClosed, Title: "Closed"},
+		},
+	}, nil
+}
+
+func (s *stubProvider) LoadMyWork(_ context.Context, query MyWorkQuery) (MyWorkResult, error) {
+	s.myWorkScopes = append(s.myWorkScopes, query.Scope)
+	if query.Scope == MyWorkAssignedIssues {
+		return MyWorkResult{Items: []ListItem{
+			{ID: 41, Title: "Fix flaky test", Subtitle: "group/api#12 • opened", ProjectPath: "group/api", Issue: &IssueDetails{IID: 12, State: "opened"}},
+		}}, nil
+	}
+	return MyWorkResult{Items: []ListItem{
+		{ID: 42, Title: "Bump deps", Subtitle: "group/web!8 • alice", ProjectPath: "group/web", MergeRequest: &MergeRequestDetails{IID: 8, State: "opened"}},
+	}}, nil
+}
+
+func (s *stubProvider) ForProject(projectPath string) DataProvider {
+	s.projects = append(s.projects, projectPath)
+	return s
+}
+
+func (s *stubProvider) LoadMergeRequestDetailData(context.Context, int64) (MergeRequestDetailData, error) {
+	return MergeRequestDetailData{
+		Threads: []MergeRequestThread{
+			{ID: "a1", Resolvable: true, Resolved: true, ResolvedBy: "alice", Notes: []IssueComment{{Author: "bob", Body: "fixed typo"}}},
+			{ID: "b2", Resolvable: true, Path: "main.go", NewLine: 3, Notes: []IssueComment{{Author: "bob", Body: "why 2?"}, {Author: "alice", Body: "see issue"}}},
+		},
+		Activities: []IssueActivity{{Actor: "alice", CreatedAt: "2026-01-02 10:00 UTC", Action: "added 1 commit"}},
+	}, nil
+}
+
+func (s *stubProvider) LoadMergeRequestDiffs(context.Context, int64) ([]MergeRequestDiffFile, error) {
+	return []MergeRequestDiffFile{
+		{OldPath: "main.go", NewPath: "main.go", Diff: "@@ -1,2 +1,2 @@\n package main\n-var a = 1\n+var a = 2\n"},
+		{OldPath: "README.md", NewPath: "README.md", NewFile: true, Diff: "@@ -0,0 +1 @@\n+# Readme\n"},
 	}, nil
 }
 
@@ -334,173 +662,524 @@ func TestDashboardIssueSearchAppliesOnEnter(t *testing.T) {
 	}
 }
 
-func TestDashboardMergeRequestStateTabReloads(t *testing.T) {
+func TestDashboardIssueFilterQueryLoadsFilteredIssues(t *testing.T) {
 	t.Parallel()
 
 	provider := &stubProvider{}
-	m := NewDashboardModel(provider, DashboardContext{})
-	m.view = MergeRequestsView
+	m := NewDashboardModel(provider, DashboardContext{User: CurrentUser{Username: "alice"}})
+	m.view = IssuesView
 	m.loading = false
-	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", Description: "first mr"}}}
 
-	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
 	model := updated.(DashboardModel)
-	if model.mergeRequestState != MergeRequestStateMerged {
-		t.Fatalf("state = %v want %v", model.mergeRequestState, MergeRequestStateMerged)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("colour:red")})
+	model = updated.(DashboardModel)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if cmd != nil || !model.searchMode || model.filterErr == "" {
+		t.Fatalf("invalid filter: searchMode=%v err=%q want input kept open with an error", model.searchMode, model.filterErr)
+	}
+
+	model.searchInput.SetValue(`label:bug -label:wontfix assignee:@me milestone:"16.4 RC" crash`)
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if model.searchMode || model.filterErr != "" {
+		t.Fatalf("searchMode=%v err=%q want filter applied", model.searchMode, model.filterErr)
 	}
 	if cmd == nil {
-		t.Fatal("expected reload command")
+		t.Fatal("expected filtered reload command")
 	}
 
 	_ = cmd()
-	if len(provider.mergeRequestCalls) == 0 {
-		t.Fatal("expected merge request load call")
+	if len(provider.issueCalls) == 0 {
+		t.Fatal("expected issue load call")
 	}
-	if provider.mergeRequestCalls[0].State != MergeRequestStateMerged {
-		t.Fatalf("call state = %v want %v", provider.mergeRequestCalls[0].State, MergeRequestStateMerged)
+	call := provider.issueCalls[0]
+	want := IssueFilter{Labels: []string{"bug"}, NotLabels: []string{"wontfix"}, Assignee: "alice", Milestone: "16.4 RC"}
+	if !reflect.DeepEqual(call.Filter, want) {
+		t.Fatalf("call filter = %+v want %+v", call.Filter, want)
+	}
+	if call.Search != "crash" {
+		t.Fatalf("call search = %q want %q", call.Search, "crash")
+	}
+	if view := model.View(); !strings.Contains(view, "label:bug") || !strings.Contains(view, "-label:wontfix") {
+		t.Fatalf("expected filter chips in view, got:\n%s", view)
 	}
 }
 
-func TestDashboardMergeRequestAllTabReloads(t *testing.T) {
+func TestDashboardMergeRequestFilterQueryLoadsFilteredMergeRequests(t *testing.T) {
 	t.Parallel()
 
 	provider := &stubProvider{}
-	m := NewDashboardModel(provider, DashboardContext{})
+	m := NewDashboardModel(provider, DashboardContext{User: CurrentUser{Username: "alice"}})
 	m.view = MergeRequestsView
 	m.loading = false
-	m.mergeRequestState = MergeRequestStateClosed
-	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "closed", Description: "first mr"}}}
 
-	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
 	model := updated.(DashboardModel)
-	if model.mergeRequestState != MergeRequestStateAll {
-		t.Fatalf("state = %v want %v", model.mergeRequestState, MergeRequestStateAll)
+	if !model.searchMode {
+		t.Fatal("expected search mode")
 	}
-	if cmd == nil {
-		t.Fatal("expected reload command")
+	model.searchInput.SetValue("draft:maybe")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if cmd != nil || !model.searchMode || !strings.Contains(model.filterErr, `draft "maybe"`) {
+		t.Fatalf("invalid filter: searchMode=%v err=%q want input kept open with an error", model.searchMode, model.filterErr)
 	}
 
+	model.searchInput.SetValue("reviewer:@me draft:no target:main login")
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if cmd == nil {
+		t.Fatal("expected filtered reload command")
+	}
 	_ = cmd()
 	if len(provider.mergeRequestCalls) == 0 {
 		t.Fatal("expected merge request load call")
 	}
-	if provider.mergeRequestCalls[0].State != MergeRequestStateAll {
-		t.Fatalf("call state = %v want %v", provider.mergeRequestCalls[0].State, MergeRequestStateAll)
+	call := provider.mergeRequestCalls[0]
+	want := MergeRequestFilter{Reviewer: "alice", Target: "main", Draft: "no"}
+	if !reflect.DeepEqual(call.Filter, want) || call.Search != "login" {
+		t.Fatalf("call filter = %+v, %q want %+v, %q", call.Filter, call.Search, want, "login")
 	}
-}
-
-func TestDashboardLoadsNextMergeRequestPageNearEnd(t *testing.T) {
-	t.Parallel()
-
-	provider := &stubProvider{}
-	m := NewDashboardModel(provider, DashboardContext{})
-	m.view = MergeRequestsView
-	m.loading = false
-	m.items = []ListItem{
-		{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", Description: "first mr"}},
-		{ID: 23, Title: "MR three", MergeRequest: &MergeRequestDetails{IID: 203, State: "opened", Description: "third mr"}},
+	if model.issueSearch != "" {
+		t.Fatalf("issue search = %q want the issue filter untouched", model.issueSearch)
 	}
-	m.selected = 0
-	m.mergeRequestPage = 1
-	m.mergeRequestHasNext = true
 
-	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
-	model := updated.(DashboardModel)
-	if model.selected != 1 {
-		t.Fatalf("selected = %d want %d", model.selected, 1)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	model = updated.(DashboardModel)
+	if !model.pickerActive || model.pickerPurpose != pickerMergeRequestFilter {
+		t.Fatal("expected merge request filter picker")
 	}
+	updated, cmd = model.applyMergeRequestFilterPicked([]string{"approved-by:@me"})
+	model = updated.(DashboardModel)
 	if cmd == nil {
-		t.Fatal("expected next-page load command")
+		t.Fatal("expected reload after picking a preset")
 	}
-
 	_ = cmd()
-	if len(provider.mergeRequestCalls) == 0 {
-		t.Fatal("expected merge request load call")
+	if got := provider.mergeRequestCalls[len(provider.mergeRequestCalls)-1].Filter.ApprovedBy; got != "alice" {
+		t.Fatalf("approved by = %q want %q", got, "alice")
 	}
-	if provider.mergeRequestCalls[0].Page != 2 {
-		t.Fatalf("call page = %d want %d", provider.mergeRequestCalls[0].Page, 2)
+	if view := model.View(); !strings.Contains(view, "approved-by:@me") {
+		t.Fatalf("expected filter chip in view, got:\n%s", view)
 	}
 }
 
-func TestDashboardInitialRequestIDAcceptsFirstLoad(t *testing.T) {
-	t.Parallel()
+type stubSortStore struct {
+	sorts map[ViewMode]ListSort
+}
 
-	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
-	m.view = IssuesView
-	updated, _ := m.Update(loadedMsg{view: IssuesView, items: []ListItem{{ID: 99, Title: "Loaded", Issue: &IssueDetails{IID: 199, State: "opened", Description: "loaded issue"}}}, requestID: 1, replace: true})
-	model := updated.(DashboardModel)
-	if len(model.items) == 0 {
-		t.Fatal("expected first load message to be accepted")
-	}
+func (s *stubSortStore) ListSort(view ViewMode) (ListSort, bool) {
+	sort, ok := s.sorts[view]
+	return sort, ok
 }
 
-func TestDashboardIssueDetailOpensAndCloses(t *testing.T) {
+func (s *stubSortStore) SaveListSort(view ViewMode, sort ListSort) error {
+	s.sorts[view] = sort
+	return nil
+}
+
+func TestDashboardListSortCyclesAndPersistsPerView(t *testing.T) {
 	t.Parallel()
 
-	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	store := &stubSortStore{sorts: map[ViewMode]ListSort{MergeRequestsView: {Field: SortTitle, Ascending: true}}}
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{Sorts: store})
 	m.view = IssuesView
 	m.loading = false
-	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened", Description: "first issue"}}}
-
-	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view := m.View(); !strings.Contains(view, "sort: updated newest first") {
+		t.Fatalf("expected default sort in header, got:\n%s", view)
+	}
+	var runBatch func(cmd tea.Cmd)
+	runBatch = func(cmd tea.Cmd) {
+		batch, ok := cmd().(tea.BatchMsg)
+		if !ok {
+			return
+		}
+		for _, next := range batch {
+			if next != nil {
+				runBatch(next)
+			}
+		}
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
 	model := updated.(DashboardModel)
-	if !model.issueDetail {
-		t.Fatal("expected issue detail view to open")
+	if cmd == nil {
+		t.Fatal("expected reload and save commands")
 	}
+	runBatch(cmd)
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	model = updated.(DashboardModel)
+	runBatch(cmd)
 
-	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	want := ListSort{Field: SortCreated, Ascending: true}
+	if got := provider.issueCalls[len(provider.issueCalls)-1].Sort; got != want {
+		t.Fatalf("issue call sort = %+v want %+v", got, want)
+	}
+	if got := store.sorts[IssuesView]; got != want {
+		t.Fatalf("saved issue sort = %+v want %+v", got, want)
+	}
+	if view := model.View(); !strings.Contains(view, "sort: created oldest first") {
+		t.Fatalf("expected sort in header, got:\n%s", view)
+	}
+
+	model.view = MergeRequestsView
+	if view := model.View(); !strings.Contains(view, "sort: title A to Z") {
+		t.Fatalf("expected saved merge request sort in header, got:\n%s", view)
+	}
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
 	model = updated.(DashboardModel)
-	if model.issueDetail {
-		t.Fatal("expected issue detail view to close")
+	runBatch(cmd)
+	want = ListSort{Field: SortLabelPriority, Ascending: true}
+	if got := provider.mergeRequestCalls[len(provider.mergeRequestCalls)-1].Sort; got != want {
+		t.Fatalf("merge request call sort = %+v want %+v", got, want)
+	}
+	if got := store.sorts[IssuesView]; got.Field != SortCreated {
+		t.Fatalf("issue sort = %+v want it kept per view", got)
 	}
 }
 
-func TestDashboardIssueDetailEnterDoesNotClose(t *testing.T) {
+type stubViewStore struct {
+	views []SavedView
+}
+
+func (s *stubViewStore) SavedViews() []SavedView {
+	return slices.Clone(s.views)
+}
+
+func (s *stubViewStore) SaveView(view SavedView) error {
+	s.views = append(s.views, view)
+	return nil
+}
+
+func TestDashboardSavedViewsSaveListAndOpenByNumber(t *testing.T) {
 	t.Parallel()
 
-	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	store := &stubViewStore{views: []SavedView{{Name: "Reviews", View: MergeRequestsView, State: "opened", Query: "reviewer:@me"}}}
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{Views: store})
+	m.width = 160
 	m.view = IssuesView
 	m.loading = false
-	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened", Description: "first issue"}}}
+	m.issueState = IssueStateAll
+	m.issueSearch = "label:bug"
+	m.issueSort = ListSort{Field: SortDueDate, Ascending: true}
 
-	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
 	model := updated.(DashboardModel)
-	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.namePrompt != namePromptView {
+		t.Fatal("expected save view prompt")
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Triage")})
+	model = updated.(DashboardModel)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if cmd == nil {
+		t.Fatal("expected save command")
+	}
+	updated, _ = model.Update(cmd())
 	model = updated.(DashboardModel)
+	want := SavedView{Name: "Triage", View: IssuesView, State: "all", Query: "label:bug", Sort: ListSort{Field: SortDueDate, Ascending: true}}
+	if len(store.views) != 2 || store.views[1] != want {
+		t.Fatalf("saved views = %+v want %+v appended", store.views, want)
+	}
+	if model.notice != `saved view "Triage", press 9 to open it` {
+		t.Fatalf("notice = %q", model.notice)
+	}
+	view := model.View()
+	if !strings.Contains(view, "8. Reviews") || !strings.Contains(view, "9. Triage") {
+		t.Fatalf("expected saved views in sidebar, got:\n%s", view)
+	}
+	if strings.Index(view, "9. Triage") > strings.Index(view, "2. Merge Requests") {
+		t.Fatalf("expected the issue view under Issues, got:\n%s", view)
+	}
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "9. Triage") != strings.Contains(line, "›") {
+			t.Fatalf("expected only the saved view marked active, got:\n%s", view)
+		}
+	}
 
-	if !model.issueDetail {
-		t.Fatal("expected issue detail view to remain open on Enter")
+	model.issueState = IssueStateOpened
+	model.issueSearch = ""
+	model.issueSort = ListSort{}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("8")})
+	model = updated.(DashboardModel)
+	if model.view != MergeRequestsView || model.mergeRequestSearch != "reviewer:@me" {
+		t.Fatalf("view = %v search = %q want the Reviews view", model.view, model.mergeRequestSearch)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")})
+	model = updated.(DashboardModel)
+	if cmd == nil {
+		t.Fatal("expected load command")
+	}
+	if model.view != IssuesView || model.issueState != IssueStateAll || model.issueSearch != "label:bug" || model.issueSort != want.Sort {
+		t.Fatalf("issues = %v %q %+v want the Triage view", model.issueState, model.issueSearch, model.issueSort)
+	}
+	if model.notice != "view Triage" {
+		t.Fatalf("notice = %q", model.notice)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("0")})
+	if got := updated.(DashboardModel).notice; !strings.HasPrefix(got, "no saved view on 0") {
+		t.Fatalf("notice = %q want no saved view", got)
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if picker := updated.(DashboardModel); !picker.pickerActive || picker.pickerPurpose != pickerSavedView {
+		t.Fatal("expected saved view picker")
 	}
 }
 
-func TestDashboardIssueDetailScrollDoesNotMoveSelection(t *testing.T) {
+func TestDashboardSavedViewBeyondNumberKeysSaysHowToOpenIt(t *testing.T) {
 	t.Parallel()
 
-	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	store := &stubViewStore{views: []SavedView{
+		{Name: "Mine", View: IssuesView, State: "opened", Query: "assignee:@me"},
+		{Name: "Bugs", View: IssuesView, State: "opened", Query: "label:bug"},
+		{Name: "Reviews", View: MergeRequestsView, State: "opened", Query: "reviewer:@me"},
+	}}
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{Views: store})
+	m.width = 160
 	m.view = IssuesView
 	m.loading = false
-	m.width = 100
-	m.height = 20
-	m.items = []ListItem{{
-		ID:    11,
-		Title: "Issue one",
-		Issue: &IssueDetails{
-			IID:         101,
-			State:       "opened",
-			Description: "line one line two line three line four line five line six line seven line eight line nine line ten",
-		},
-	}}
+	m.issueState = IssueStateClosed
 
-	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Done")})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
 	model := updated.(DashboardModel)
-	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
-	model = updated.(DashboardModel)
-
-	if model.selected != 0 {
-		t.Fatalf("selected = %d want %d", model.selected, 0)
+	if model.notice != `saved view "Done"; only the first 3 views get a number key, press v to open it` {
+		t.Fatalf("notice = %q", model.notice)
 	}
-	if model.detailScroll == 0 {
-		t.Fatal("expected detail scroll to advance")
+	view := model.View()
+	if !strings.Contains(view, "8. Mine") || !strings.Contains(view, "   Done") || strings.Contains(view, ". Done") {
+		t.Fatalf("expected Done listed without a number key, got:\n%s", view)
+	}
+}
+
+type stubFilterStore struct {
+	filters []SavedFilter
+}
+
+func (s *stubFilterStore) IssueFilters() []SavedFilter {
+	return s.filters
+}
+
+func (s *stubFilterStore) SaveIssueFilter(filter SavedFilter) error {
+	s.filters = append(s.filters, filter)
+	return nil
+}
+
+func TestDashboardSavesIssueFilterAndOffersItInPanel(t *testing.T) {
+	t.Parallel()
+
+	store := &stubFilterStore{}
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{Filters: store})
+	m.view = IssuesView
+	m.loading = false
+	m.issueSearch = "label:bug assignee:none"
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	model := updated.(DashboardModel)
+	if model.namePrompt != namePromptIssueFilter {
+		t.Fatal("expected save filter popup")
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Triage")})
+	model = updated.(DashboardModel)
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if cmd == nil {
+		t.Fatal("expected save command")
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	want := []SavedFilter{{Name: "Triage", Query: "label:bug assignee:none"}}
+	if !reflect.DeepEqual(store.filters, want) {
+		t.Fatalf("saved filters = %+v want %+v", store.filters, want)
+	}
+	if model.notice != `saved filter "Triage"` {
+		t.Fatalf("notice = %q", model.notice)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	model = updated.(DashboardModel)
+	if !model.pickerActive || model.pickerPurpose != pickerIssueFilter {
+		t.Fatal("expected issue filter picker")
+	}
+	if view := model.View(); !strings.Contains(view, "Triage") || !strings.Contains(view, "Assigned to me") {
+		t.Fatalf("expected presets and saved filters in panel, got:\n%s", view)
+	}
+}
+
+func TestDashboardMergeRequestStateTabReloads(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", Description: "first mr"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	model := updated.(DashboardModel)
+	if model.mergeRequestState != MergeRequestStateMerged {
+		t.Fatalf("state = %v want %v", model.mergeRequestState, MergeRequestStateMerged)
+	}
+	if cmd == nil {
+		t.Fatal("expected reload command")
+	}
+
+	_ = cmd()
+	if len(provider.mergeRequestCalls) == 0 {
+		t.Fatal("expected merge request load call")
+	}
+	if provider.mergeRequestCalls[0].State != MergeRequestStateMerged {
+		t.Fatalf("call state = %v want %v", provider.mergeRequestCalls[0].State, MergeRequestStateMerged)
+	}
+}
+
+func TestDashboardMergeRequestAllTabReloads(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.mergeRequestState = MergeRequestStateClosed
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "closed", Description: "first mr"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	model := updated.(DashboardModel)
+	if model.mergeRequestState != MergeRequestStateAll {
+		t.Fatalf("state = %v want %v", model.mergeRequestState, MergeRequestStateAll)
+	}
+	if cmd == nil {
+		t.Fatal("expected reload command")
+	}
+
+	_ = cmd()
+	if len(provider.mergeRequestCalls) == 0 {
+		t.Fatal("expected merge request load call")
+	}
+	if provider.mergeRequestCalls[0].State != MergeRequestStateAll {
+		t.Fatalf("call state = %v want %v", provider.mergeRequestCalls[0].State, MergeRequestStateAll)
+	}
+}
+
+func TestDashboardLoadsNextMergeRequestPageNearEnd(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{
+		{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", Description: "first mr"}},
+		{ID: 23, Title: "MR three", MergeRequest: &MergeRequestDetails{IID: 203, State: "opened", Description: "third mr"}},
+	}
+	m.selected = 0
+	m.mergeRequestPage = 1
+	m.mergeRequestHasNext = true
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	model := updated.(DashboardModel)
+	if model.selected != 1 {
+		t.Fatalf("selected = %d want %d", model.selected, 1)
+	}
+	if cmd == nil {
+		t.Fatal("expected next-page load command")
+	}
+
+	_ = cmd()
+	if len(provider.mergeRequestCalls) == 0 {
+		t.Fatal("expected merge request load call")
+	}
+	if provider.mergeRequestCalls[0].Page != 2 {
+		t.Fatalf("call page = %d want %d", provider.mergeRequestCalls[0].Page, 2)
+	}
+}
+
+func TestDashboardInitialRequestIDAcceptsFirstLoad(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = IssuesView
+	updated, _ := m.Update(loadedMsg{view: IssuesView, items: []ListItem{{ID: 99, Title: "Loaded", Issue: &IssueDetails{IID: 199, State: "opened", Description: "loaded issue"}}}, requestID: 1, replace: true})
+	model := updated.(DashboardModel)
+	if len(model.items) == 0 {
+		t.Fatal("expected first load message to be accepted")
+	}
+}
+
+func TestDashboardIssueDetailOpensAndCloses(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = IssuesView
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened", Description: "first issue"}}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model := updated.(DashboardModel)
+	if !model.issueDetail {
+		t.Fatal("expected issue detail view to open")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(DashboardModel)
+	if model.issueDetail {
+		t.Fatal("expected issue detail view to close")
+	}
+}
+
+func TestDashboardIssueDetailEnterDoesNotClose(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = IssuesView
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened", Description: "first issue"}}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model := updated.(DashboardModel)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+
+	if !model.issueDetail {
+		t.Fatal("expected issue detail view to remain open on Enter")
+	}
+}
+
+func TestDashboardIssueDetailScrollDoesNotMoveSelection(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = IssuesView
+	m.loading = false
+	m.width = 100
+	m.height = 20
+	m.items = []ListItem{{
+		ID:    11,
+		Title: "Issue one",
+		Issue: &IssueDetails{
+			IID:         101,
+			State:       "opened",
+			Description: "line one line two line three line four line five line six line seven line eight line nine line ten",
+		},
+	}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model := updated.(DashboardModel)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	model = updated.(DashboardModel)
+
+	if model.selected != 0 {
+		t.Fatalf("selected = %d want %d", model.selected, 0)
+	}
+	if model.detailScroll == 0 {
+		t.Fatal("expected detail scroll to advance")
 	}
 }
 
@@ -707,3 +1386,1363 @@ func TestFitLinePreservesANSISequences(t *testing.T) {
 		t.Fatalf("expected ANSI sequences to be preserved, got %q", got)
 	}
 }
+
+func TestDashboardNumericKeyFourRoutesToPipelines(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = IssuesView
+	m.loading = false
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("4")})
+	model := updated.(DashboardModel)
+	if model.view != PipelinesView {
+		t.Fatalf("view = %v want %v", model.view, PipelinesView)
+	}
+	if cmd == nil {
+		t.Fatal("expected pipeline load command")
+	}
+
+	_ = cmd()
+	if len(provider.pipelineCalls) == 0 {
+		t.Fatal("expected pipeline load call")
+	}
+}
+
+func TestDashboardRightFromMergeRequestsMovesToPipelines(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model := updated.(DashboardModel)
+	if model.view != PipelinesView {
+		t.Fatalf("view = %v want %v", model.view, PipelinesView)
+	}
+}
+
+func TestDashboardPipelineDetailRendersStageGrid(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = PipelinesView
+	m.loading = false
+	m.width = 120
+	m.height = 30
+	m.items = []ListItem{{ID: 31, Title: "#31 main", Pipeline: &PipelineDetails{ID: 31, Status: "failed", Ref: "main"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model := updated.(DashboardModel)
+	if !model.pipelineDetail {
+		t.Fatal("expected pipeline detail view to open")
+	}
+	if cmd == nil {
+		t.Fatal("expected pipeline detail load command")
+	}
+
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	view := stripANSI(model.View())
+	for _, want := range []string{"Pipeline Detail", "build", "test", "compile", "unit"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("expected pipeline detail to contain %q, got %q", want, view)
+		}
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(DashboardModel)
+	if model.pipelineDetail {
+		t.Fatal("expected pipeline detail view to close")
+	}
+}
+
+func TestDashboardPipelineJobOpensLogAndResumesFromOffset(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = PipelinesView
+	m.loading = false
+	m.width = 120
+	m.height = 30
+	m.items = []ListItem{{ID: 31, Title: "#31 main", Pipeline: &PipelineDetails{ID: 31, Status: "failed", Ref: "main"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if job, ok := model.selectedPipelineJob(); !ok || job.Name != "unit" {
+		t.Fatalf("selected job = %+v want the failed unit job", job)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if !model.jobLog || cmd == nil {
+		t.Fatal("expected job log to open with a trace load command")
+	}
+
+	updated, pollCmd := model.Update(cmd())
+	model = updated.(DashboardModel)
+	view := stripANSI(model.View())
+	if !strings.Contains(view, "Executing step_script") || !strings.Contains(view, "go test") {
+		t.Fatalf("expected trace content in job log, got %q", view)
+	}
+	if pollCmd == nil {
+		t.Fatal("expected running job to schedule a poll")
+	}
+
+	updated, cmd = model.Update(jobTracePollMsg{jobID: model.jobLogJob.ID, requestID: model.jobLogRequest})
+	updated, pollCmd = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(provider.traceOffsets) != 2 || provider.traceOffsets[1] == 0 {
+		t.Fatalf("trace offsets = %v want second request to resume", provider.traceOffsets)
+	}
+	if !strings.Contains(stripANSI(model.View()), "ERROR: Job failed") {
+		t.Fatal("expected appended trace chunk to render")
+	}
+	if pollCmd != nil {
+		t.Fatal("expected polling to stop once the job completed")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(DashboardModel)
+	if model.jobLog || !model.pipelineDetail {
+		t.Fatal("expected Esc to return from job log to pipeline detail")
+	}
+}
+
+func TestDashboardJobLogKeepsPollingAfterFailedPoll(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{traceErr: errors.New("502 bad gateway")}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.width = 120
+	m.height = 30
+	updated, cmd := m.openJobLog(PipelineJob{ID: 7, Name: "unit", Status: "running"})
+
+	for failures, wait := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		var pollCmd tea.Cmd
+		updated, pollCmd = updated.Update(cmd())
+		model := updated.(DashboardModel)
+		if pollCmd == nil {
+			t.Fatalf("expected a retry to be scheduled after failure %d", failures+1)
+		}
+		if got := model.jobLogRetryWait(); got != wait {
+			t.Fatalf("retry wait after failure %d = %s want %s", failures+1, got, wait)
+		}
+		if !strings.Contains(stripANSI(model.View()), "502 bad gateway") {
+			t.Fatal("expected the poll error to be shown")
+		}
+		updated, cmd = model.Update(jobTracePollMsg{jobID: 7, requestID: model.jobLogRequest})
+	}
+
+	provider.traceErr = nil
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if model.jobLogErr != "" || model.jobLogFailures != 0 {
+		t.Fatalf("error = %q failures = %d want both cleared after a successful poll", model.jobLogErr, model.jobLogFailures)
+	}
+	if !strings.Contains(stripANSI(model.View()), "go test") {
+		t.Fatal("expected the trace to render once polling recovers")
+	}
+}
+
+func TestDashboardMergeRequestDetailOpensPipeline(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened"}}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	model := updated.(DashboardModel)
+	if !model.pipelineDetail || cmd == nil {
+		t.Fatal("expected merge request pipeline to start loading")
+	}
+
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if model.pipelineDetailID != 41 {
+		t.Fatalf("pipelineDetailID = %d want 41", model.pipelineDetailID)
+	}
+	if !strings.Contains(stripANSI(model.View()), "compile") {
+		t.Fatal("expected merge request pipeline jobs to render")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(DashboardModel)
+	if model.pipelineDetail || !model.mergeRequestDetail {
+		t.Fatal("expected Esc to return to merge request detail")
+	}
+}
+
+func TestDashboardMergeRequestChangesTabShowsDiffs(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.width = 100
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened"}}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	model := updated.(DashboardModel)
+	if model.mergeRequestDetailTab != mergeRequestDetailTabChanges || cmd == nil {
+		t.Fatal("expected c to open the Changes tab and load diffs")
+	}
+
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if stats := model.mergeRequestDiffStats[201]; len(stats) != 2 {
+		t.Fatalf("diff stats = %+v want them counted for both files on load", stats)
+	}
+	view := stripANSI(model.View())
+	if !strings.Contains(view, "Files changed: 2 (+2 -1)") {
+		t.Fatalf("expected file summary in view, got %q", view)
+	}
+	if !strings.Contains(view, "var a = 2") {
+		t.Fatal("expected first file diff to render")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	model = updated.(DashboardModel)
+	if model.mergeRequestDiffFile != 1 {
+		t.Fatalf("mergeRequestDiffFile = %d want 1", model.mergeRequestDiffFile)
+	}
+	if !strings.Contains(stripANSI(model.View()), "# Readme") {
+		t.Fatal("expected second file diff to render")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	model = updated.(DashboardModel)
+	if model.mergeRequestDetailTab != mergeRequestDetailTabOverview {
+		t.Fatal("expected d to return to the Detail tab")
+	}
+}
+
+func TestDashboardMergeRequestThreadsTabShowsUnresolvedFirst(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model := updated.(DashboardModel)
+	if cmd == nil || !model.mergeRequestDetailLoad {
+		t.Fatal("expected merge request discussions to load when details open")
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if !strings.Contains(stripANSI(model.View()), "Threads: 1 of 2 threads unresolved") {
+		t.Fatal("expected unresolved thread summary in the Detail tab")
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	model = updated.(DashboardModel)
+	if model.mergeRequestDetailTab != mergeRequestDetailTabThreads || cmd != nil {
+		t.Fatal("expected t to open the Threads tab from cached data")
+	}
+	view := stripANSI(model.View())
+	unresolved := strings.Index(view, "● Unresolved • main.go:3")
+	resolved := strings.Index(view, "✓ Resolved by alice")
+	if unresolved < 0 || resolved < 0 || unresolved > resolved {
+		t.Fatalf("expected unresolved thread before resolved thread, got %q", view)
+	}
+	if !strings.Contains(view, "↳ alice") {
+		t.Fatal("expected replies to render under their thread")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	model = updated.(DashboardModel)
+	if !strings.Contains(stripANSI(model.View()), "added 1 commit") {
+		t.Fatal("expected system notes in the Activity tab")
+	}
+}
+
+func typeText(m tea.Model, text string) tea.Model {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestDashboardIssueComposerPostsAndReloadsComments(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened"}}}
+	m.detailData[101] = IssueDetailData{}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	model := updated.(DashboardModel)
+	if !model.composer || model.focus != focusComposer {
+		t.Fatal("expected C to open the composer")
+	}
+
+	updated = typeText(model, "**lgtm**")
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model = updated.(DashboardModel)
+	if !model.composerPreview || !strings.Contains(stripANSI(model.View()), "lgtm") || strings.Contains(stripANSI(model.View()), "**lgtm**") {
+		t.Fatal("expected tab to show the rendered markdown preview")
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model = updated.(DashboardModel)
+	if !model.composerSending || cmd == nil {
+		t.Fatal("expected ctrl+s to post the comment")
+	}
+	updated, cmd = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(provider.notes) != 1 || provider.notes[0] != "issue:101:**lgtm**" {
+		t.Fatalf("notes = %v want [issue:101:**lgtm**]", provider.notes)
+	}
+	if model.composer || model.detailTab != issueDetailTabComments || cmd == nil {
+		t.Fatal("expected composer to close and the Comments tab to reload")
+	}
+	if _, cached := model.detailData[101]; cached {
+		t.Fatal("expected issue detail data cache to be invalidated")
+	}
+}
+
+func TestDashboardThreadReplyKeepsDraftOnFailure(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{noteErr: errors.New("403 Forbidden")}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	model := updated.(DashboardModel)
+	if !model.composer || model.composerTarget.discussionID != "a1" {
+		t.Fatalf("expected reply composer for thread a1, got %+v", model.composerTarget)
+	}
+
+	updated = typeText(model, "thanks")
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(provider.notes) != 1 || provider.notes[0] != "reply:201:a1:thanks" {
+		t.Fatalf("notes = %v want [reply:201:a1:thanks]", provider.notes)
+	}
+	if !model.composer || model.composerInput.Value() != "thanks" || !strings.Contains(stripANSI(model.View()), "403 Forbidden") {
+		t.Fatal("expected the composer to stay open with the draft and error after a failed post")
+	}
+	t.Cleanup(func() { _ = os.Remove(model.composerDraftPath) })
+	draft, err := readEditorDraft(model.composerDraftPath)
+	if err != nil || draft != "thanks" {
+		t.Fatalf("saved draft = %q, %v want %q", draft, err, "thanks")
+	}
+}
+
+func TestDashboardNewIssueFormCreatesAndInsertsIssue(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened"}}}
+	m.selected = 0
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	model := updated.(DashboardModel)
+	if !model.issueForm || cmd == nil {
+		t.Fatalf("expected n to open the issue form and load its options")
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if !model.issueFormOptionsReady {
+		t.Fatalf("expected form options to be loaded")
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model = updated.(DashboardModel)
+	if model.issueFormErr != "title is required" || model.issueFormSending {
+		t.Fatalf("issueFormErr = %q want title is required", model.issueFormErr)
+	}
+
+	updated = typeText(model, "Broken login")
+	for range 2 {
+		updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = typeText(updated, "bend")
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if model.pickerActive || len(model.issueFormLabels) != 1 || model.issueFormLabels[0] != "backend" {
+		t.Fatalf("issueFormLabels = %v want [backend]", model.issueFormLabels)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated = typeText(updated, "bob")
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	for range 3 {
+		updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	model = updated.(DashboardModel)
+	if !model.issueFormSending || cmd == nil {
+		t.Fatalf("expected ctrl+s to submit the form, err=%q", model.issueFormErr)
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+
+	if len(provider.createdIssues) != 1 {
+		t.Fatalf("created issues = %d want 1", len(provider.createdIssues))
+	}
+	created := provider.createdIssues[0]
+	if created.Title != "Broken login" || !created.Confidential || len(created.AssigneeIDs) != 1 || created.AssigneeIDs[0] != 8 {
+		t.Fatalf("created issue = %+v", created)
+	}
+	if model.issueForm {
+		t.Fatalf("expected the form to close after creating the issue")
+	}
+	if len(model.items) != 2 || model.items[0].ID != 99 || model.selected != 0 {
+		t.Fatalf("items = %+v want the new issue first and selected", model.items)
+	}
+	if len(provider.issueCalls) != 0 {
+		t.Fatalf("issue calls = %d want no reload", len(provider.issueCalls))
+	}
+}
+
+func TestDashboardCreatedIssueReloadsFilteredList(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = IssuesView
+	m.loading = false
+	m.issueSearch = "label:bug"
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened"}}}
+	m.issueFormSending = true
+
+	updated, cmd := m.Update(issueCreatedMsg{requestID: m.requestID, item: ListItem{ID: 99, Title: "Broken login", Issue: &IssueDetails{IID: 42, State: "opened"}}})
+	model := updated.(DashboardModel)
+	if cmd == nil || !model.loading || model.notice != "created issue #42" {
+		t.Fatalf("loading = %v notice = %q want the filtered list reloaded", model.loading, model.notice)
+	}
+	if slices.ContainsFunc(model.items, func(item ListItem) bool { return item.ID == 99 }) {
+		t.Fatalf("items = %+v want the new issue left to the reload", model.items)
+	}
+	cmd()
+	if len(provider.issueCalls) != 1 || provider.issueCalls[0].Filter.Labels[0] != "bug" {
+		t.Fatalf("issue calls = %+v want the filtered list reloaded", provider.issueCalls)
+	}
+}
+
+func TestDashboardIssueQuickEditIgnoresResultAfterProjectSwitch(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{updateErr: errors.New("403 Forbidden")}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Subtitle: "#101 • opened", Issue: &IssueDetails{IID: 101, State: "opened"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	model := updated.(DashboardModel).useProject("org/api", provider)
+	other := ListItem{ID: 51, Title: "Other issue", Subtitle: "#101 • closed", Issue: &IssueDetails{IID: 101, State: "closed"}}
+	model.items = []ListItem{other}
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if got := model.items[0]; got.ID != other.ID || got.Issue.State != "closed" {
+		t.Fatalf("item = %+v want the issue of org/api left alone", got)
+	}
+	if !strings.Contains(model.errorMessage, "403 Forbidden") {
+		t.Fatalf("errorMessage = %q want the API error", model.errorMessage)
+	}
+}
+
+func TestDashboardIssueQuickEditRollsBackOnError(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{updateErr: errors.New("403 Forbidden")}
+	m := NewDashboardModel(provider, DashboardContext{User: CurrentUser{ID: 7, Username: "alice", Name: "Alice"}})
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Subtitle: "#101 • opened", Issue: &IssueDetails{IID: 101, State: "opened", Labels: []string{"bug"}}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	model := updated.(DashboardModel)
+	if got := model.items[0].Issue.State; got != "closed" {
+		t.Fatalf("optimistic state = %q want closed", got)
+	}
+	if m.items[0].Issue.State != "opened" {
+		t.Fatalf("optimistic update mutated the previous model's item")
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if got := model.items[0].Issue.State; got != "opened" {
+		t.Fatalf("state after rollback = %q want opened", got)
+	}
+	if !strings.Contains(model.errorMessage, "403 Forbidden") {
+		t.Fatalf("errorMessage = %q want the API error", model.errorMessage)
+	}
+
+	provider.updateErr = nil
+	model.errorMessage = ""
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	model = updated.(DashboardModel)
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	details := model.items[0].Issue
+	if len(details.AssigneeIDs) != 1 || details.AssigneeIDs[0] != 7 || len(details.Assignees) != 1 || details.Assignees[0] != "Alice" {
+		t.Fatalf("assignees = %v %v want Alice", details.AssigneeIDs, details.Assignees)
+	}
+	last := provider.issueUpdates[len(provider.issueUpdates)-1]
+	if last.AssigneeIDs == nil || len(*last.AssigneeIDs) != 1 {
+		t.Fatalf("update = %+v want one assignee", last)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+	model = updated.(DashboardModel)
+	updated, _ = model.Update(cmd())
+	model = updated.(DashboardModel)
+	if !model.pickerActive {
+		t.Fatalf("expected L to open the label picker once options load")
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	updated = typeText(updated, "front")
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyTab})
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(DashboardModel)
+	if got := model.items[0].Issue.Labels; len(got) != 1 || got[0] != "frontend" {
+		t.Fatalf("labels = %v want [frontend]", got)
+	}
+	if cmd == nil {
+		t.Fatalf("expected the label change to be sent")
+	}
+	cmd()
+	last = provider.issueUpdates[len(provider.issueUpdates)-1]
+	if len(last.AddLabels) != 1 || last.AddLabels[0] != "frontend" || len(last.RemoveLabels) != 1 || last.RemoveLabels[0] != "bug" {
+		t.Fatalf("update = %+v want +frontend -bug", last)
+	}
+}
+
+func TestDashboardMergeRequestMergeAsksForConfirmation(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", RemoveSourceBranch: true}}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("M")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	model := updated.(DashboardModel)
+	if !model.mergeRequestConfirmOpen {
+		t.Fatal("expected M to open the merge confirmation")
+	}
+	if view := model.View(); !strings.Contains(view, "Merge !201?") || !strings.Contains(view, "[x] Squash commits") {
+		t.Fatalf("confirmation view missing prompt or options:\n%s", view)
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(DashboardModel)
+	if model.mergeRequestConfirmOpen || cmd != nil || len(provider.merges) != 0 {
+		t.Fatalf("esc should cancel without merging, merges = %v", provider.merges)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("M")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	want := MergeOptions{RemoveSourceBranch: true, AutoMerge: true}
+	if len(provider.merges) != 1 || provider.merges[0] != want {
+		t.Fatalf("merges = %+v want [%+v]", provider.merges, want)
+	}
+	if got := model.items[0].MergeRequest.State; got != "merged" {
+		t.Fatalf("state = %q want merged", got)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	model = updated.(DashboardModel)
+	if model.mergeRequestConfirmOpen || model.notice != "!201 is merged" {
+		t.Fatalf("approve on a merged MR: confirm = %v notice = %q", model.mergeRequestConfirmOpen, model.notice)
+	}
+}
+
+func TestMergeRequestApprovalLinesListRules(t *testing.T) {
+	t.Parallel()
+
+	lines := mergeRequestApprovalLines(MergeRequestApprovals{
+		Required:   2,
+		Left:       1,
+		ApprovedBy: []string{"alice"},
+		Rules: []MergeRequestApprovalRule{
+			{Name: "Backend", Required: 1, Approved: true, ApprovedBy: []string{"alice"}},
+			{Name: "Security", Required: 1},
+		},
+	})
+	want := []string{
+		"Approvals: 1 of 2 required • approved by alice",
+		"✓ Backend: 1 of 1 • alice",
+		"○ Security: 0 of 1",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("lines = %q want %q", lines, want)
+	}
+}
+
+func TestDashboardMergeRequestCheckoutShowsGitOutput(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{checkoutErr: errors.New("working tree has uncommitted changes; commit or stash them first")}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", SourceBranch: "feature/test"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if cmd == nil {
+		t.Fatal("expected space to start the checkout")
+	}
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if !model.gitOutputOpen {
+		t.Fatal("expected the git output popup to open")
+	}
+	view := model.View()
+	for _, want := range []string{"Could not check out !201", "git fetch origin refs/merge-requests/201/head", "uncommitted changes"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("git output view missing %q:\n%s", want, view)
+		}
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if updated.(DashboardModel).gitOutputOpen {
+		t.Fatal("expected esc to close the git output popup")
+	}
+}
+
+func TestDashboardForkMergeRequestChecksOutPrefixedBranch(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened", SourceBranch: "main", FromFork: true}}}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if cmd == nil {
+		t.Fatal("expected space to start the checkout")
+	}
+	cmd()
+	if len(provider.checkouts) != 1 || provider.checkouts[0] != "mr-201-main" {
+		t.Fatalf("checkouts = %v want mr-201-main", provider.checkouts)
+	}
+}
+
+func TestDashboardNewMergeRequestPushesThenCreates(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{formOptions: MergeRequestFormOptions{
+		Branch:         LocalBranch{Name: "fix/login", Commits: []string{"Fix login redirect\n\nKeeps the return URL."}},
+		DefaultBranch:  "main",
+		TargetBranches: []string{"main", "develop"},
+		Template:       "## Checklist",
+	}}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.view = MergeRequestsView
+	m.loading = false
+	m.items = []ListItem{{ID: 21, Title: "MR one", MergeRequest: &MergeRequestDetails{IID: 201, State: "opened"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if !updated.(DashboardModel).mergeRequestForm || cmd == nil {
+		t.Fatal("expected n to open the merge request form and load the branch")
+	}
+	for _, load := range cmd().(tea.BatchMsg) {
+		if load != nil {
+			updated, _ = updated.Update(load())
+		}
+	}
+	model := updated.(DashboardModel)
+	if got := model.mergeRequestFormTitle.Value(); got != "Fix login redirect" {
+		t.Fatalf("title = %q want the commit subject", got)
+	}
+	if got := model.mergeRequestFormDescription.Value(); got != "Keeps the return URL.\n\n## Checklist" {
+		t.Fatalf("description = %q want the commit body and template", got)
+	}
+	if model.mergeRequestFormTarget != "main" || !model.mergeRequestFormPush {
+		t.Fatalf("target = %q push = %v want main and push offered", model.mergeRequestFormTarget, model.mergeRequestFormPush)
+	}
+
+	for range 5 {
+		updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if cmd == nil {
+		t.Fatalf("expected ctrl+s to push the branch, err=%q", updated.(DashboardModel).mergeRequestFormErr)
+	}
+	updated, cmd = updated.Update(cmd())
+	if len(provider.pushes) != 1 || provider.pushes[0] != "fix/login" || cmd == nil {
+		t.Fatalf("pushes = %v want fix/login followed by the create request", provider.pushes)
+	}
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+
+	if len(provider.createdMRs) != 1 {
+		t.Fatalf("created merge requests = %d want 1", len(provider.createdMRs))
+	}
+	if created := provider.createdMRs[0]; !created.Draft || created.SourceBranch != "fix/login" || created.TargetBranch != "main" {
+		t.Fatalf("created merge request = %+v", created)
+	}
+	if model.mergeRequestForm || model.notice != "created merge request !299" {
+		t.Fatalf("form open = %v notice = %q", model.mergeRequestForm, model.notice)
+	}
+	if len(model.items) != 2 || model.items[0].ID != 99 {
+		t.Fatalf("items = %+v want the new merge request first", model.items)
+	}
+}
+
+func TestMergeRequestFormPrefillListsCommits(t *testing.T) {
+	t.Parallel()
+
+	title, description := mergeRequestFormPrefill(LocalBranch{
+		Name:    "feature/add-login_form",
+		Commits: []string{"Add login form\n\nWith a body.", "Validate passwords"},
+	}, "")
+	if title != "Add login form" {
+		t.Fatalf("title = %q want the humanized branch name", title)
+	}
+	if description != "- Add login form\n- Validate passwords" {
+		t.Fatalf("description = %q want one bullet per commit", description)
+	}
+}
+
+func TestIssueBranchNameFollowsPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"", "42-fix-login-redirect-on-safari-17"},
+		{"{username}/{iid}-{title}", "alice/42-fix-login-redirect-on-safari-17"},
+		{"issue-{iid}", "issue-42"},
+	}
+	for _, tt := range tests {
+		if got := issueBranchName(tt.pattern, 42, "Fix: login redirect on Safari 17!", "alice"); got != tt.want {
+			t.Fatalf("issueBranchName(%q) = %q want %q", tt.pattern, got, tt.want)
+		}
+	}
+	if got := issueBranchName("", 7, strings.Repeat("word ", 30), ""); len(got) > len("7-")+issueBranchSlugLimit || strings.HasSuffix(got, "-") {
+		t.Fatalf("long title branch = %q want the slug cut at %d characters", got, issueBranchSlugLimit)
+	}
+}
+
+func TestDashboardIssueBranchCreatesDraftMergeRequest(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{IssueBranchPattern: "{iid}-{title}"})
+	m.loading = false
+	m.issueDetail = true
+	m.items = []ListItem{{ID: 11, Title: "Broken login", Issue: &IssueDetails{IID: 101, State: "opened"}}}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	model := updated.(DashboardModel)
+	if !model.issueBranchConfirmOpen || model.issueBranchInput.Value() != "101-broken-login" {
+		t.Fatalf("branch popup open = %v name = %q", model.issueBranchConfirmOpen, model.issueBranchInput.Value())
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to create the branch")
+	}
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+
+	if len(provider.issueBranches) != 1 {
+		t.Fatalf("branch requests = %d want 1", len(provider.issueBranches))
+	}
+	if req := provider.issueBranches[0]; req.Branch != "101-broken-login" || !req.DraftMergeRequest || req.IssueIID != 101 {
+		t.Fatalf("branch request = %+v", req)
+	}
+	if !model.gitOutputOpen || model.gitOutputTitle != "Created branch 101-broken-login and draft merge request !298" {
+		t.Fatalf("git output open = %v title = %q", model.gitOutputOpen, model.gitOutputTitle)
+	}
+}
+
+func TestDashboardTodosMarkDoneAndOpenTarget(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.loading = false
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+	updated, _ = updated.Update(cmd())
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if model.view != TodosView || len(model.items) != 2 {
+		t.Fatalf("view = %v items = %d want todos with 2 items", model.view, len(model.items))
+	}
+	if len(provider.todoActions) != 2 || provider.todoActions[1] != TodoActionAssigned {
+		t.Fatalf("todo filters = %v want all then assigned", provider.todoActions)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(provider.todosDone) != 1 || provider.todosDone[0] != 31 || len(model.items) != 1 {
+		t.Fatalf("todos done = %v items = %d", provider.todosDone, len(model.items))
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to load the todo target")
+	}
+	updated, cmd = updated.Update(cmd())
+	updated, cmd = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(provider.projects) != 1 || model.ctx.ProjectPath != "group/other" {
+		t.Fatalf("projects = %v context project = %q want group/other", provider.projects, model.ctx.ProjectPath)
+	}
+	item, ok := model.selectedIssueItem()
+	if !model.issueDetail || !ok || item.Issue.IID != 7 {
+		t.Fatalf("issue detail = %v selected = %+v want #7 open", model.issueDetail, item)
+	}
+	if cmd == nil {
+		t.Fatal("expected the issue detail to start loading")
+	}
+}
+
+func TestDashboardTodoTargetFailureKeepsProject(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{loadItemErr: errors.New("404 Project Not Found")}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.loading = false
+	m.view = TodosView
+	m.items = []ListItem{{ID: 31, Title: "Fix login", Todo: &TodoDetails{ID: 31, ProjectPath: "group/other", TargetType: TodoTargetIssue, TargetIID: 7}}}
+	m.detailData[101] = IssueDetailData{Comments: []IssueComment{{Author: "alice", Body: "cached"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to load the todo target")
+	}
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if model.ctx.ProjectPath != "group/project" || model.view != TodosView {
+		t.Fatalf("project = %q view = %v want to stay on group/project todos", model.ctx.ProjectPath, model.view)
+	}
+	if _, ok := model.detailData[101]; !ok {
+		t.Fatal("expected the current project's state to be kept")
+	}
+	if !strings.Contains(model.errorMessage, "404 Project Not Found") {
+		t.Fatalf("errorMessage = %q want the load error", model.errorMessage)
+	}
+}
+
+func TestDashboardMyWorkOpensItemInItsProject(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.loading = false
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	updated, _ = updated.Update(cmd())
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	want := []MyWorkScope{MyWorkAssignedIssues, MyWorkAuthoredMergeRequests}
+	if !slices.Equal(provider.myWorkScopes, want) {
+		t.Fatalf("my work lists = %v want %v", provider.myWorkScopes, want)
+	}
+	if model.view != MyWorkView || len(model.items) != 1 || model.items[0].ProjectPath != "group/web" {
+		t.Fatalf("view = %v items = %+v", model.view, model.items)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to load the merge request list of its project")
+	}
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if model.ctx.ProjectPath != "group/web" || len(provider.projects) != 1 {
+		t.Fatalf("context project = %q providers = %v want group/web", model.ctx.ProjectPath, provider.projects)
+	}
+	item, ok := model.selectedMergeRequestItem()
+	if !model.mergeRequestDetail || !ok || item.MergeRequest.IID != 8 {
+		t.Fatalf("merge request detail = %v selected = %+v want !8 open", model.mergeRequestDetail, item)
+	}
+}
+
+func TestDashboardGroupsBrowseTreeAndOpenProject(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.loading = false
+
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("7")},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyBackspace},
+	}
+	var updated tea.Model = m
+	for _, key := range keys {
+		var cmd tea.Cmd
+		updated, cmd = updated.Update(key)
+		updated, _ = updated.Update(cmd())
+	}
+	model := updated.(DashboardModel)
+	paths := make([]string, 0, len(provider.groupQueries))
+	for _, query := range provider.groupQueries {
+		paths = append(paths, query.Path)
+	}
+	if want := []string{"", "org", "org/team", "org"}; !slices.Equal(paths, want) {
+		t.Fatalf("group paths = %q want %q", paths, want)
+	}
+	if model.selected != 0 || model.items[model.selected].Group.FullPath != "org/team" {
+		t.Fatalf("selected = %d want the subgroup that was left", model.selected)
+	}
+
+	model.issueSort = ListSort{Field: SortDueDate, Ascending: true}
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if last := provider.groupQueries[len(provider.groupQueries)-1]; last.Scope != GroupIssues || last.Path != "org" || last.Sort != model.issueSort {
+		t.Fatalf("last group query = %+v want the issues of org sorted like the issue list", last)
+	}
+	if len(model.items) != 1 || model.items[0].ProjectPath != "org/team/api" {
+		t.Fatalf("group issues = %+v", model.items)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	model.selected = 1
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if model.view != IssuesView || model.ctx.ProjectPath != "org/site" || !slices.Equal(provider.projects, []string{"org/site"}) {
+		t.Fatalf("view = %v project = %q providers = %v want org/site issues", model.view, model.ctx.ProjectPath, provider.projects)
+	}
+}
+
+func TestDashboardGroupProjectFailureKeepsProject(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{issuesErr: errors.New("403 Forbidden")}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "group/project"})
+	m.loading = false
+	m.view = GroupsView
+	m.groupPath = "org"
+	m.items = []ListItem{{ID: 3, Title: "secret", Group: &GroupEntry{Kind: GroupEntryProject, FullPath: "org/secret"}}}
+	m.detailData[101] = IssueDetailData{Comments: []IssueComment{{Author: "alice", Body: "cached"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter to check the project")
+	}
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if model.ctx.ProjectPath != "group/project" || model.view != GroupsView {
+		t.Fatalf("project = %q view = %v want to stay in the groups view of group/project", model.ctx.ProjectPath, model.view)
+	}
+	if _, ok := model.detailData[101]; !ok {
+		t.Fatal("expected the current project's state to be kept")
+	}
+	if !strings.Contains(model.errorMessage, "open org/secret: 403 Forbidden") {
+		t.Fatalf("errorMessage = %q want the check error", model.errorMessage)
+	}
+}
+
+func TestDashboardMilestonesShowProgressAndOpenMilestoneItems(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.loading = false
+	m.width = 160
+	m.height = 40
+
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("7")},
+		{Type: tea.KeyRunes, Runes: []rune("l")},
+	}
+	var updated tea.Model = m
+	for _, key := range keys {
+		var cmd tea.Cmd
+		updated, cmd = updated.Update(key)
+		updated, _ = updated.Update(cmd())
+	}
+	model := updated.(DashboardModel)
+	if model.view != MilestonesView || len(model.items) != 2 {
+		t.Fatalf("view = %v items = %d want the two milestones", model.view, len(model.items))
+	}
+	view := model.View()
+	for _, want := range []string{
+		"[#####-----] 50% • 2/4 issues closed • weight 3/8 • 5 days left",
+		"[----------] 0% • 0/1 issues closed • no due date • group milestone",
+	} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("view does not show %q:\n%s", want, view)
+		}
+	}
+
+	model.selected = 1
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	last := provider.milestoneQueries[len(provider.milestoneQueries)-1]
+	if last.Milestone == nil || last.Milestone.GroupID != 3 || last.Scope != MilestoneMergeRequests {
+		t.Fatalf("last milestone query = %+v want the merge requests of the group milestone", last)
+	}
+	if len(model.items) != 1 || model.items[0].MergeRequest == nil {
+		t.Fatalf("milestone items = %+v want its merge request", model.items)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if model.milestone != nil || model.selected != 1 {
+		t.Fatalf("milestone = %v selected = %d want the milestone list with the one left selected", model.milestone, model.selected)
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	updated, _ = updated.Update(cmd())
+	if last := provider.milestoneQueries[len(provider.milestoneQueries)-1]; last.State != MilestoneStateClosed || last.Milestone != nil {
+		t.Fatalf("last milestone query = %+v want the closed milestones", last)
+	}
+}
+
+func TestDashboardBoardShowsColumnsAndMovesIssuesBetweenLists(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.loading = false
+	m.width = 160
+	m.height = 40
+
+	var updated tea.Model = m
+	for _, key := range []string{"7", "l", "l"} {
+		var cmd tea.Cmd
+		updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		updated, _ = updated.Update(cmd())
+	}
+	model := updated.(DashboardModel)
+	if model.view != BoardsView || len(model.boardColumns) != 4 {
+		t.Fatalf("view = %v columns = %d want the four lists of the board", model.view, len(model.boardColumns))
+	}
+	view := model.View()
+	for _, want := range []string{"[Development]", "Open (1)", "Doing (1+)", "Review (0)", "#22 Login page", "Alice • frontend"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("board does not show %q:\n%s", want, view)
+		}
+	}
+
+	moves := []struct {
+		key  string
+		want IssueUpdate
+	}{
+		{key: ">", want: IssueUpdate{RemoveLabels: []string{"Doing"}, AddLabels: []string{"Review"}}},
+		{key: ">", want: IssueUpdate{RemoveLabels: []string{"Review"}, StateEvent: "close"}},
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	for i, move := range moves {
+		var cmd tea.Cmd
+		updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(move.key)})
+		updated, _ = updated.Update(cmd())
+		if got := provider.issueUpdates[i]; !reflect.DeepEqual(got, move.want) {
+			t.Fatalf("move %d update = %+v want %+v", i, got, move.want)
+		}
+	}
+	model = updated.(DashboardModel)
+	if model.boardColumn != 3 || len(model.boardColumns[1].Items) != 0 || len(model.boardColumns[3].Items) != 1 {
+		t.Fatalf("column = %d columns = %+v want the issue followed into the closed list", model.boardColumn, model.boardColumns)
+	}
+	if card := model.boardColumns[3].Items[0].Issue; card.State != "closed" || slices.Contains(card.Labels, "Doing") {
+		t.Fatalf("moved card = %+v want it closed without the Doing label", card)
+	}
+
+	provider.updateErr = errors.New("forbidden")
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("<")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(model.boardColumns[2].Items) != 0 || len(model.boardColumns[3].Items) != 1 || !strings.Contains(model.errorMessage, "forbidden") {
+		t.Fatalf("columns = %+v error = %q want the failed move rolled back", model.boardColumns, model.errorMessage)
+	}
+}
+
+func TestDashboardBoardIgnoresMoveFinishedAfterReload(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{updateErr: errors.New("forbidden")}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.loading = false
+	m.width = 160
+	m.height = 40
+
+	var updated tea.Model = m
+	for _, key := range []string{"7", "l", "l"} {
+		var cmd tea.Cmd
+		updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		updated, _ = updated.Update(cmd())
+	}
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	updated, move := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(">")})
+	updated, reload := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	updated, _ = updated.Update(reload())
+	model := updated.(DashboardModel)
+	model.boardColumns[1].Items = nil
+	reloaded := cloneBoardColumns(model.boardColumns)
+	updated, _ = model.Update(move())
+	model = updated.(DashboardModel)
+	if !reflect.DeepEqual(model.boardColumns, reloaded) {
+		t.Fatalf("columns = %+v want the reloaded board kept", model.boardColumns)
+	}
+	if !strings.Contains(model.errorMessage, "forbidden") {
+		t.Fatalf("error = %q want the failed move reported", model.errorMessage)
+	}
+}
+
+func TestDashboardEpicsShowTreeAndReuseIssueDetail(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.width = 160
+	m.height = 40
+	m.view = EpicsView
+	updated, cmd := m.startLoadCurrentView()
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if len(model.items) != 2 || !strings.Contains(model.View(), "▸ Checkout revamp") {
+		t.Fatalf("items = %d want the two epics, view:\n%s", len(model.items), model.View())
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(model.items) != 4 || model.items[1].Depth != 1 || model.items[2].ProjectPath != "org/web" {
+		t.Fatalf("items = %+v want the child epic and issue below the epic", model.items)
+	}
+	view := model.View()
+	for _, want := range []string{"▾ Checkout revamp", "  ▸ Payment providers", "  • Card form", "org/web#9 • opened"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("view does not show %q:\n%s", want, view)
+		}
+	}
+
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	for _, load := range cmd().(tea.BatchMsg) {
+		if load != nil {
+			updated, _ = updated.Update(load())
+		}
+	}
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	model = updated.(DashboardModel)
+	view = model.View()
+	if !model.issueDetail || !strings.Contains(view, "Epic Detail") || !strings.Contains(view, "split into child epics") {
+		t.Fatalf("expected the epic comments in the issue detail view:\n%s", view)
+	}
+	if _, ok := model.detailData[-501]; !ok {
+		t.Fatalf("detail data = %v want the epic under its negated ID", model.detailData)
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if len(provider.issueUpdates) != 0 {
+		t.Fatalf("issue updates = %+v want none for an epic", provider.issueUpdates)
+	}
+	if notice := updated.(DashboardModel).notice; notice != "quick edits are not available for epics" {
+		t.Fatalf("notice = %q want quick edits refused in the epic detail", notice)
+	}
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	if notice := updated.(DashboardModel).notice; notice != "quick edits are not available for epics" {
+		t.Fatalf("notice = %q want quick edits refused in the epics list", notice)
+	}
+	model = updated.(DashboardModel)
+	model.selected = 2
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+	if model = updated.(DashboardModel); !strings.Contains(model.notice, "open the issue") {
+		t.Fatalf("notice = %q want a hint to open the child issue", model.notice)
+	}
+	model.selected = 0
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if model = updated.(DashboardModel); len(model.items) != 2 || model.items[0].Epic.Expanded {
+		t.Fatalf("items = %+v want the epic collapsed again", model.items)
+	}
+
+	provider.epicErr = fmt.Errorf("list epics for group %q: %w", "org", errors.New("epics are not available: they need GitLab Premium or Ultimate"))
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if !strings.Contains(model.errorMessage, "need GitLab Premium or Ultimate") || len(model.items) != 0 {
+		t.Fatalf("errorMessage = %q items = %d want the unavailable message", model.errorMessage, len(model.items))
+	}
+}
+
+func TestDashboardIssueOverviewListsLinksAndOpensThem(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{issueLinks: []IssueLink{
+		{Kind: IssueLinkRelatesTo, Item: ListItem{ID: 81, Title: "Login page", Subtitle: "org/web#3 • opened", ProjectPath: "org/web", Issue: &IssueDetails{IID: 3, State: "opened"}}},
+		{Kind: IssueLinkBlockedBy, Item: ListItem{ID: 83, Title: "Session store", Subtitle: "org/api#8 • opened", ProjectPath: "org/api", Issue: &IssueDetails{IID: 8, State: "opened"}}},
+		{Kind: IssueLinkClosedBy, Item: ListItem{ID: 82, Title: "Add login", Subtitle: "org/web!4 • opened", ProjectPath: "org/web", MergeRequest: &MergeRequestDetails{IID: 4, State: "opened"}}},
+		{Kind: IssueLinkRelatedToMR, Item: ListItem{ID: 84, Title: "Refactor auth", Subtitle: "org/web!6 • merged", ProjectPath: "org/web", MergeRequest: &MergeRequestDetails{IID: 6, State: "merged"}}},
+	}}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.view = IssuesView
+	m.loading = false
+	m.width = 160
+	m.height = 50
+	m.items = []ListItem{{ID: 70, Title: "Sign in", Issue: &IssueDetails{IID: 7, State: "opened"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	view := updated.(DashboardModel).View()
+	for _, want := range []string{
+		"Linked issues:",
+		"› relates to: Login page • org/web#3 • opened",
+		"is blocked by: Session store • org/api#8 • opened",
+		"Closed by merge requests:",
+		"Add login • org/web!4 • opened",
+		"Related merge requests:",
+		"Refactor auth • org/web!6 • merged",
+	} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("overview does not show %q:\n%s", want, view)
+		}
+	}
+
+	for _, key := range []string{"]", "]", "]", "["} {
+		updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	if view := updated.(DashboardModel).View(); !strings.Contains(view, "› Add login • org/web!4 • opened") {
+		t.Fatalf("expected the closing merge request selected:\n%s", view)
+	}
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	item, ok := model.selectedMergeRequestItem()
+	if model.view != MergeRequestsView || !model.mergeRequestDetail || !ok || item.MergeRequest.IID != 4 {
+		t.Fatalf("view = %v detail = %v selected = %+v want merge request !4 open", model.view, model.mergeRequestDetail, item)
+	}
+}
+
+func TestDashboardIssueOverviewShowsCommentsWhenLinksFail(t *testing.T) {
+	t.Parallel()
+
+	provider := &stubProvider{issueLinksErr: "load linked issues: 403 Forbidden"}
+	m := NewDashboardModel(provider, DashboardContext{ProjectPath: "org/web"})
+	m.view = IssuesView
+	m.loading = false
+	m.width = 160
+	m.height = 50
+	m.items = []ListItem{{ID: 70, Title: "Sign in", Issue: &IssueDetails{IID: 7, State: "opened"}}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	view := model.View()
+	if !strings.Contains(view, "Linked issues and merge requests failed to load: load linked issues: 403 Forbidden") {
+		t.Fatalf("overview does not report the failed links:\n%s", view)
+	}
+	if model.detailErr != "" || len(model.detailData[7].Comments) != 1 {
+		t.Fatalf("detailErr = %q data = %+v want the comments loaded", model.detailErr, model.detailData[7])
+	}
+}
+
+type stubSwitcher struct {
+	switched []ProjectOption
+	pinned   []string
+}
+
+func (s *stubSwitcher) LoadProjectOptions(context.Context) ([]ProjectOption, error) {
+	return []ProjectOption{
+		{Host: "https://gitlab.example.com", HostLabel: "gitlab.example.com", Path: "group/project", Recent: true},
+		{Host: "https://gitlab.other.com", HostLabel: "gitlab.other.com", Path: "team/service"},
+	}, nil
+}
+
+func (s *stubSwitcher) SwitchProject(_ context.Context, option ProjectOption) (DataProvider, DashboardContext, error) {
+	s.switched = append(s.switched, option)
+	return &stubProvider{}, DashboardContext{ProjectPath: option.Path, Host: option.Host, Switcher: s}, nil
+}
+
+func (s *stubSwitcher) ToggleFavorite(_ context.Context, option ProjectOption) (bool, error) {
+	s.pinned = append(s.pinned, option.Path)
+	return !option.Favorite, nil
+}
+
+func TestDashboardProjectSwitcherRebuildsProvider(t *testing.T) {
+	t.Parallel()
+
+	switcher := &stubSwitcher{}
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{ProjectPath: "group/project", Host: "https://gitlab.example.com", Switcher: switcher})
+	m.loading = false
+	m.view = MergeRequestsView
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	updated, _ = updated.Update(cmd())
+	model := updated.(DashboardModel)
+	if !model.pickerActive || model.pickerPurpose != pickerSwitchProject {
+		t.Fatalf("picker active = %v purpose = %v want the project switcher", model.pickerActive, model.pickerPurpose)
+	}
+
+	for _, r := range "service" {
+		updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if cmd == nil {
+		t.Fatal("expected ctrl+f to pin the highlighted project")
+	}
+	updated, _ = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(switcher.pinned) != 1 || switcher.pinned[0] != "team/service" || !model.projectOptions[1].Favorite {
+		t.Fatalf("pinned = %v options = %+v want team/service pinned", switcher.pinned, model.projectOptions)
+	}
+	if !model.pickerActive || !strings.Contains(model.picker.options[1].detail, "pinned") {
+		t.Fatalf("picker detail = %q want the pin shown while the picker stays open", model.picker.options[1].detail)
+	}
+	updated, cmd = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected picking a project to switch to it")
+	}
+	updated, cmd = updated.Update(cmd())
+	model = updated.(DashboardModel)
+	if len(switcher.switched) != 1 || switcher.switched[0].Path != "team/service" {
+		t.Fatalf("switched = %+v want team/service", switcher.switched)
+	}
+	if model.ctx.ProjectPath != "team/service" || model.ctx.Host != "https://gitlab.other.com" {
+		t.Fatalf("context = %+v want team/service on gitlab.other.com", model.ctx)
+	}
+	if model.view != MergeRequestsView || !model.loading || cmd == nil {
+		t.Fatalf("view = %v loading = %v want the merge requests reloading", model.view, model.loading)
+	}
+	updated, _ = updated.Update(cmd())
+	if provider := updated.(DashboardModel).provider.(*stubProvider); len(provider.mergeRequestCalls) != 1 {
+		t.Fatalf("new provider merge request loads = %d want 1", len(provider.mergeRequestCalls))
+	}
+}
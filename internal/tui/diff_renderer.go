@@ -0,0 +1,263 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2/quick"
+)
+
+const (
+	diffSideBySideMinWidth = 120
+	diffLineNumberWidth    = 4
+)
+
+var diffHunkPattern = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+type diffLineKind int
+
+const (
+	diffLineContext diffLineKind = iota
+	diffLineAdded
+	diffLineRemoved
+	diffLineHunk
+	diffLineMeta
+)
+
+type diffLine struct {
+	kind        diffLineKind
+	oldLine     int
+	newLine     int
+	text        string
+	highlighted string
+}
+
+func parseUnifiedDiff(diff string) []diffLine {
+	raw := strings.Split(strings.TrimSuffix(strings.ReplaceAll(diff, "\r\n", "\n"), "\n"), "\n")
+	lines := make([]diffLine, 0, len(raw))
+	oldLine, newLine := 0, 0
+	for _, line := range raw {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			if match := diffHunkPattern.FindStringSubmatch(line); match != nil {
+				oldLine, _ = strconv.Atoi(match[1])
+				newLine, _ = strconv.Atoi(match[2])
+			}
+			lines = append(lines, diffLine{kind: diffLineHunk, text: line})
+		case strings.HasPrefix(line, "+"):
+			lines = append(lines, diffLine{kind: diffLineAdded, newLine: newLine, text: line[1:]})
+			newLine++
+		case strings.HasPrefix(line, "-"):
+			lines = append(lines, diffLine{kind: diffLineRemoved, oldLine: oldLine, text: line[1:]})
+			oldLine++
+		case strings.HasPrefix(line, "\\"):
+			lines = append(lines, diffLine{kind: diffLineMeta, text: line})
+		default:
+			lines = append(lines, diffLine{kind: diffLineContext, oldLine: oldLine, newLine: newLine, text: strings.TrimPrefix(line, " ")})
+			oldLine++
+			newLine++
+		}
+	}
+	return lines
+}
+
+// diffFileStats holds the line counts shown next to a file in the changes
+// tab, counted once when the changes load rather than on every render.
+type diffFileStats struct {
+	added   int
+	removed int
+}
+
+func countDiffFileStats(files []MergeRequestDiffFile) []diffFileStats {
+	stats := make([]diffFileStats, len(files))
+	for i, file := range files {
+		stats[i].added, stats[i].removed = diffStats(parseUnifiedDiff(file.Diff))
+	}
+	return stats
+}
+
+func diffStats(lines []diffLine) (int, int) {
+	added, removed := 0, 0
+	for _, line := range lines {
+		switch line.kind {
+		case diffLineAdded:
+			added++
+		case diffLineRemoved:
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// highlightDiffLines runs chroma over the old and new side of each hunk as a
+// block, so multi-line constructs keep their colours, and maps the result back
+// onto the individual diff lines.
+func highlightDiffLines(lines []diffLine, filename string) {
+	start := 0
+	for start < len(lines) {
+		end := start + 1
+		for end < len(lines) && lines[end].kind != diffLineHunk {
+			end++
+		}
+		hunk := lines[start:end]
+		highlightDiffSide(hunk, filename, diffLineRemoved)
+		highlightDiffSide(hunk, filename, diffLineAdded)
+		start = end
+	}
+}
+
+func highlightDiffSide(hunk []diffLine, filename string, side diffLineKind) {
+	indexes := make([]int, 0, len(hunk))
+	code := make([]string, 0, len(hunk))
+	for i, line := range hunk {
+		if line.kind == side || line.kind == diffLineContext {
+			indexes = append(indexes, i)
+			code = append(code, strings.ReplaceAll(line.text, "\t", "    "))
+		}
+	}
+	if len(code) == 0 {
+		return
+	}
+	highlighted := highlightCodeLines(code, filename)
+	for i, index := range indexes {
+		hunk[index].highlighted = highlighted[i]
+	}
+}
+
+// highlightCodeLines uses the same chroma formatter and style as markdown code
+// blocks, falling back to the plain text when the output cannot be mapped back.
+func highlightCodeLines(code []string, filename string) []string {
+	var buf bytes.Buffer
+	if err := quick.Highlight(&buf, strings.Join(code, "\n"), path.Base(filename), "terminal16m", "monokai"); err != nil {
+		return code
+	}
+	highlighted := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(highlighted) != len(code) {
+		return code
+	}
+	for i := range highlighted {
+		highlighted[i] += "\x1b[0m"
+	}
+	return highlighted
+}
+
+func (l diffLine) display() string {
+	if l.highlighted != "" {
+		return l.highlighted
+	}
+	return strings.ReplaceAll(l.text, "\t", "    ")
+}
+
+func formatDiffLineNumber(number int) string {
+	if number <= 0 {
+		return strings.Repeat(" ", diffLineNumberWidth)
+	}
+	return fmt.Sprintf("%*d", diffLineNumberWidth, number)
+}
+
+func (m DashboardModel) renderUnifiedDiff(lines []diffLine, width int) []string {
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		switch line.kind {
+		case diffLineHunk:
+			out = append(out, m.styles.diffHunk.Render(fitLine(line.text, width)))
+		case diffLineMeta:
+			out = append(out, m.styles.dim.Render(fitLine(line.text, width)))
+		default:
+			gutter := m.styles.dim.Render(formatDiffLineNumber(line.oldLine) + " " + formatDiffLineNumber(line.newLine) + " ")
+			out = append(out, fitLine(gutter+m.diffMarker(line.kind)+" "+line.display(), width))
+		}
+	}
+	return out
+}
+
+// renderSideBySideDiff pairs each run of removals with the additions that
+// follow it so edited lines sit next to each other.
+func (m DashboardModel) renderSideBySideDiff(lines []diffLine, width int) []string {
+	separator := m.styles.dim.Render(" │ ")
+	half := max(10, (width-3)/2)
+	out := make([]string, 0, len(lines))
+
+	cell := func(line *diffLine, number int) string {
+		if line == nil {
+			return strings.Repeat(" ", half)
+		}
+		content := m.styles.dim.Render(formatDiffLineNumber(number)+" ") + m.diffMarker(line.kind) + " " + line.display()
+		return padToWidth(fitLine(content, half), half)
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch line.kind {
+		case diffLineHunk:
+			out = append(out, m.styles.diffHunk.Render(fitLine(line.text, width)))
+			i++
+		case diffLineMeta:
+			out = append(out, m.styles.dim.Render(fitLine(line.text, width)))
+			i++
+		case diffLineContext:
+			out = append(out, cell(&lines[i], line.oldLine)+separator+cell(&lines[i], line.newLine))
+			i++
+		default:
+			removed := make([]int, 0, 4)
+			for i < len(lines) && lines[i].kind == diffLineRemoved {
+				removed = append(removed, i)
+				i++
+			}
+			added := make([]int, 0, 4)
+			for i < len(lines) && lines[i].kind == diffLineAdded {
+				added = append(added, i)
+				i++
+			}
+			for row := 0; row < max(len(removed), len(added)); row++ {
+				left, right := cell(nil, 0), cell(nil, 0)
+				if row < len(removed) {
+					left = cell(&lines[removed[row]], lines[removed[row]].oldLine)
+				}
+				if row < len(added) {
+					right = cell(&lines[added[row]], lines[added[row]].newLine)
+				}
+				out = append(out, left+separator+right)
+			}
+		}
+	}
+	return out
+}
+
+func (m DashboardModel) diffMarker(kind diffLineKind) string {
+	switch kind {
+	case diffLineAdded:
+		return m.styles.diffAdded.Render("+")
+	case diffLineRemoved:
+		return m.styles.diffRemoved.Render("-")
+	default:
+		return " "
+	}
+}
+
+func diffFileStatus(file MergeRequestDiffFile) string {
+	switch {
+	case file.NewFile:
+		return "A"
+	case file.DeletedFile:
+		return "D"
+	case file.RenamedFile:
+		return "R"
+	default:
+		return "M"
+	}
+}
+
+func diffFilePath(file MergeRequestDiffFile) string {
+	if file.RenamedFile && file.OldPath != "" && file.OldPath != file.NewPath {
+		return file.OldPath + " → " + file.NewPath
+	}
+	if file.NewPath != "" {
+		return file.NewPath
+	}
+	return fallbackValue(file.OldPath, "-")
+}
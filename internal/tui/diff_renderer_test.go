@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseUnifiedDiffTracksLineNumbers(t *testing.T) {
+	t.Parallel()
+
+	lines := parseUnifiedDiff("@@ -10,3 +10,3 @@ func main() {\n a := 1\n-b := 2\n+b := 3\n c := 4\n\\ No newline at end of file\n")
+	if len(lines) != 6 {
+		t.Fatalf("len(lines) = %d want 6", len(lines))
+	}
+	if lines[0].kind != diffLineHunk || lines[5].kind != diffLineMeta {
+		t.Fatalf("unexpected hunk/meta kinds: %v %v", lines[0].kind, lines[5].kind)
+	}
+	if lines[2].kind != diffLineRemoved || lines[2].oldLine != 11 {
+		t.Fatalf("removed line = %+v want old line 11", lines[2])
+	}
+	if lines[3].kind != diffLineAdded || lines[3].newLine != 11 {
+		t.Fatalf("added line = %+v want new line 11", lines[3])
+	}
+	if lines[4].oldLine != 12 || lines[4].newLine != 12 {
+		t.Fatalf("context line = %+v want 12/12", lines[4])
+	}
+
+	added, removed := diffStats(lines)
+	if added != 1 || removed != 1 {
+		t.Fatalf("diffStats = +%d -%d want +1 -1", added, removed)
+	}
+}
+
+func TestRenderSideBySideDiffPairsChanges(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(nil, DashboardContext{})
+	lines := parseUnifiedDiff("@@ -1,3 +1,2 @@\n-one\n-two\n+uno\n same\n")
+	out := m.renderSideBySideDiff(lines, 60)
+	if len(out) != 4 {
+		t.Fatalf("len(out) = %d want 4", len(out))
+	}
+	first := stripANSI(out[1])
+	if !strings.Contains(first, "one") || !strings.Contains(first, "uno") {
+		t.Fatalf("expected first change row to pair one with uno, got %q", first)
+	}
+	second := stripANSI(out[2])
+	if !strings.Contains(second, "two") || strings.Contains(second, "uno") {
+		t.Fatalf("expected unpaired removal on second row, got %q", second)
+	}
+}
@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editorHeaderPrefix marks the template lines written above a draft. They are
+// HTML comments so they cannot clash with markdown headings in the body.
+const editorHeaderPrefix = "<!-- lazygitlab:"
+
+type editorFinishedMsg struct {
+	target composerTarget
+	path   string
+	err    error
+}
+
+// editorCommand resolves $VISUAL, then $EDITOR, falling back to vi. The value
+// may carry arguments, as in "code --wait".
+func editorCommand(path string) (*exec.Cmd, error) {
+	editor := strings.TrimSpace(os.Getenv("VISUAL"))
+	if editor == "" {
+		editor = strings.TrimSpace(os.Getenv("EDITOR"))
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	fields := strings.Fields(editor)
+	if _, err := exec.LookPath(fields[0]); err != nil {
+		return nil, fmt.Errorf("editor %q not found: set $VISUAL or $EDITOR", fields[0])
+	}
+	return exec.Command(fields[0], append(fields[1:], path)...), nil
+}
+
+func editorTemplate(reference string, body string) string {
+	header := []string{
+		fmt.Sprintf("%s %s -->", editorHeaderPrefix, reference),
+		editorHeaderPrefix + " lines starting with this marker are removed; save and quit to return -->",
+		"",
+	}
+	return strings.Join(header, "\n") + body
+}
+
+// stripEditorTemplate drops the template header lines wherever they ended up
+// and trims the surrounding blank lines.
+func stripEditorTemplate(content string) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), editorHeaderPrefix) {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// writeEditorDraft stores the body in the draft file at path, creating a new
+// temporary file when path is empty, and returns the path it wrote.
+func writeEditorDraft(path string, reference string, body string) (string, error) {
+	if path == "" {
+		file, err := os.CreateTemp("", "lazygitlab-draft-*.md")
+		if err != nil {
+			return "", fmt.Errorf("create draft file: %w", err)
+		}
+		path = file.Name()
+		if err := file.Close(); err != nil {
+			return "", fmt.Errorf("create draft file: %w", err)
+		}
+	}
+	if err := os.WriteFile(path, []byte(editorTemplate(reference, body)), 0o600); err != nil {
+		return "", fmt.Errorf("write draft file: %w", err)
+	}
+	return path, nil
+}
+
+func readEditorDraft(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read draft file: %w", err)
+	}
+	return stripEditorTemplate(string(data)), nil
+}
+
+// openComposerEditor suspends the program and edits the current composer
+// draft in the user's editor, loading the result back when it exits.
+func (m DashboardModel) openComposerEditor() (tea.Model, tea.Cmd) {
+	path, err := writeEditorDraft(m.composerDraftPath, m.composerTarget.title, m.composerInput.Value())
+	if err != nil {
+		m.composerErr = err.Error()
+		return m, nil
+	}
+	m.composerDraftPath = path
+	cmd, err := editorCommand(path)
+	if err != nil {
+		m.composerErr = err.Error()
+		return m, nil
+	}
+	m.composerErr = ""
+	target := m.composerTarget
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return editorFinishedMsg{target: target, path: path, err: err}
+	})
+}
+
+func (m DashboardModel) applyEditorFinished(msg editorFinishedMsg) (tea.Model, tea.Cmd) {
+	if !m.composer || msg.target != m.composerTarget {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.composerErr = fmt.Sprintf("editor exited with an error: %v", msg.err)
+		return m, nil
+	}
+	body, err := readEditorDraft(msg.path)
+	if err != nil {
+		m.composerErr = err.Error()
+		return m, nil
+	}
+	m.composerInput.SetValue(body)
+	m.composerPreview = false
+	m.composerErr = ""
+	return m, m.composerInput.Focus()
+}
+
+// discardEditorDraft removes the draft file once its content has been posted.
+func (m DashboardModel) discardEditorDraft() DashboardModel {
+	if m.composerDraftPath != "" {
+		_ = os.Remove(m.composerDraftPath)
+		m.composerDraftPath = ""
+	}
+	return m
+}
@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestStripEditorTemplateRemovesHeader(t *testing.T) {
+	t.Parallel()
+
+	content := editorTemplate("Comment on #12 Broken login", "# Findings\n\n- one\n")
+	if !strings.HasPrefix(content, editorHeaderPrefix+" Comment on #12 Broken login -->") {
+		t.Fatalf("template header missing reference: %q", content)
+	}
+	if got := stripEditorTemplate(content); got != "# Findings\n\n- one" {
+		t.Fatalf("stripEditorTemplate = %q want %q", got, "# Findings\n\n- one")
+	}
+}
+
+func TestEditorCommandPrefersVisual(t *testing.T) {
+	t.Setenv("VISUAL", "true --wait")
+	t.Setenv("EDITOR", "false")
+
+	cmd, err := editorCommand("/tmp/draft.md")
+	if err != nil {
+		t.Fatalf("editorCommand error = %v", err)
+	}
+	if got := strings.Join(cmd.Args, " "); got != "true --wait /tmp/draft.md" {
+		t.Fatalf("editor args = %q want %q", got, "true --wait /tmp/draft.md")
+	}
+}
+
+func TestMergeRequestFormLoadsEditedDescription(t *testing.T) {
+	t.Setenv("VISUAL", "true")
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{ProjectPath: "group/project"})
+	m.view = MergeRequestsView
+	m.loading = false
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyTab})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	model := updated.(DashboardModel)
+	if cmd == nil || model.mergeRequestFormDraftPath == "" {
+		t.Fatalf("expected ctrl+o to open the editor on a draft, err = %q", model.mergeRequestFormErr)
+	}
+	path := model.mergeRequestFormDraftPath
+	t.Cleanup(func() { _ = os.Remove(path) })
+
+	if _, err := writeEditorDraft(path, "New merge request: untitled", "## Why\n\nlong rationale"); err != nil {
+		t.Fatalf("writeEditorDraft error = %v", err)
+	}
+	updated, _ = model.Update(mergeRequestFormEditorFinishedMsg{path: path})
+	model = updated.(DashboardModel)
+	if got := model.mergeRequestFormDescription.Value(); got != "## Why\n\nlong rationale" {
+		t.Fatalf("description = %q want the edited draft without its header", got)
+	}
+
+	model = model.resetMergeRequestForm()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("draft %s should be removed once the form is reset, stat err = %v", path, err)
+	}
+}
+
+func TestComposerLoadsEditedDraft(t *testing.T) {
+	t.Parallel()
+
+	m := NewDashboardModel(&stubProvider{}, DashboardContext{})
+	m.loading = false
+	m.items = []ListItem{{ID: 11, Title: "Issue one", Issue: &IssueDetails{IID: 101, State: "opened"}}}
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	model := updated.(DashboardModel)
+
+	path, err := writeEditorDraft("", model.composerTarget.title, "long review note\n\nsecond paragraph")
+	if err != nil {
+		t.Fatalf("writeEditorDraft error = %v", err)
+	}
+	t.Cleanup(func() { _ = os.Remove(path) })
+
+	updated, _ = model.Update(editorFinishedMsg{target: model.composerTarget, path: path})
+	model = updated.(DashboardModel)
+	if got := model.composerInput.Value(); got != "long review note\n\nsecond paragraph" {
+		t.Fatalf("composer value = %q want the edited draft without its header", got)
+	}
+}
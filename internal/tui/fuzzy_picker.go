@@ -0,0 +1,263 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const fuzzyPickerRows = 10
+
+type pickerOption struct {
+	value  string
+	label  string
+	detail string
+}
+
+type pickerResult int
+
+const (
+	pickerOpen pickerResult = iota
+	pickerDone
+	pickerCancelled
+)
+
+// fuzzyPicker filters a fixed option list as the user types. Single pickers
+// return the highlighted option on enter; multi pickers toggle options with
+// tab and return every checked option on enter, or just the highlighted one
+// when nothing was checked.
+type fuzzyPicker struct {
+	title    string
+	hint     string
+	options  []pickerOption
+	multi    bool
+	checked  map[string]bool
+	query    textinput.Model
+	matches  []int
+	cursor   int
+	touched  bool
+	selected []string
+}
+
+func newFuzzyPicker(title string, options []pickerOption, multi bool, checked []string) fuzzyPicker {
+	query := textinput.New()
+	query.Prompt = "Filter: "
+	query.Placeholder = "type to narrow the list"
+	query.CharLimit = 80
+	query.Width = 40
+	query.Focus()
+
+	p := fuzzyPicker{
+		title:   title,
+		options: options,
+		multi:   multi,
+		checked: make(map[string]bool, len(checked)),
+		query:   query,
+	}
+	for _, value := range checked {
+		p.checked[value] = true
+	}
+	p.filter()
+	if !multi && len(checked) == 1 {
+		for i, index := range p.matches {
+			if p.options[index].value == checked[0] {
+				p.cursor = i
+				break
+			}
+		}
+	}
+	return p
+}
+
+func (p fuzzyPicker) update(msg tea.KeyMsg) (fuzzyPicker, pickerResult, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		return p, pickerCancelled, nil
+	case "up", "ctrl+p", "ctrl+k":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+		return p, pickerOpen, nil
+	case "down", "ctrl+n", "ctrl+j":
+		if p.cursor < len(p.matches)-1 {
+			p.cursor++
+		}
+		return p, pickerOpen, nil
+	case "tab":
+		if p.multi {
+			p.toggle()
+			p.touched = true
+			if p.cursor < len(p.matches)-1 {
+				p.cursor++
+			}
+		}
+		return p, pickerOpen, nil
+	case "enter":
+		if !p.multi {
+			option, ok := p.highlighted()
+			if !ok {
+				return p, pickerOpen, nil
+			}
+			p.selected = []string{option.value}
+			return p, pickerDone, nil
+		}
+		if len(p.checked) == 0 && !p.touched {
+			p.toggle()
+		}
+		p.selected = p.checkedValues()
+		return p, pickerDone, nil
+	}
+
+	var cmd tea.Cmd
+	before := p.query.Value()
+	p.query, cmd = p.query.Update(msg)
+	if p.query.Value() != before {
+		p.filter()
+	}
+	return p, pickerOpen, cmd
+}
+
+func (p fuzzyPicker) highlighted() (pickerOption, bool) {
+	if p.cursor < 0 || p.cursor >= len(p.matches) {
+		return pickerOption{}, false
+	}
+	return p.options[p.matches[p.cursor]], true
+}
+
+func (p *fuzzyPicker) toggle() {
+	option, ok := p.highlighted()
+	if !ok {
+		return
+	}
+	if p.checked[option.value] {
+		delete(p.checked, option.value)
+		return
+	}
+	p.checked[option.value] = true
+}
+
+// checkedValues returns the checked options in their original order.
+func (p fuzzyPicker) checkedValues() []string {
+	values := make([]string, 0, len(p.checked))
+	for _, option := range p.options {
+		if p.checked[option.value] {
+			values = append(values, option.value)
+		}
+	}
+	return values
+}
+
+func (p *fuzzyPicker) filter() {
+	query := strings.TrimSpace(p.query.Value())
+	type scored struct {
+		index int
+		score int
+	}
+	matches := make([]scored, 0, len(p.options))
+	for i, option := range p.options {
+		score, ok := fuzzyScore(query, option.label)
+		if detailScore, detailOK := fuzzyScore(query, option.detail); detailOK && (!ok || detailScore > score) {
+			score, ok = detailScore, true
+		}
+		if ok {
+			matches = append(matches, scored{index: i, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	p.matches = make([]int, 0, len(matches))
+	for _, match := range matches {
+		p.matches = append(p.matches, match.index)
+	}
+	if p.cursor >= len(p.matches) {
+		p.cursor = max(0, len(p.matches)-1)
+	}
+}
+
+// fuzzyScore reports whether every rune of query appears in candidate in
+// order, ignoring case. Matches at word starts and runs of consecutive runes
+// score higher so "fe" ranks "feature" above "backend-fixes".
+func fuzzyScore(query string, candidate string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+	needle := []rune(strings.ToLower(query))
+	haystack := []rune(strings.ToLower(candidate))
+	score := 0
+	matched := 0
+	previous := -2
+	for i, r := range haystack {
+		if matched == len(needle) {
+			break
+		}
+		if r != needle[matched] {
+			continue
+		}
+		score++
+		if i == previous+1 {
+			score += 5
+		}
+		if i == 0 || isFuzzyWordBoundary(haystack[i-1]) {
+			score += 10
+		}
+		previous = i
+		matched++
+	}
+	if matched < len(needle) {
+		return 0, false
+	}
+	return score, true
+}
+
+func isFuzzyWordBoundary(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("-_/.:@", r)
+}
+
+func (p fuzzyPicker) view(width int, s styles) []string {
+	hint := "enter: choose | esc: cancel"
+	if p.multi {
+		hint = "tab: toggle | enter: done | esc: cancel"
+	}
+	if p.hint != "" {
+		hint = p.hint
+	}
+	lines := []string{
+		s.header.Render(fitLine(p.title, width)),
+		s.dim.Render(fitLine(hint, width)),
+		fitLine(p.query.View(), width),
+		"",
+	}
+	if len(p.matches) == 0 {
+		return append(lines, s.dim.Render("No matches."))
+	}
+
+	start, end := visibleRange(len(p.matches), p.cursor, fuzzyPickerRows)
+	for i := start; i < end; i++ {
+		option := p.options[p.matches[i]]
+		label := option.label
+		if p.multi {
+			box := "[ ] "
+			if p.checked[option.value] {
+				box = "[x] "
+			}
+			label = box + label
+		}
+		if option.detail != "" {
+			label += s.dim.Render("  " + option.detail)
+		}
+		if i == p.cursor {
+			lines = append(lines, fitLine(s.selectedRow.Render("› ")+label, width))
+			continue
+		}
+		lines = append(lines, fitLine("  "+label, width))
+	}
+	if len(p.matches) > fuzzyPickerRows {
+		lines = append(lines, s.dim.Render(fmt.Sprintf("%d-%d of %d", start+1, end, len(p.matches))))
+	}
+	return lines
+}
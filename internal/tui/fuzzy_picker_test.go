@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFuzzyScoreRanksWordStartsFirst(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := fuzzyScore("fe", "bug"); ok {
+		t.Fatalf("fuzzyScore matched a candidate without the query runes")
+	}
+	feature, ok := fuzzyScore("fe", "feature")
+	if !ok {
+		t.Fatalf("fuzzyScore did not match feature")
+	}
+	backend, ok := fuzzyScore("fe", "backend-fixes")
+	if !ok {
+		t.Fatalf("fuzzyScore did not match backend-fixes")
+	}
+	if feature <= backend {
+		t.Fatalf("feature score = %d want above backend-fixes score %d", feature, backend)
+	}
+	if _, ok := fuzzyScore("PRIO", "priority::high"); !ok {
+		t.Fatalf("fuzzyScore should ignore case")
+	}
+}
+
+func TestFuzzyPickerMultiSelectKeepsOptionOrder(t *testing.T) {
+	t.Parallel()
+
+	options := []pickerOption{{value: "bug", label: "bug"}, {value: "backend", label: "backend"}, {value: "frontend", label: "frontend"}}
+	p := newFuzzyPicker("Labels", options, true, []string{"frontend"})
+	for _, r := range "back" {
+		p, _, _ = p.update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if len(p.matches) != 1 {
+		t.Fatalf("matches = %d want 1", len(p.matches))
+	}
+	p, result, _ := p.update(tea.KeyMsg{Type: tea.KeyTab})
+	if result != pickerOpen {
+		t.Fatalf("tab result = %v want %v", result, pickerOpen)
+	}
+	p, result, _ = p.update(tea.KeyMsg{Type: tea.KeyEnter})
+	if result != pickerDone {
+		t.Fatalf("enter result = %v want %v", result, pickerDone)
+	}
+	if want := []string{"backend", "frontend"}; !reflect.DeepEqual(p.selected, want) {
+		t.Fatalf("selected = %v want %v", p.selected, want)
+	}
+}
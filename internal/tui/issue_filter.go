@@ -0,0 +1,196 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// issueFilterKeys lists the keys of the issue filter query syntax.
+var issueFilterKeys = []string{"label", "assignee", "author", "milestone", "weight", "due"}
+
+var issueDueValues = []string{"none", "any", "today", "tomorrow", "overdue", "week", "month"}
+
+// issueFilterPresets are offered in the filter panel above the saved filters.
+var issueFilterPresets = []SavedFilter{
+	{Name: "Assigned to me", Query: "assignee:@me"},
+	{Name: "Created by me", Query: "author:@me"},
+	{Name: "Unassigned", Query: "assignee:none"},
+	{Name: "Overdue", Query: "due:overdue"},
+	{Name: "Without milestone", Query: "milestone:none"},
+}
+
+type filterTerm struct {
+	key    string
+	value  string
+	negate bool
+}
+
+// parseIssueFilter splits an issue filter query into its filter and the free
+// text left over for the search. Terms are key:value pairs; values with
+// spaces are quoted, as in milestone:"16.4 RC", and a leading "-" excludes
+// labels, assignees, authors and milestones.
+func parseIssueFilter(query string) (IssueFilter, string, error) {
+	var filter IssueFilter
+	var words []string
+	for _, token := range tokenizeFilterQuery(query) {
+		if token.key == "" {
+			words = append(words, token.value)
+			continue
+		}
+		if token.value == "" {
+			return IssueFilter{}, "", fmt.Errorf("filter %q needs a value", token.key+":")
+		}
+		switch token.key {
+		case "label":
+			if token.negate {
+				filter.NotLabels = append(filter.NotLabels, token.value)
+			} else {
+				filter.Labels = append(filter.Labels, token.value)
+			}
+		case "assignee":
+			setFilterValue(&filter.Assignee, &filter.NotAssignee, token.negate, filterUsername(token.value))
+		case "author":
+			setFilterValue(&filter.Author, &filter.NotAuthor, token.negate, filterUsername(token.value))
+		case "milestone":
+			setFilterValue(&filter.Milestone, &filter.NotMilestone, token.negate, token.value)
+		case "weight":
+			if token.negate {
+				return IssueFilter{}, "", fmt.Errorf("weight cannot be excluded")
+			}
+			value := strings.ToLower(token.value)
+			if _, err := strconv.Atoi(value); err != nil && value != "none" && value != "any" {
+				return IssueFilter{}, "", fmt.Errorf("weight %q is not a number, none or any", token.value)
+			}
+			filter.Weight = value
+		case "due":
+			if token.negate {
+				return IssueFilter{}, "", fmt.Errorf("due cannot be excluded")
+			}
+			value := strings.ToLower(token.value)
+			if !slices.Contains(issueDueValues, value) {
+				return IssueFilter{}, "", fmt.Errorf("due %q is not one of %s", token.value, strings.Join(issueDueValues, ", "))
+			}
+			filter.Due = value
+		default:
+			prefix := ""
+			if token.negate {
+				prefix = "-"
+			}
+			return IssueFilter{}, "", fmt.Errorf("unknown filter %q; use %s", prefix+token.key+":", strings.Join(issueFilterKeys, ", "))
+		}
+	}
+	return filter, strings.Join(words, " "), nil
+}
+
+// tokenizeFilterQuery splits a filter query on spaces outside quotes. A
+// token is a term when it has a colon before any quote, so "a:b" in quotes
+// is text. The merge request filter shares this syntax.
+func tokenizeFilterQuery(query string) []filterTerm {
+	var terms []filterTerm
+	var current strings.Builder
+	key := ""
+	quoted := false
+	sawQuote := false
+	started := false
+	flush := func() {
+		if started {
+			term := filterTerm{key: strings.ToLower(key), value: current.String()}
+			term.key, term.negate = strings.CutPrefix(term.key, "-")
+			terms = append(terms, term)
+		}
+		current.Reset()
+		key = ""
+		sawQuote = false
+		started = false
+	}
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			sawQuote = true
+			started = true
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		case r == ':' && key == "" && !sawQuote && current.Len() > 0:
+			key = current.String()
+			current.Reset()
+			started = true
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+	flush()
+	return terms
+}
+
+func setFilterValue(include *string, exclude *string, negate bool, value string) {
+	if negate {
+		*exclude = value
+		return
+	}
+	*include = value
+}
+
+// filterUsername drops the "@" of a mention, but keeps "@me", which stands for the
+// current user until withCurrentUser replaces it.
+func filterUsername(value string) string {
+	if strings.EqualFold(value, "@me") {
+		return "@me"
+	}
+	return strings.TrimPrefix(value, "@")
+}
+
+// withCurrentUser replaces "@me" with the current user.
+func (f IssueFilter) withCurrentUser(name string) IssueFilter {
+	if name == "" {
+		return f
+	}
+	for _, field := range []*string{&f.Assignee, &f.NotAssignee, &f.Author, &f.NotAuthor} {
+		if *field == "@me" {
+			*field = name
+		}
+	}
+	return f
+}
+
+// issueFilterChips describes each active term of a parsed filter query.
+func issueFilterChips(filter IssueFilter, search string) []string {
+	var chips []string
+	add := func(key string, value string) {
+		chips = appendFilterChip(chips, key, value)
+	}
+	for _, label := range filter.Labels {
+		add("label", label)
+	}
+	for _, label := range filter.NotLabels {
+		add("-label", label)
+	}
+	add("assignee", filter.Assignee)
+	add("-assignee", filter.NotAssignee)
+	add("author", filter.Author)
+	add("-author", filter.NotAuthor)
+	add("milestone", filter.Milestone)
+	add("-milestone", filter.NotMilestone)
+	add("weight", filter.Weight)
+	add("due", filter.Due)
+	if search != "" {
+		chips = append(chips, strconv.Quote(search))
+	}
+	return chips
+}
+
+// appendFilterChip adds key:value to chips, quoting values with spaces so the
+// chip reads as it would be typed. Empty values are skipped.
+func appendFilterChip(chips []string, key string, value string) []string {
+	if value == "" {
+		return chips
+	}
+	if strings.ContainsFunc(value, unicode.IsSpace) {
+		value = strconv.Quote(value)
+	}
+	return append(chips, key+":"+value)
+}
@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIssueFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		query  string
+		filter IssueFilter
+		search string
+		err    string
+	}{
+		{name: "plain search", query: "login bug", search: "login bug"},
+		{
+			name:  "all terms",
+			query: `label:bug -label:wontfix assignee:@me -author:@bot milestone:"16.4 RC" weight:3 due:Overdue crash`,
+			filter: IssueFilter{
+				Labels:    []string{"bug"},
+				NotLabels: []string{"wontfix"},
+				Assignee:  "@me",
+				NotAuthor: "bot",
+				Milestone: "16.4 RC",
+				Weight:    "3",
+				Due:       "overdue",
+			},
+			search: "crash",
+		},
+		{name: "quoted text is search", query: `"label:bug"`, search: "label:bug"},
+		{name: "quoted label", query: `label:"needs review" -milestone:none`, filter: IssueFilter{Labels: []string{"needs review"}, NotMilestone: "none"}},
+		{name: "unknown key", query: "status:open", err: `unknown filter "status:"`},
+		{name: "missing value", query: "label:", err: `filter "label:" needs a value`},
+		{name: "bad weight", query: "weight:heavy", err: `weight "heavy"`},
+		{name: "bad due", query: "due:yesterday", err: `due "yesterday"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, search, err := parseIssueFilter(tt.query)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("parseIssueFilter(%q) error = %v want %q", tt.query, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseIssueFilter(%q) error = %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(filter, tt.filter) || search != tt.search {
+				t.Fatalf("parseIssueFilter(%q) = %+v, %q want %+v, %q", tt.query, filter, search, tt.filter, tt.search)
+			}
+		})
+	}
+}
+
+func TestIssueFilterChipsAndCurrentUser(t *testing.T) {
+	t.Parallel()
+
+	filter, search, err := parseIssueFilter(`assignee:@me milestone:"16.4 RC" -label:wontfix flaky`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chips := issueFilterChips(filter.withCurrentUser("alice"), search)
+	want := []string{"-label:wontfix", "assignee:alice", `milestone:"16.4 RC"`, `"flaky"`}
+	if !reflect.DeepEqual(chips, want) {
+		t.Fatalf("chips = %q want %q", chips, want)
+	}
+}
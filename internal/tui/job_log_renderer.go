@@ -0,0 +1,238 @@
+package tui
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+// GitLab runners wrap collapsible output in marker lines of the form
+// "section_start:<unix>:<name>[options]\r\x1b[0K<header>" and
+// "section_end:<unix>:<name>\r\x1b[0K".
+var jobLogSectionPattern = regexp.MustCompile(`section_(start|end):(\d+):([A-Za-z0-9_.\-]+)(\[[^\]]*\])?\r?(?:\x1b\[0?K)?`)
+
+// jobLogControlPattern matches CSI sequences other than SGR colours, such as
+// the erase-line codes the runner emits before every marker.
+var jobLogControlPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-La-ln-z]`)
+
+type jobLogLine struct {
+	text    string
+	section int
+	header  bool
+}
+
+type jobLogSection struct {
+	name      string
+	header    string
+	parent    int
+	depth     int
+	startedAt int64
+	duration  string
+	collapsed bool
+}
+
+type jobLogDocument struct {
+	lines    []jobLogLine
+	sections []jobLogSection
+}
+
+type jobLogView struct {
+	lines      []string
+	headerRows []int
+}
+
+func parseJobLog(raw string) jobLogDocument {
+	doc := jobLogDocument{}
+	if raw == "" {
+		return doc
+	}
+
+	open := make([]int, 0, 4)
+	current := func() int {
+		if len(open) == 0 {
+			return -1
+		}
+		return open[len(open)-1]
+	}
+
+	rawLines := strings.Split(strings.TrimSuffix(strings.ReplaceAll(raw, "\r\n", "\n"), "\n"), "\n")
+	for _, rawLine := range rawLines {
+		matches := jobLogSectionPattern.FindAllStringSubmatchIndex(rawLine, -1)
+		if len(matches) == 0 {
+			doc.lines = append(doc.lines, jobLogLine{text: cleanJobLogText(rawLine), section: current()})
+			continue
+		}
+
+		pos := 0
+		for i, match := range matches {
+			if before := cleanJobLogText(rawLine[pos:match[0]]); strings.TrimSpace(xansi.Strip(before)) != "" {
+				doc.lines = append(doc.lines, jobLogLine{text: before, section: current()})
+			}
+			pos = match[1]
+			next := len(rawLine)
+			if i+1 < len(matches) {
+				next = matches[i+1][0]
+			}
+
+			kind := rawLine[match[2]:match[3]]
+			timestamp, _ := strconv.ParseInt(rawLine[match[4]:match[5]], 10, 64)
+			name := rawLine[match[6]:match[7]]
+			options := ""
+			if match[8] >= 0 {
+				options = rawLine[match[8]:match[9]]
+			}
+
+			if kind == "start" {
+				header := cleanJobLogText(rawLine[pos:next])
+				if strings.TrimSpace(xansi.Strip(header)) == "" {
+					header = name
+				}
+				pos = next
+				index := len(doc.sections)
+				parent := current()
+				depth := 0
+				if parent >= 0 {
+					depth = doc.sections[parent].depth + 1
+				}
+				doc.sections = append(doc.sections, jobLogSection{
+					name:      name,
+					header:    header,
+					parent:    parent,
+					depth:     depth,
+					startedAt: timestamp,
+					collapsed: strings.Contains(options, "collapsed=true"),
+				})
+				doc.lines = append(doc.lines, jobLogLine{text: header, section: index, header: true})
+				open = append(open, index)
+				continue
+			}
+
+			// Close the named section along with anything left open inside it.
+			for j := len(open) - 1; j >= 0; j-- {
+				if doc.sections[open[j]].name != name {
+					continue
+				}
+				for _, index := range open[j:] {
+					if timestamp >= doc.sections[index].startedAt {
+						doc.sections[index].duration = formatJobLogDuration(timestamp - doc.sections[index].startedAt)
+					}
+				}
+				open = open[:j]
+				break
+			}
+		}
+		if rest := cleanJobLogText(rawLine[pos:]); strings.TrimSpace(xansi.Strip(rest)) != "" {
+			doc.lines = append(doc.lines, jobLogLine{text: rest, section: current()})
+		}
+	}
+
+	return doc
+}
+
+// cleanJobLogText keeps SGR colours but drops other terminal control codes and
+// applies carriage returns the way a terminal would, keeping the last write.
+func cleanJobLogText(input string) string {
+	text := jobLogControlPattern.ReplaceAllString(input, "")
+	if strings.Contains(text, "\r") {
+		parts := strings.Split(text, "\r")
+		text = ""
+		for i := len(parts) - 1; i >= 0; i-- {
+			if strings.TrimSpace(xansi.Strip(parts[i])) != "" {
+				text = parts[i]
+				break
+			}
+		}
+	}
+	return strings.ReplaceAll(text, "\t", "    ")
+}
+
+func formatJobLogDuration(seconds int64) string {
+	d := time.Duration(seconds) * time.Second
+	if d < time.Minute {
+		return strconv.FormatInt(seconds, 10) + "s"
+	}
+	return d.String()
+}
+
+// sectionCollapsed resolves the fold state of a section, letting explicit
+// toggles override the runner's collapsed=true default.
+func (doc jobLogDocument) sectionCollapsed(index int, folds map[int]bool) bool {
+	if collapsed, ok := folds[index]; ok {
+		return collapsed
+	}
+	return doc.sections[index].collapsed
+}
+
+func (doc jobLogDocument) sectionHidden(index int, folds map[int]bool) bool {
+	for index >= 0 {
+		if doc.sectionCollapsed(index, folds) {
+			return true
+		}
+		index = doc.sections[index].parent
+	}
+	return false
+}
+
+// render flattens the document into display rows, hiding the bodies of folded
+// sections and hard-wrapping long lines without dropping colour codes.
+func (doc jobLogDocument) render(width int, folds map[int]bool, selected int, s styles) jobLogView {
+	view := jobLogView{
+		lines:      make([]string, 0, len(doc.lines)),
+		headerRows: make([]int, len(doc.sections)),
+	}
+	for i := range view.headerRows {
+		view.headerRows[i] = -1
+	}
+
+	for _, line := range doc.lines {
+		if line.header {
+			section := doc.sections[line.section]
+			if section.parent >= 0 && doc.sectionHidden(section.parent, folds) {
+				continue
+			}
+			marker := "▾"
+			if doc.sectionCollapsed(line.section, folds) {
+				marker = "▸"
+			}
+			prefix := "  "
+			if line.section == selected {
+				prefix = s.selectedRow.Render("› ")
+			}
+			header := prefix + strings.Repeat("  ", section.depth) + marker + " " + line.text + "\x1b[0m"
+			if section.duration != "" {
+				header += s.dim.Render(" (" + section.duration + ")")
+			}
+			view.headerRows[line.section] = len(view.lines)
+			view.lines = append(view.lines, fitLine(header, width))
+			continue
+		}
+		if line.section >= 0 && doc.sectionHidden(line.section, folds) {
+			continue
+		}
+		for _, wrapped := range wrapJobLogLine(line.text, max(1, width-2)) {
+			view.lines = append(view.lines, "  "+wrapped)
+		}
+	}
+	return view
+}
+
+func wrapJobLogLine(text string, width int) []string {
+	if text == "" {
+		return []string{""}
+	}
+	suffix := ""
+	if strings.Contains(text, "\x1b[") {
+		suffix = "\x1b[0m"
+	}
+	if width <= 1 || xansi.StringWidth(text) <= width {
+		return []string{text + suffix}
+	}
+	parts := strings.Split(xansi.Hardwrap(text, width, true), "\n")
+	for i := range parts {
+		parts[i] += suffix
+	}
+	return parts
+}
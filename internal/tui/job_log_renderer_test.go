@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleJobLog = "\x1b[0KRunning with gitlab-runner\n" +
+	"\x1b[0Ksection_start:100:prepare[collapsed=true]\r\x1b[0K\x1b[36;1mPreparing executor\x1b[0;m\n" +
+	"Pulling image\n" +
+	"\x1b[0Ksection_end:104:prepare\r\x1b[0K\n" +
+	"\x1b[0Ksection_start:104:step_script\r\x1b[0K\x1b[36;1mExecuting step_script\x1b[0;m\n" +
+	"\x1b[0Ksection_start:105:deps\r\x1b[0KInstalling deps\n" +
+	"downloading\n" +
+	"\x1b[0Ksection_end:110:deps\r\x1b[0K\n" +
+	"\x1b[31;1mFAIL\x1b[0;m\n" +
+	"\x1b[0Ksection_end:200:step_script\r\x1b[0K\n"
+
+func TestParseJobLogGroupsSections(t *testing.T) {
+	t.Parallel()
+
+	doc := parseJobLog(sampleJobLog)
+	if len(doc.sections) != 3 {
+		t.Fatalf("sections = %d want 3", len(doc.sections))
+	}
+
+	prepare := doc.sections[0]
+	if !prepare.collapsed {
+		t.Fatal("expected collapsed=true option to fold prepare by default")
+	}
+	if got := stripANSI(prepare.header); got != "Preparing executor" {
+		t.Fatalf("prepare header = %q want %q", got, "Preparing executor")
+	}
+	if prepare.duration != "4s" {
+		t.Fatalf("prepare duration = %q want %q", prepare.duration, "4s")
+	}
+
+	deps := doc.sections[2]
+	if deps.parent != 1 || deps.depth != 1 {
+		t.Fatalf("deps parent/depth = %d/%d want 1/1", deps.parent, deps.depth)
+	}
+	if doc.sections[1].duration != "1m36s" {
+		t.Fatalf("step_script duration = %q want %q", doc.sections[1].duration, "1m36s")
+	}
+
+	for _, line := range doc.lines {
+		if strings.Contains(line.text, "section_") {
+			t.Fatalf("expected section markers to be stripped, got %q", line.text)
+		}
+	}
+}
+
+func TestJobLogRenderFoldsSections(t *testing.T) {
+	t.Parallel()
+
+	doc := parseJobLog(sampleJobLog)
+	folds := map[int]bool{}
+	view := stripANSI(strings.Join(doc.render(80, folds, -1, newStyles()).lines, "\n"))
+	if strings.Contains(view, "Pulling image") {
+		t.Fatalf("expected collapsed section body to be hidden, got %q", view)
+	}
+	if !strings.Contains(view, "▸ Preparing executor") || !strings.Contains(view, "downloading") {
+		t.Fatalf("expected folded header and expanded nested body, got %q", view)
+	}
+
+	folds[0] = false
+	folds[1] = true
+	rendered := doc.render(80, folds, 1, newStyles())
+	view = stripANSI(strings.Join(rendered.lines, "\n"))
+	if !strings.Contains(view, "Pulling image") {
+		t.Fatalf("expected expanded section body, got %q", view)
+	}
+	if strings.Contains(view, "Installing deps") || strings.Contains(view, "FAIL") {
+		t.Fatalf("expected nested content of folded section to be hidden, got %q", view)
+	}
+	if rendered.headerRows[2] != -1 {
+		t.Fatalf("nested header row = %d want -1", rendered.headerRows[2])
+	}
+	if !strings.Contains(view, "› ▸ Executing step_script") {
+		t.Fatalf("expected selected section marker, got %q", view)
+	}
+}
+
+func TestCleanJobLogTextKeepsColoursAndLastWrite(t *testing.T) {
+	t.Parallel()
+
+	got := cleanJobLogText("\x1b[0Kprogress 10%\rprogress 100%\r\x1b[32mdone\x1b[0m\r")
+	if got != "\x1b[32mdone\x1b[0m" {
+		t.Fatalf("cleanJobLogText = %q want %q", got, "\x1b[32mdone\x1b[0m")
+	}
+}
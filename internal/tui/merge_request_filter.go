@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// mergeRequestFilterKeys lists the keys of the merge request filter query
+// syntax.
+var mergeRequestFilterKeys = []string{"label", "author", "assignee", "reviewer", "approved-by", "target", "draft"}
+
+// mergeRequestFilterPresets are offered in the merge request filter panel.
+var mergeRequestFilterPresets = []SavedFilter{
+	{Name: "Needs my review", Query: "reviewer:@me draft:no"},
+	{Name: "Approved by me", Query: "approved-by:@me"},
+	{Name: "Created by me", Query: "author:@me"},
+	{Name: "Assigned to me", Query: "assignee:@me"},
+	{Name: "Drafts", Query: "draft:yes"},
+}
+
+// parseMergeRequestFilter splits a merge request filter query into its filter
+// and the free text left over for the search, with the same syntax as
+// parseIssueFilter. Only labels and authors can be excluded.
+func parseMergeRequestFilter(query string) (MergeRequestFilter, string, error) {
+	var filter MergeRequestFilter
+	var words []string
+	for _, token := range tokenizeFilterQuery(query) {
+		if token.key == "" {
+			words = append(words, token.value)
+			continue
+		}
+		if token.value == "" {
+			return MergeRequestFilter{}, "", fmt.Errorf("filter %q needs a value", token.key+":")
+		}
+		if token.negate && token.key != "label" && token.key != "author" && slices.Contains(mergeRequestFilterKeys, token.key) {
+			return MergeRequestFilter{}, "", fmt.Errorf("%s cannot be excluded", token.key)
+		}
+		switch token.key {
+		case "label":
+			if token.negate {
+				filter.NotLabels = append(filter.NotLabels, token.value)
+			} else {
+				filter.Labels = append(filter.Labels, token.value)
+			}
+		case "author":
+			setFilterValue(&filter.Author, &filter.NotAuthor, token.negate, filterUsername(token.value))
+		case "assignee":
+			filter.Assignee = filterUsername(token.value)
+		case "reviewer":
+			filter.Reviewer = filterUsername(token.value)
+		case "approved-by":
+			filter.ApprovedBy = filterUsername(token.value)
+		case "target":
+			filter.Target = token.value
+		case "draft":
+			switch strings.ToLower(token.value) {
+			case "yes", "true":
+				filter.Draft = "yes"
+			case "no", "false":
+				filter.Draft = "no"
+			default:
+				return MergeRequestFilter{}, "", fmt.Errorf("draft %q is not yes or no", token.value)
+			}
+		default:
+			prefix := ""
+			if token.negate {
+				prefix = "-"
+			}
+			return MergeRequestFilter{}, "", fmt.Errorf("unknown filter %q; use %s", prefix+token.key+":", strings.Join(mergeRequestFilterKeys, ", "))
+		}
+	}
+	return filter, strings.Join(words, " "), nil
+}
+
+// withCurrentUser replaces "@me" with the current user.
+func (f MergeRequestFilter) withCurrentUser(name string) MergeRequestFilter {
+	if name == "" {
+		return f
+	}
+	for _, field := range []*string{&f.Author, &f.NotAuthor, &f.Assignee, &f.Reviewer, &f.ApprovedBy} {
+		if *field == "@me" {
+			*field = name
+		}
+	}
+	return f
+}
+
+// mergeRequestFilterChips describes each active term of a parsed filter
+// query.
+func mergeRequestFilterChips(filter MergeRequestFilter, search string) []string {
+	var chips []string
+	for _, label := range filter.Labels {
+		chips = appendFilterChip(chips, "label", label)
+	}
+	for _, label := range filter.NotLabels {
+		chips = appendFilterChip(chips, "-label", label)
+	}
+	chips = appendFilterChip(chips, "author", filter.Author)
+	chips = appendFilterChip(chips, "-author", filter.NotAuthor)
+	chips = appendFilterChip(chips, "assignee", filter.Assignee)
+	chips = appendFilterChip(chips, "reviewer", filter.Reviewer)
+	chips = appendFilterChip(chips, "approved-by", filter.ApprovedBy)
+	chips = appendFilterChip(chips, "target", filter.Target)
+	chips = appendFilterChip(chips, "draft", filter.Draft)
+	if search != "" {
+		chips = append(chips, strconv.Quote(search))
+	}
+	return chips
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMergeRequestFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		query  string
+		filter MergeRequestFilter
+		search string
+		err    string
+	}{
+		{name: "plain search", query: "fix login", search: "fix login"},
+		{
+			name:  "all terms",
+			query: `label:backend -label:"on hold" -author:@bot assignee:none reviewer:@me approved-by:carol target:main draft:No retry`,
+			filter: MergeRequestFilter{
+				Labels:     []string{"backend"},
+				NotLabels:  []string{"on hold"},
+				NotAuthor:  "bot",
+				Assignee:   "none",
+				Reviewer:   "@me",
+				ApprovedBy: "carol",
+				Target:     "main",
+				Draft:      "no",
+			},
+			search: "retry",
+		},
+		{name: "draft true", query: "draft:true", filter: MergeRequestFilter{Draft: "yes"}},
+		{name: "issue only key", query: "milestone:16.4", err: `unknown filter "milestone:"`},
+		{name: "excluded reviewer", query: "-reviewer:bob", err: "reviewer cannot be excluded"},
+		{name: "bad draft", query: "draft:maybe", err: `draft "maybe"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, search, err := parseMergeRequestFilter(tt.query)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("parseMergeRequestFilter(%q) error = %v want %q", tt.query, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMergeRequestFilter(%q) error = %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(filter, tt.filter) || search != tt.search {
+				t.Fatalf("parseMergeRequestFilter(%q) = %+v, %q want %+v, %q", tt.query, filter, search, tt.filter, tt.search)
+			}
+		})
+	}
+}
+
+func TestMergeRequestFilterChipsAndCurrentUser(t *testing.T) {
+	t.Parallel()
+
+	filter, search, err := parseMergeRequestFilter(`reviewer:@me -label:"on hold" draft:no crash`)
+	if err != nil {
+		t.Fatalf("parseMergeRequestFilter error = %v", err)
+	}
+	got := mergeRequestFilterChips(filter, search)
+	want := []string{`-label:"on hold"`, "reviewer:@me", "draft:no", `"crash"`}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("chips = %q want %q", got, want)
+	}
+	if resolved := filter.withCurrentUser("alice"); resolved.Reviewer != "alice" {
+		t.Fatalf("reviewer = %q want %q", resolved.Reviewer, "alice")
+	}
+}
@@ -0,0 +1,429 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type boardLoadedMsg struct {
+	requestID int
+	result    BoardResult
+	err       error
+}
+
+type boardCardMovedMsg struct {
+	boardID   int64
+	requestID int
+	issueIID  int64
+	column    string
+	previous  []BoardColumn
+	item      ListItem
+	err       error
+}
+
+var boardKeyHints = []string{
+	"h/l: column (past the edge: switch view)",
+	"j/k: card",
+	"< / >: move the issue to the previous or next list",
+	"m: move the issue to a list",
+	"enter: open issue",
+	"[: prev board",
+	"]: next board",
+	"r: refresh",
+}
+
+const (
+	boardColumnMinWidth = 24
+	boardIssuesPerList  = 20
+)
+
+func (m DashboardModel) handleBoardScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != BoardsView {
+		return m, nil, false
+	}
+
+	switch key {
+	case "j", "down":
+		if m.boardColumn < len(m.boardColumns) && m.boardRow < len(m.boardColumns[m.boardColumn].Items)-1 {
+			m.boardRow++
+		}
+		return m, nil, true
+	case "k", "up":
+		if m.boardRow > 0 {
+			m.boardRow--
+		}
+		return m, nil, true
+	case "h", "left":
+		if m.boardColumn == 0 {
+			return m, nil, false
+		}
+		m.boardColumn--
+		return m.clampBoardRow(), nil, true
+	case "l", "right":
+		if m.boardColumn >= len(m.boardColumns)-1 {
+			return m, nil, false
+		}
+		m.boardColumn++
+		return m.clampBoardRow(), nil, true
+	case "<", ">":
+		target := m.boardColumn + 1
+		if key == "<" {
+			target = m.boardColumn - 1
+		}
+		model, cmd := m.moveBoardCard(target)
+		return model, cmd, true
+	case "m":
+		model, cmd := m.openBoardMovePicker()
+		return model, cmd, true
+	case "enter":
+		if card, ok := m.selectedBoardCard(); ok {
+			model, cmd := m.jumpToItem("", card)
+			return model, cmd, true
+		}
+		return m, nil, true
+	case "[", "]":
+		if len(m.boards) < 2 {
+			m.notice = "this project has a single issue board"
+			return m, nil, true
+		}
+		step := 1
+		if key == "[" {
+			step = -1
+		}
+		index := max(0, slices.IndexFunc(m.boards, func(board IssueBoard) bool { return board.ID == m.boardID }))
+		n := len(m.boards)
+		m.boardID = m.boards[((index+step)%n+n)%n].ID
+		m.boardColumn, m.boardRow = 0, 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "r":
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+func (m DashboardModel) applyBoardLoaded(msg boardLoadedMsg) (tea.Model, tea.Cmd) {
+	if m.view != BoardsView || msg.requestID != m.requestID {
+		return m, nil
+	}
+	m.loading = false
+	m.items = nil
+	if msg.err != nil {
+		m.errorMessage = msg.err.Error()
+		m.boardColumns = nil
+		return m, nil
+	}
+	m.errorMessage = ""
+	m.boards = msg.result.Boards
+	m.boardID = msg.result.Board.ID
+	m.boardColumns = msg.result.Columns
+	m.boardColumn = min(m.boardColumn, max(0, len(m.boardColumns)-1))
+	return m.clampBoardRow(), nil
+}
+
+func (m DashboardModel) clampBoardRow() DashboardModel {
+	if m.boardColumn >= len(m.boardColumns) {
+		m.boardRow = 0
+		return m
+	}
+	m.boardRow = min(m.boardRow, max(0, len(m.boardColumns[m.boardColumn].Items)-1))
+	return m
+}
+
+func (m DashboardModel) selectedBoardCard() (ListItem, bool) {
+	if m.boardColumn >= len(m.boardColumns) {
+		return ListItem{}, false
+	}
+	items := m.boardColumns[m.boardColumn].Items
+	if m.boardRow < 0 || m.boardRow >= len(items) || items[m.boardRow].Issue == nil {
+		return ListItem{}, false
+	}
+	return items[m.boardRow], true
+}
+
+func (m DashboardModel) openBoardMovePicker() (tea.Model, tea.Cmd) {
+	card, ok := m.selectedBoardCard()
+	if !ok {
+		return m, nil
+	}
+	choices := make([]pickerOption, 0, len(m.boardColumns))
+	for i, column := range m.boardColumns {
+		if i == m.boardColumn {
+			continue
+		}
+		choices = append(choices, pickerOption{value: strconv.Itoa(i), label: column.Title, detail: boardListDetail(column)})
+	}
+	m.picker = newFuzzyPicker(fmt.Sprintf("Move #%d to", card.Issue.IID), choices, false, nil)
+	m.pickerPurpose = pickerBoardMove
+	m.pickerActive = true
+	return m, nil
+}
+
+func (m DashboardModel) applyBoardMovePicked(values []string) (tea.Model, tea.Cmd) {
+	if len(values) != 1 {
+		return m, nil
+	}
+	target, err := strconv.Atoi(values[0])
+	if err != nil {
+		return m, nil
+	}
+	return m.moveBoardCard(target)
+}
+
+// moveBoardCard moves the selected issue to the list at target straight
+// away, relabelling, closing or reopening it to match, and sends the update;
+// applyBoardCardMoved puts the board back if GitLab rejects it.
+func (m DashboardModel) moveBoardCard(target int) (tea.Model, tea.Cmd) {
+	card, ok := m.selectedBoardCard()
+	if !ok || target == m.boardColumn || target < 0 || target >= len(m.boardColumns) {
+		return m, nil
+	}
+	to := m.boardColumns[target]
+	update := boardMoveUpdate(m.boardColumns[m.boardColumn], to)
+
+	details := *card.Issue
+	details.Labels = slices.DeleteFunc(slices.Clone(details.Labels), func(label string) bool {
+		return slices.Contains(update.RemoveLabels, label)
+	})
+	for _, label := range update.AddLabels {
+		if !slices.Contains(details.Labels, label) {
+			details.Labels = append(details.Labels, label)
+		}
+	}
+	switch update.StateEvent {
+	case "close":
+		details.State = "closed"
+	case "reopen":
+		details.State = "opened"
+	}
+	moved := card
+	moved.Issue = &details
+
+	previous := cloneBoardColumns(m.boardColumns)
+	columns := cloneBoardColumns(m.boardColumns)
+	columns[m.boardColumn].Items = slices.Delete(columns[m.boardColumn].Items, m.boardRow, m.boardRow+1)
+	columns[target].Items = slices.Insert(columns[target].Items, 0, moved)
+	m.boardColumns = columns
+	m.boardColumn, m.boardRow = target, 0
+	m.notice = fmt.Sprintf("moving #%d to %s...", details.IID, to.Title)
+
+	provider := m.provider
+	issueIID := details.IID
+	boardID := m.boardID
+	requestID := m.requestID
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		item, err := provider.UpdateIssue(ctx, issueIID, update)
+		return boardCardMovedMsg{boardID: boardID, requestID: requestID, issueIID: issueIID, column: to.Title, previous: previous, item: item, err: err}
+	}
+}
+
+// applyBoardCardMoved confirms or rolls back a move. Once the board has been
+// switched or reloaded the columns are no longer the ones the move started
+// from, so only the outcome is reported.
+func (m DashboardModel) applyBoardCardMoved(msg boardCardMovedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if m.view != BoardsView {
+		return m, nil
+	}
+	if msg.boardID != m.boardID || msg.requestID != m.requestID {
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("move issue #%d to %s: %v", msg.issueIID, msg.column, msg.err)
+		}
+		return m, nil
+	}
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("move issue #%d to %s: %v", msg.issueIID, msg.column, msg.err)
+		m.boardColumns = msg.previous
+		return m.clampBoardRow(), nil
+	}
+	m.notice = fmt.Sprintf("moved #%d to %s", msg.issueIID, msg.column)
+	if msg.item.Issue == nil {
+		return m, nil
+	}
+	for _, column := range m.boardColumns {
+		for i, item := range column.Items {
+			if item.Issue != nil && item.Issue.IID == msg.issueIID {
+				column.Items[i] = msg.item
+			}
+		}
+	}
+	return m, nil
+}
+
+// boardMoveUpdate is the change that takes an issue from one board list to
+// another: the label of the list it leaves is removed, the label of the list
+// it joins added, and it is closed or reopened when the closed list is
+// involved.
+func boardMoveUpdate(from BoardColumn, to BoardColumn) IssueUpdate {
+	var update IssueUpdate
+	if from.Kind == BoardListLabel {
+		update.RemoveLabels = []string{from.Label}
+	}
+	if to.Kind == BoardListLabel {
+		update.AddLabels = []string{to.Label}
+	}
+	if to.Kind == BoardListClosed {
+		update.StateEvent = "close"
+	} else if from.Kind == BoardListClosed {
+		update.StateEvent = "reopen"
+	}
+	return update
+}
+
+func cloneBoardColumns(columns []BoardColumn) []BoardColumn {
+	cloned := slices.Clone(columns)
+	for i := range cloned {
+		cloned[i].Items = slices.Clone(cloned[i].Items)
+	}
+	return cloned
+}
+
+func (m DashboardModel) renderBoardBody(width int) []string {
+	return []string{
+		" " + m.renderBoardTabs(max(20, width-8)),
+		m.styles.dim.Render(fitLine(" h/l column • j/k card • </> or m move • enter open • [/] board • ? help", max(20, width-8))),
+		"",
+	}
+}
+
+func (m DashboardModel) renderBoardTabs(width int) string {
+	if len(m.boards) == 0 {
+		return m.styles.dim.Render("no issue boards")
+	}
+	parts := make([]string, 0, len(m.boards))
+	for _, board := range m.boards {
+		if board.ID == m.boardID {
+			parts = append(parts, m.styles.selectedRow.Render("["+board.Name+"]"))
+			continue
+		}
+		parts = append(parts, m.styles.dim.Render(board.Name))
+	}
+	return fitLine(strings.Join(parts, "  "), width)
+}
+
+// renderBoardColumns lays the lists of the board side by side, as many as
+// fit in width around the selected one.
+func (m DashboardModel) renderBoardColumns(width int, height int) []string {
+	if len(m.boardColumns) == 0 {
+		return []string{"  No issue boards"}
+	}
+	visible := max(1, min(len(m.boardColumns), (width+1)/(boardColumnMinWidth+1)))
+	start, end := visibleRange(len(m.boardColumns), m.boardColumn, visible)
+	columnWidth := (width - (end - start - 1)) / (end - start)
+	cardRows := max(1, (height-3)/2)
+
+	rendered := make([]string, 0, 2*(end-start))
+	for i := start; i < end; i++ {
+		if i > start {
+			rendered = append(rendered, " ")
+		}
+		rendered = append(rendered, m.renderBoardColumn(i, columnWidth, cardRows))
+	}
+	lines := strings.Split(lipgloss.JoinHorizontal(lipgloss.Top, rendered...), "\n")
+	if end-start < len(m.boardColumns) {
+		footer := fmt.Sprintf("  lists %d-%d of %d", start+1, end, len(m.boardColumns))
+		lines = append(lines, m.styles.dim.Render(fitLine(footer, width)))
+	}
+	return lines
+}
+
+func (m DashboardModel) renderBoardColumn(index int, width int, cardRows int) string {
+	column := m.boardColumns[index]
+	titleStyle := m.styles.secondary
+	selected := -1
+	if index == m.boardColumn {
+		titleStyle = m.styles.title
+		selected = m.boardRow
+	}
+	lines := []string{
+		titleStyle.Render(fitLine(column.Title+" "+boardListCount(column), width)),
+		m.styles.dim.Render(strings.Repeat("─", width)),
+	}
+	if len(column.Items) == 0 {
+		lines = append(lines, m.styles.dim.Render(fitLine("  no issues", width)))
+	}
+	start, end := visibleRange(len(column.Items), max(0, selected), cardRows)
+	for i := start; i < end; i++ {
+		card := column.Items[i]
+		prefix, style := "  ", m.styles.normalRow
+		if i == selected {
+			prefix, style = "› ", m.styles.selectedRow
+		}
+		lines = append(lines,
+			style.Render(fitLine(prefix+boardCardTitle(card), width)),
+			m.styles.dim.Render(fitLine("  "+boardCardMeta(card, column), width)),
+		)
+	}
+	if end-start < len(column.Items) {
+		lines = append(lines, m.styles.dim.Render(fitLine(fmt.Sprintf("  %d-%d of %d", start+1, end, len(column.Items)), width)))
+	}
+	return lipgloss.NewStyle().Width(width).Render(strings.Join(lines, "\n"))
+}
+
+func boardCardTitle(card ListItem) string {
+	if card.Issue == nil || card.Issue.IID <= 0 {
+		return card.Title
+	}
+	return fmt.Sprintf("#%d %s", card.Issue.IID, card.Title)
+}
+
+// boardCardMeta lists the assignees and the labels of a card other than the
+// label of its list.
+func boardCardMeta(card ListItem, column BoardColumn) string {
+	if card.Issue == nil {
+		return "-"
+	}
+	parts := []string{fallbackValue(strings.Join(card.Issue.Assignees, ", "), "unassigned")}
+	labels := slices.DeleteFunc(slices.Clone(card.Issue.Labels), func(label string) bool {
+		return label == column.Label
+	})
+	if len(labels) > 0 {
+		parts = append(parts, strings.Join(labels, ", "))
+	}
+	return strings.Join(parts, " • ")
+}
+
+// boardListCount is the number of issues shown in a list, such as "(20+)"
+// when it holds more than were loaded.
+func boardListCount(column BoardColumn) string {
+	count := strconv.Itoa(len(column.Items))
+	if column.HasMore {
+		count += "+"
+	}
+	return "(" + count + ")"
+}
+
+func boardListDetail(column BoardColumn) string {
+	switch column.Kind {
+	case BoardListOpen:
+		return "open issues in none of the label lists"
+	case BoardListClosed:
+		return "closes the issue"
+	default:
+		return "label " + column.Label
+	}
+}
+
+func (m DashboardModel) boardStatus() string {
+	for _, board := range m.boards {
+		if board.ID == m.boardID {
+			column := ""
+			if m.boardColumn < len(m.boardColumns) {
+				column = ", " + m.boardColumns[m.boardColumn].Title
+			}
+			return board.Name + column
+		}
+	}
+	return "no boards"
+}
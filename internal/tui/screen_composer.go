@@ -0,0 +1,289 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type composerKind int
+
+const (
+	composerIssueNote composerKind = iota
+	composerMergeRequestNote
+	composerThreadReply
+)
+
+// composerTarget identifies what a note will be posted to. discussionID is
+// only set for thread replies.
+type composerTarget struct {
+	kind         composerKind
+	iid          int64
+	discussionID string
+	title        string
+}
+
+type noteCreatedMsg struct {
+	target    composerTarget
+	err       error
+	requestID int
+}
+
+var composerKeyHints = []string{
+	"ctrl+s: post",
+	"tab: switch Write/Preview",
+	"ctrl+o: edit in $VISUAL/$EDITOR",
+	"esc: close (the draft is kept until posted)",
+}
+
+func newComposerInput() textarea.Model {
+	input := textarea.New()
+	input.Placeholder = "Write a comment in markdown..."
+	input.ShowLineNumbers = false
+	input.CharLimit = 0
+	input.Prompt = ""
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+func (m DashboardModel) openComposer(target composerTarget) (tea.Model, tea.Cmd) {
+	if m.composerTarget != target {
+		m.composerInput.Reset()
+		m.composerDraftPath = ""
+	}
+	m.composer = true
+	m.composerTarget = target
+	m.composerPreview = false
+	m.composerSending = false
+	m.composerErr = ""
+	m.focus = focusComposer
+	width, rows := m.composerViewport()
+	m.composerInput.SetWidth(width)
+	m.composerInput.SetHeight(rows)
+	return m, m.composerInput.Focus()
+}
+
+func (m DashboardModel) openIssueComposer() (tea.Model, tea.Cmd) {
+	item, ok := m.selectedIssueItem()
+	if ok && item.Epic != nil {
+		m.notice = "commenting on epics is not supported"
+		return m, nil
+	}
+	if !ok || item.Issue == nil || item.Issue.IID <= 0 {
+		return m, nil
+	}
+	return m.openComposer(composerTarget{
+		kind:  composerIssueNote,
+		iid:   item.Issue.IID,
+		title: fmt.Sprintf("Comment on #%d %s", item.Issue.IID, item.Title),
+	})
+}
+
+func (m DashboardModel) openMergeRequestComposer() (tea.Model, tea.Cmd) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok || item.MergeRequest.IID <= 0 {
+		return m, nil
+	}
+	return m.openComposer(composerTarget{
+		kind:  composerMergeRequestNote,
+		iid:   item.MergeRequest.IID,
+		title: fmt.Sprintf("Comment on !%d %s", item.MergeRequest.IID, item.Title),
+	})
+}
+
+func (m DashboardModel) openThreadReplyComposer() (tea.Model, tea.Cmd) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok {
+		return m, nil
+	}
+	thread, ok := m.selectedMergeRequestThread()
+	if !ok || thread.ID == "" {
+		return m, nil
+	}
+	title := fmt.Sprintf("Reply on !%d", item.MergeRequest.IID)
+	if position := mergeRequestThreadPosition(thread); position != "" {
+		title += " • " + position
+	}
+	if len(thread.Notes) > 0 {
+		title += fmt.Sprintf(" • to %s", fallbackValue(thread.Notes[0].Author, "-"))
+	}
+	return m.openComposer(composerTarget{
+		kind:         composerThreadReply,
+		iid:          item.MergeRequest.IID,
+		discussionID: thread.ID,
+		title:        title,
+	})
+}
+
+func (m DashboardModel) handleComposerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.focus = focusComposer
+	if m.composerSending {
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.composer = false
+		m.composerErr = ""
+		m.composerInput.Blur()
+		m.focus = focusDetail
+		return m, nil
+	case "ctrl+s":
+		return m.submitComposer()
+	case "ctrl+o":
+		return m.openComposerEditor()
+	case "tab":
+		m.composerPreview = !m.composerPreview
+		if m.composerPreview {
+			m.composerInput.Blur()
+			return m, nil
+		}
+		return m, m.composerInput.Focus()
+	}
+	if m.composerPreview {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.composerInput, cmd = m.composerInput.Update(msg)
+	return m, cmd
+}
+
+func (m DashboardModel) submitComposer() (tea.Model, tea.Cmd) {
+	body := strings.TrimSpace(m.composerInput.Value())
+	if body == "" {
+		m.composerErr = "comment is empty"
+		return m, nil
+	}
+	m.composerSending = true
+	m.composerErr = ""
+
+	provider := m.provider
+	target := m.composerTarget
+	requestID := m.requestID
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		var err error
+		switch target.kind {
+		case composerIssueNote:
+			err = provider.CreateIssueNote(ctx, target.iid, body)
+		case composerMergeRequestNote:
+			err = provider.CreateMergeRequestNote(ctx, target.iid, body)
+		case composerThreadReply:
+			err = provider.AddDiscussionNote(ctx, target.iid, target.discussionID, body)
+		}
+		return noteCreatedMsg{target: target, err: err, requestID: requestID}
+	}
+}
+
+// applyNoteCreated closes the composer and drops the cached notes of the
+// target so the Comments or Threads tab reloads with the new note in place.
+func (m DashboardModel) applyNoteCreated(msg noteCreatedMsg) (tea.Model, tea.Cmd) {
+	if msg.requestID != m.requestID || msg.target != m.composerTarget {
+		return m, nil
+	}
+	m.composerSending = false
+	if msg.err != nil {
+		m.composerErr = msg.err.Error()
+		path, err := writeEditorDraft(m.composerDraftPath, m.composerTarget.title, m.composerInput.Value())
+		if err == nil {
+			m.composerDraftPath = path
+			m.composerErr += "; draft saved to " + path
+		}
+		return m, nil
+	}
+
+	m = m.discardEditorDraft()
+	m.composer = false
+	m.composerErr = ""
+	m.composerInput.Reset()
+	m.composerInput.Blur()
+	m.focus = focusDetail
+
+	switch msg.target.kind {
+	case composerIssueNote:
+		delete(m.detailData, msg.target.iid)
+		m.invalidateMarkdownCacheForIssue(msg.target.iid)
+		m.clearDetailCache()
+		item, ok := m.selectedIssueItem()
+		if !m.issueDetail || !ok || item.Issue == nil || item.Issue.IID != msg.target.iid {
+			return m, nil
+		}
+		m.detailTab = issueDetailTabComments
+		m.detailScroll = 0
+		cmd := m.loadIssueDetailDataCmd()
+		if cmd != nil {
+			m.detailLoad = true
+			m.detailErr = ""
+		}
+		return m, tea.Batch(cmd, m.preloadMarkdownCmd())
+	default:
+		delete(m.mergeRequestDetailData, msg.target.iid)
+		item, ok := m.selectedMergeRequestItem()
+		if !m.mergeRequestDetail || !ok || item.MergeRequest.IID != msg.target.iid {
+			return m, nil
+		}
+		return m.switchMergeRequestDetailTab(mergeRequestDetailTabThreads)
+	}
+}
+
+func (m DashboardModel) composerViewport() (int, int) {
+	totalWidth := max(60, m.width-2)
+	contentHeight := max(8, m.height-5)
+	contentWidth := max(10, totalWidth-m.styles.panel.GetHorizontalFrameSize())
+	rows := max(3, contentHeight-m.styles.panel.GetVerticalFrameSize()-6)
+	return contentWidth, rows
+}
+
+func (m DashboardModel) renderComposerFullscreen(width int, height int) string {
+	contentWidth := max(10, width-m.styles.panel.GetHorizontalFrameSize())
+	_, rows := m.composerViewport()
+	active := 0
+	if m.composerPreview {
+		active = 1
+	}
+	lines := []string{
+		m.styles.header.Render(fitLine(m.composerTarget.title, contentWidth)),
+		m.styles.dim.Render(fitLine("ctrl+s post | tab write/preview | ctrl+o $EDITOR | esc close (draft kept)", contentWidth)),
+		m.renderDetailTabLabels([]string{"Write", "Preview"}, active),
+		"",
+	}
+
+	var body []string
+	if m.composerPreview {
+		value := strings.TrimSpace(m.composerInput.Value())
+		if value == "" {
+			body = []string{m.styles.dim.Render("Nothing to preview.")}
+		} else {
+			body = renderMarkdownParagraphs(value, contentWidth)
+		}
+	} else {
+		body = strings.Split(m.composerInput.View(), "\n")
+	}
+	lines = append(lines, fitHeight(body, rows)...)
+	for len(lines) < rows+4 {
+		lines = append(lines, "")
+	}
+
+	switch {
+	case m.composerSending:
+		lines = append(lines, "", m.styles.dim.Render("Posting..."))
+	case m.composerErr != "":
+		lines = append(lines, "", m.styles.statusFailed.Render(fitLine("Failed to post: "+m.composerErr, contentWidth)))
+	}
+
+	innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+	lines = fitHeight(lines, innerHeight)
+	return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+}
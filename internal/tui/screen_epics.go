@@ -0,0 +1,239 @@
+package tui
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type epicChildrenLoadedMsg struct {
+	epicID int64
+	items  []ListItem
+	err    error
+}
+
+var epicKeyHints = []string{
+	"space: expand or collapse the child epics and issues of an epic",
+	"enter: open epic details with overview, activities and comments, or open the issue",
+	"[: prev state",
+	"]: next state",
+	"r: refresh",
+}
+
+var epicStates = []EpicState{
+	EpicStateOpened,
+	EpicStateClosed,
+	EpicStateAll,
+}
+
+func (m DashboardModel) handleEpicScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != EpicsView {
+		return m, nil, false
+	}
+
+	switch key {
+	case "enter":
+		if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
+			return m, nil, true
+		}
+		item := m.items[m.selected]
+		if item.Epic == nil {
+			model, cmd := m.jumpToItem(item.ProjectPath, item)
+			return model, cmd, true
+		}
+		model, cmd := m.openIssueDetail()
+		return model, cmd, true
+	case "x", "m", "L", "M", "!":
+		if model, cmd, handled := m.handleIssueEditKey(key); handled {
+			return model, cmd, true
+		}
+		m.notice = "open the issue with enter to edit it in its project"
+		return m, nil, true
+	case " ":
+		model, cmd := m.toggleEpic()
+		return model, cmd, true
+	case "[", "]":
+		step := 1
+		if key == "[" {
+			step = -1
+		}
+		m.epicState = cycleEpicState(m.epicState, step)
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "r":
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+// toggleEpic collapses the selected epic, or loads its child epics and
+// issues to list them below it.
+func (m DashboardModel) toggleEpic() (tea.Model, tea.Cmd) {
+	if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
+		return m, nil
+	}
+	item := m.items[m.selected]
+	if item.Epic == nil {
+		return m, nil
+	}
+	if item.Epic.Expanded {
+		m.items = collapseEpic(m.items, m.selected)
+		return m, nil
+	}
+
+	epic := *item.Epic
+	provider := m.provider
+	m.notice = "loading " + epic.Reference + "..."
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+		items, err := provider.LoadEpicChildren(ctx, epic)
+		return epicChildrenLoadedMsg{epicID: epic.ID, items: items, err: err}
+	}
+}
+
+// applyEpicChildrenLoaded lists the children of an epic one level deeper
+// below it, unless it was expanded or left in the meantime.
+func (m DashboardModel) applyEpicChildrenLoaded(msg epicChildrenLoadedMsg) (tea.Model, tea.Cmd) {
+	if m.view != EpicsView {
+		return m, nil
+	}
+	m.notice = ""
+	if msg.err != nil {
+		m.errorMessage = msg.err.Error()
+		return m, nil
+	}
+	index := slices.IndexFunc(m.items, func(item ListItem) bool {
+		return item.Epic != nil && item.Epic.ID == msg.epicID
+	})
+	if index < 0 || m.items[index].Epic.Expanded {
+		return m, nil
+	}
+
+	items := slices.Clone(m.items)
+	parent := items[index]
+	epic := *parent.Epic
+	epic.Expanded = true
+	items[index].Epic = &epic
+	children := make([]ListItem, 0, len(msg.items))
+	for _, child := range msg.items {
+		child.Depth = parent.Depth + 1
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		m.notice = epic.Reference + " has no child epics or issues"
+	}
+	m.items = slices.Insert(items, index+1, children...)
+	return m, nil
+}
+
+// collapseEpic drops the rows below the epic at index that are nested deeper
+// than it.
+func collapseEpic(items []ListItem, index int) []ListItem {
+	end := index + 1
+	for end < len(items) && items[end].Depth > items[index].Depth {
+		end++
+	}
+	collapsed := slices.Delete(slices.Clone(items), index+1, end)
+	epic := *collapsed[index].Epic
+	epic.Expanded = false
+	collapsed[index].Epic = &epic
+	return collapsed
+}
+
+func (m DashboardModel) renderEpicBody(width int) []string {
+	location := " epics of " + path.Dir(m.ctx.ProjectPath) + " and its subgroups, recently updated first"
+	lines := []string{
+		" " + m.renderEpicTabs(max(20, width-8)),
+		m.styles.dim.Render(fitLine(location, max(20, width-8))),
+		"",
+	}
+	for _, hint := range epicKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+func (m DashboardModel) renderEpicTabs(width int) string {
+	parts := make([]string, 0, len(epicStates))
+	for _, state := range epicStates {
+		label := epicStateLabel(state)
+		if state == m.epicState {
+			parts = append(parts, m.styles.selectedRow.Render("["+label+"]"))
+			continue
+		}
+		parts = append(parts, m.styles.dim.Render(label))
+	}
+	return fitLine(strings.Join(parts, "  "), width)
+}
+
+func (m DashboardModel) shouldLoadMoreEpics() bool {
+	if m.view != EpicsView || m.loading || m.loadingMore || !m.epicHasNext {
+		return false
+	}
+	if len(m.items) == 0 {
+		return false
+	}
+	return m.selected >= len(m.items)-2
+}
+
+// epicTreeTitle draws a row of the epic tree, such as "  ▾ Checkout revamp"
+// for an expanded child epic.
+func epicTreeTitle(item ListItem) string {
+	marker := "• "
+	if item.Epic != nil {
+		marker = "▸ "
+		if item.Epic.Expanded {
+			marker = "▾ "
+		}
+	}
+	return epicTreeIndent(item) + marker + item.Title
+}
+
+func epicTreeIndent(item ListItem) string {
+	return strings.Repeat("  ", item.Depth)
+}
+
+func epicListMeta(item ListItem) string {
+	meta := fallbackValue(strings.TrimSpace(item.Subtitle), "-")
+	if item.Epic == nil {
+		return meta
+	}
+	switch start, due := item.Epic.StartDate, item.Epic.DueDate; {
+	case start != "" && due != "":
+		meta += " • " + start + " → " + due
+	case due != "":
+		meta += " • due " + due
+	case start != "":
+		meta += " • starts " + start
+	}
+	if item.Issue != nil && len(item.Issue.Labels) > 0 {
+		meta += " • " + strings.Join(item.Issue.Labels, ", ")
+	}
+	return meta
+}
+
+func epicStateLabel(state EpicState) string {
+	switch state {
+	case EpicStateClosed:
+		return "Closed"
+	case EpicStateAll:
+		return "All"
+	default:
+		return "Open"
+	}
+}
+
+func cycleEpicState(current EpicState, step int) EpicState {
+	index := max(0, slices.Index(epicStates, current))
+	n := len(epicStates)
+	return epicStates[((index+step)%n+n)%n]
+}
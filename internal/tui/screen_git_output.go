@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type mergeRequestCheckedOutMsg struct {
+	iid    int64
+	branch string
+	output string
+	err    error
+}
+
+// checkoutMergeRequest checks the selected merge request out in the local
+// repository. The provider refuses when the working tree is dirty, so there
+// is no confirmation step.
+func (m DashboardModel) checkoutMergeRequest() (tea.Model, tea.Cmd) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok || item.MergeRequest.IID <= 0 {
+		return m, nil
+	}
+	iid := item.MergeRequest.IID
+	branch := mergeRequestCheckoutBranch(*item.MergeRequest)
+	m.notice = fmt.Sprintf("checking out !%d as %s...", iid, branch)
+
+	provider := m.provider
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		output, err := provider.CheckoutMergeRequest(ctx, iid, branch)
+		return mergeRequestCheckedOutMsg{iid: iid, branch: branch, output: output, err: err}
+	}
+}
+
+// mergeRequestCheckoutBranch names the local branch a merge request is
+// checked out as. Merge requests from forks get an mr-<iid>- prefix: their
+// source branch is often main or master, and the fork's commits must not be
+// fast-forwarded onto the local branch of the same name.
+func mergeRequestCheckoutBranch(details MergeRequestDetails) string {
+	branch := strings.TrimSpace(details.SourceBranch)
+	switch {
+	case branch == "":
+		return fmt.Sprintf("mr-%d", details.IID)
+	case details.FromFork:
+		return fmt.Sprintf("mr-%d-%s", details.IID, branch)
+	}
+	return branch
+}
+
+func (m DashboardModel) applyMergeRequestCheckedOut(msg mergeRequestCheckedOutMsg) (tea.Model, tea.Cmd) {
+	title := fmt.Sprintf("Checked out !%d as %s", msg.iid, msg.branch)
+	m.notice = fmt.Sprintf("checked out %s", msg.branch)
+	if msg.err != nil {
+		title = fmt.Sprintf("Could not check out !%d", msg.iid)
+		m.notice = ""
+	}
+	return m.openGitOutput(title, msg.output, msg.err), nil
+}
+
+// openGitOutput shows what git printed, followed by the error if the command
+// failed, until the user dismisses it.
+func (m DashboardModel) openGitOutput(title string, output string, err error) DashboardModel {
+	m.gitOutputOpen = true
+	m.gitOutputTitle = title
+	m.gitOutput = strings.TrimRight(output, "\n")
+	m.gitOutputErr = ""
+	if err != nil {
+		m.gitOutputErr = err.Error()
+	}
+	m.gitOutputScroll = 0
+	return m
+}
+
+func (m DashboardModel) handleGitOutputKey(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "enter", "q":
+		m.gitOutputOpen = false
+	case "j", "down":
+		textWidth, rows := m.gitOutputViewport()
+		body := m.gitOutputLines(textWidth)
+		m.gitOutputScroll = min(m.gitOutputScroll+1, max(0, len(body)-rows))
+	case "k", "up":
+		m.gitOutputScroll = max(0, m.gitOutputScroll-1)
+	}
+	return m, nil
+}
+
+func (m DashboardModel) gitOutputViewport() (int, int) {
+	totalWidth := max(60, m.width-2)
+	contentHeight := max(8, m.height-5)
+	popupWidth := min(100, max(40, totalWidth-4))
+	textWidth := max(10, popupWidth-m.styles.helpPopup.GetHorizontalFrameSize())
+	rows := max(3, contentHeight-m.styles.helpPopup.GetVerticalFrameSize()-6)
+	return textWidth, rows
+}
+
+func (m DashboardModel) gitOutputLines(width int) []string {
+	if m.gitOutput == "" {
+		return nil
+	}
+	return wrapLines(strings.Split(m.gitOutput, "\n"), width)
+}
+
+func (m DashboardModel) renderGitOutput(width int, height int) string {
+	textWidth, rows := m.gitOutputViewport()
+	popupWidth := textWidth + m.styles.helpPopup.GetHorizontalFrameSize()
+	if m.gitOutputErr != "" {
+		rows = max(1, rows-2)
+	}
+
+	body := m.gitOutputLines(textWidth)
+	scroll := min(m.gitOutputScroll, max(0, len(body)-rows))
+	end := min(len(body), scroll+rows)
+
+	titleStyle := m.styles.header
+	if m.gitOutputErr != "" {
+		titleStyle = m.styles.statusFailed.Bold(true)
+	}
+	lines := []string{titleStyle.Render(fitLine(m.gitOutputTitle, textWidth)), ""}
+	for _, line := range body[scroll:end] {
+		lines = append(lines, m.styles.dim.Render(fitLine(line, textWidth)))
+	}
+	if m.gitOutputErr != "" {
+		lines = append(lines, "")
+		for _, line := range wrapLines([]string{"Error: " + m.gitOutputErr}, textWidth) {
+			lines = append(lines, m.styles.statusFailed.Render(line))
+		}
+	}
+	hint := "enter/esc: close"
+	if len(body) > rows {
+		hint = fmt.Sprintf("j/k: scroll (%d-%d of %d) | %s", scroll+1, end, len(body), hint)
+	}
+	lines = append(lines, "", m.styles.dim.Render(hint))
+
+	popup := m.styles.helpPopup.Width(popupWidth).Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, popup)
+}
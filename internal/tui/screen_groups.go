@@ -0,0 +1,229 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type groupProjectCheckedMsg struct {
+	projectPath string
+	provider    DataProvider
+	err         error
+}
+
+var groupKeyHints = []string{
+	"enter: open subgroup, project, issue or merge request",
+	"backspace/u: parent group",
+	"[: prev list",
+	"]: next list",
+	"r: refresh",
+}
+
+var groupScopes = []GroupScope{
+	GroupContents,
+	GroupIssues,
+	GroupMergeRequests,
+}
+
+func (m DashboardModel) handleGroupScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != GroupsView {
+		return m, nil, false
+	}
+
+	switch key {
+	case "enter":
+		if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
+			return m, nil, true
+		}
+		item := m.items[m.selected]
+		if item.Group == nil {
+			model, cmd := m.jumpToItem(item.ProjectPath, item)
+			return model, cmd, true
+		}
+		if item.Group.Kind == GroupEntryProject {
+			model, cmd := m.openGroupProject(item.Group.FullPath)
+			return model, cmd, true
+		}
+		m.groupPath = item.Group.FullPath
+		m.groupScope = GroupContents
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "backspace", "u":
+		if m.groupPath == "" {
+			return m, nil, true
+		}
+		m.groupReturnTo = m.groupPath
+		m.groupPath = parentGroupPath(m.groupPath)
+		m.groupScope = GroupContents
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "[", "]":
+		if m.groupPath == "" {
+			m.notice = "open a group to list its issues and merge requests"
+			return m, nil, true
+		}
+		step := 1
+		if key == "[" {
+			step = -1
+		}
+		m.groupScope = cycleGroupScope(m.groupScope, step)
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "r":
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+// openGroupProject makes a project picked in the groups view the active
+// project and shows its issues. Another project is first checked by listing
+// one of its issues, so one that cannot be read leaves the dashboard where it
+// is.
+func (m DashboardModel) openGroupProject(projectPath string) (tea.Model, tea.Cmd) {
+	if projectPath == m.ctx.ProjectPath {
+		return m.showGroupProject(projectPath)
+	}
+	m.notice = fmt.Sprintf("opening %s...", projectPath)
+	provider := m.provider.ForProject(projectPath)
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_, err := provider.LoadIssues(ctx, IssueQuery{State: IssueStateOpened, Page: 1, PerPage: 1})
+		return groupProjectCheckedMsg{projectPath: projectPath, provider: provider, err: err}
+	}
+}
+
+func (m DashboardModel) applyGroupProjectChecked(msg groupProjectCheckedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("open %s: %v", msg.projectPath, msg.err)
+		return m, nil
+	}
+	if m.view != GroupsView {
+		return m, nil
+	}
+	return m.useProject(msg.projectPath, msg.provider).showGroupProject(msg.projectPath)
+}
+
+func (m DashboardModel) showGroupProject(projectPath string) (tea.Model, tea.Cmd) {
+	m.view = IssuesView
+	m.selected = 0
+	model, cmd := m.startLoadCurrentView()
+	updated := model.(DashboardModel)
+	updated.notice = "switched to " + projectPath
+	return updated, cmd
+}
+
+// selectGroupReturn selects the group that was just left after moving up to
+// its parent.
+func (m DashboardModel) selectGroupReturn() DashboardModel {
+	index := slices.IndexFunc(m.items, func(item ListItem) bool {
+		return item.Group != nil && item.Group.FullPath == m.groupReturnTo
+	})
+	if index >= 0 {
+		m.selected = index
+	}
+	m.groupReturnTo = ""
+	return m
+}
+
+func (m DashboardModel) renderGroupBody(width int) []string {
+	location := " all top-level groups"
+	if m.groupPath != "" {
+		location = " group: " + strings.ReplaceAll(m.groupPath, "/", " / ")
+	}
+	lines := []string{
+		" " + m.renderGroupTabs(max(20, width-8)),
+		m.styles.dim.Render(fitLine(location, max(20, width-8))),
+	}
+	if m.groupScope != GroupContents {
+		lines = append(lines, m.styles.dim.Render(" sort: "+listSortLabel(m.groupListSort())))
+	}
+	lines = append(lines, "")
+	for _, hint := range groupKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+// groupListSort is the sort of the group issue or merge request list, which
+// follows the sort chosen for the project list of the same kind.
+func (m DashboardModel) groupListSort() ListSort {
+	if m.groupScope == GroupMergeRequests {
+		return m.mergeRequestSort
+	}
+	return m.issueSort
+}
+
+func (m DashboardModel) renderGroupTabs(width int) string {
+	parts := make([]string, 0, len(groupScopes))
+	for _, scope := range groupScopes {
+		label := groupScopeLabel(scope)
+		if scope == m.groupScope {
+			parts = append(parts, m.styles.selectedRow.Render("["+label+"]"))
+			continue
+		}
+		parts = append(parts, m.styles.dim.Render(label))
+	}
+	return fitLine(strings.Join(parts, "  "), width)
+}
+
+func (m DashboardModel) shouldLoadMoreGroups() bool {
+	if m.view != GroupsView || m.loading || m.loadingMore || !m.groupHasNext {
+		return false
+	}
+	if len(m.items) == 0 {
+		return false
+	}
+	return m.selected >= len(m.items)-2
+}
+
+func groupListMeta(item ListItem) string {
+	if item.Group == nil {
+		return myWorkListMeta(item)
+	}
+	parts := []string{"subgroup", item.Group.FullPath}
+	if item.Group.Kind == GroupEntryProject {
+		parts[0] = "project"
+	}
+	if description := strings.Join(strings.Fields(item.Group.Description), " "); description != "" {
+		parts = append(parts, description)
+	}
+	return strings.Join(parts, " • ")
+}
+
+func groupScopeLabel(scope GroupScope) string {
+	switch scope {
+	case GroupIssues:
+		return "Issues"
+	case GroupMergeRequests:
+		return "Merge requests"
+	default:
+		return "Subgroups and projects"
+	}
+}
+
+func parentGroupPath(path string) string {
+	index := strings.LastIndex(path, "/")
+	if index < 0 {
+		return ""
+	}
+	return path[:index]
+}
+
+func cycleGroupScope(current GroupScope, step int) GroupScope {
+	index := max(0, slices.Index(groupScopes, current))
+	n := len(groupScopes)
+	return groupScopes[((index+step)%n+n)%n]
+}
@@ -4,10 +4,16 @@ import tea "github.com/charmbracelet/bubbletea"
 
 var issueKeyHints = []string{
 	"enter: open issue details",
-	"/: search",
+	"n: new issue",
+	"/: filter, e.g. label:bug -label:wontfix assignee:@me milestone:\"16.4\" due:overdue text",
+	"F: filter panel with presets and saved filters",
+	"S: save the current filter",
 	"[: prev state",
 	"]: next state",
 	"o/c/a: open/closed/all",
+	"C (in details): write a comment",
+	"b (in details): create a branch, optionally with a draft merge request",
+	"[/] and enter (in details): pick and open a linked issue or merge request",
 }
 
 func (m DashboardModel) handleIssueScreenKey(key string) (tea.Model, tea.Cmd, bool) {
@@ -18,23 +24,32 @@ func (m DashboardModel) handleIssueScreenKey(key string) (tea.Model, tea.Cmd, bo
 	switch key {
 	case "enter":
 		if m.hasIssueDetailsSelection() {
-			m.issueDetail = true
-			m.detailScroll = 0
-			m.detailTab = issueDetailTabOverview
-			m.detailErr = ""
-			cmd := m.loadIssueDetailDataCmd()
-			if cmd != nil {
-				m.detailLoad = true
-				m.detailErr = ""
-			}
-			return m, tea.Batch(cmd, m.preloadMarkdownCmd()), true
+			model, cmd := m.openIssueDetail()
+			return model, cmd, true
 		}
+	case "n":
+		model, cmd := m.openIssueForm()
+		return model, cmd, true
+	case "x", "m", "L", "M", "!":
+		return m.handleIssueEditKey(key)
 	case "/":
 		m.searchMode = true
 		m.searchInput.Focus()
 		m.searchInput.SetValue(m.issueSearch)
 		m.searchInput.CursorEnd()
 		return m, nil, true
+	case "F":
+		model, cmd := m.openIssueFilterPanel()
+		return model, cmd, true
+	case "s", "O":
+		model, cmd := m.handleListSortKey(key)
+		return model, cmd, true
+	case "V":
+		model, cmd := m.openViewSave()
+		return model, cmd, true
+	case "S":
+		model, cmd := m.openIssueFilterSave()
+		return model, cmd, true
 	case "[":
 		m.issueState = prevIssueState(m.issueState)
 		m.selected = 0
@@ -69,11 +84,38 @@ func (m DashboardModel) renderIssueBody(width int) []string {
 	lines := []string{
 		" " + m.renderIssueTabs(max(20, width-8)),
 		" " + m.renderIssueSearch(max(20, width-8)),
-		m.styles.dim.Render(" sort: updated newest first"),
-		"",
 	}
+	if m.searchMode && m.filterErr != "" {
+		lines = append(lines, " "+m.styles.statusFailed.Render(fitLine(m.filterErr, max(20, width-8))))
+	}
+	lines = append(lines, m.styles.dim.Render(" sort: "+listSortLabel(m.issueSort)), "")
 	for _, hint := range issueKeyHints {
 		lines = append(lines, m.styles.dim.Render(" "+hint))
 	}
+	for _, hint := range listSortKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	for _, hint := range savedViewKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	for _, hint := range issueEditKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
 	return lines
 }
+
+// openIssueDetail shows the selected issue, or epic, in the issue detail view
+// on its overview tab.
+func (m DashboardModel) openIssueDetail() (tea.Model, tea.Cmd) {
+	m.issueDetail = true
+	m.detailScroll = 0
+	m.detailTab = issueDetailTabOverview
+	m.detailLink = 0
+	m.detailErr = ""
+	cmd := m.loadIssueDetailDataCmd()
+	if cmd != nil {
+		m.detailLoad = true
+		m.detailErr = ""
+	}
+	return m, tea.Batch(cmd, m.preloadMarkdownCmd())
+}
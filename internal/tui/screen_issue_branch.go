@@ -0,0 +1,190 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// issueBranchSlugLimit keeps generated branch names readable; GitLab cuts the
+// title part of its own suggestions in the same way.
+const issueBranchSlugLimit = 60
+
+// issueBranchConfirm is the pending branch in the create branch popup. The
+// branch name is editable and starts from the configured pattern.
+type issueBranchConfirm struct {
+	iid   int64
+	title string
+	draft bool
+}
+
+type issueBranchCreatedMsg struct {
+	iid    int64
+	result IssueBranchResult
+	err    error
+}
+
+func newIssueBranchInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.CharLimit = 255
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+// issueBranchName expands pattern for an issue. {title} becomes a lowercase
+// slug of the title; an empty pattern gives GitLab's "<iid>-<title>".
+func issueBranchName(pattern string, iid int64, title string, username string) string {
+	if strings.TrimSpace(pattern) == "" {
+		pattern = "{iid}-{title}"
+	}
+	name := strings.NewReplacer(
+		"{iid}", strconv.FormatInt(iid, 10),
+		"{title}", slugify(title, issueBranchSlugLimit),
+		"{username}", username,
+	).Replace(strings.TrimSpace(pattern))
+	return strings.Trim(name, "-/")
+}
+
+func slugify(text string, limit int) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	result := slug.String()
+	if len(result) > limit {
+		result = strings.TrimRight(result[:limit], "-")
+	}
+	return result
+}
+
+func (m DashboardModel) openIssueBranchConfirm() (tea.Model, tea.Cmd) {
+	item, ok := m.selectedIssueItem()
+	if ok && item.Epic != nil {
+		m.notice = "branches can only be created for issues"
+		return m, nil
+	}
+	if !ok || item.Issue == nil || item.Issue.IID <= 0 {
+		return m, nil
+	}
+	m.issueBranchConfirm = issueBranchConfirm{iid: item.Issue.IID, title: item.Title}
+	m.issueBranchConfirmOpen = true
+	m.issueBranchInput.Width = m.issueBranchInputWidth()
+	m.issueBranchInput.SetValue(issueBranchName(m.ctx.IssueBranchPattern, item.Issue.IID, item.Title, m.ctx.User.Username))
+	m.issueBranchInput.CursorEnd()
+	return m, m.issueBranchInput.Focus()
+}
+
+func (m DashboardModel) handleIssueBranchConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.issueBranchConfirmOpen = false
+		m.issueBranchInput.Blur()
+		return m, nil
+	case "tab":
+		m.issueBranchConfirm.draft = !m.issueBranchConfirm.draft
+		return m, nil
+	case "enter":
+		branch := strings.TrimSpace(m.issueBranchInput.Value())
+		if branch == "" {
+			return m, nil
+		}
+		m.issueBranchConfirmOpen = false
+		m.issueBranchInput.Blur()
+		return m.createIssueBranch(branch)
+	}
+	var cmd tea.Cmd
+	m.issueBranchInput, cmd = m.issueBranchInput.Update(msg)
+	return m, cmd
+}
+
+func (m DashboardModel) createIssueBranch(branch string) (tea.Model, tea.Cmd) {
+	confirm := m.issueBranchConfirm
+	m.notice = fmt.Sprintf("creating branch %s for #%d...", branch, confirm.iid)
+	req := IssueBranchRequest{
+		IssueIID:          confirm.iid,
+		IssueTitle:        confirm.title,
+		Branch:            branch,
+		DraftMergeRequest: confirm.draft,
+	}
+	provider := m.provider
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		result, err := provider.CreateIssueBranch(ctx, req)
+		return issueBranchCreatedMsg{iid: confirm.iid, result: result, err: err}
+	}
+}
+
+// applyIssueBranchCreated shows the git transcript of the local checkout. A
+// failure after the branch exists on GitLab is shown there too, so it is clear
+// what is left to do by hand.
+func (m DashboardModel) applyIssueBranchCreated(msg issueBranchCreatedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil && msg.result.Branch == "" {
+		m.errorMessage = fmt.Sprintf("create branch for #%d: %v", msg.iid, msg.err)
+		return m, nil
+	}
+
+	title := "Created branch " + msg.result.Branch
+	notice := fmt.Sprintf("created branch for #%d", msg.iid)
+	if msg.result.MergeRequest != nil && msg.result.MergeRequest.MergeRequest != nil {
+		title += fmt.Sprintf(" and draft merge request !%d", msg.result.MergeRequest.MergeRequest.IID)
+		notice += fmt.Sprintf(" with draft !%d", msg.result.MergeRequest.MergeRequest.IID)
+	}
+	if msg.err == nil {
+		m.notice = notice
+	}
+	return m.openGitOutput(title, msg.result.Output, msg.err), nil
+}
+
+func (m DashboardModel) issueBranchPopupWidth() (int, int) {
+	popupWidth := min(70, max(40, max(60, m.width-2)-4))
+	return popupWidth, max(10, popupWidth-m.styles.helpPopup.GetHorizontalFrameSize())
+}
+
+func (m DashboardModel) issueBranchInputWidth() int {
+	_, textWidth := m.issueBranchPopupWidth()
+	return max(1, textWidth-len("Branch: ")-1)
+}
+
+func (m DashboardModel) renderIssueBranchConfirm(width int, height int) string {
+	confirm := m.issueBranchConfirm
+	popupWidth, textWidth := m.issueBranchPopupWidth()
+
+	draft := "[ ]"
+	if confirm.draft {
+		draft = "[x]"
+	}
+	lines := []string{
+		m.styles.header.Render(fitLine(fmt.Sprintf("Create a branch for #%d?", confirm.iid), textWidth)),
+		fitLine(confirm.title, textWidth),
+		"",
+		fitLine("Branch: "+m.issueBranchInput.View(), textWidth),
+		m.styles.dim.Render(fitLine("created from the default branch, then checked out locally", textWidth)),
+		"",
+		fmt.Sprintf("%s Open a draft merge request (Closes #%d)  %s", draft, confirm.iid, m.styles.dim.Render("(tab)")),
+		"",
+		m.styles.dim.Render("enter: create | esc: cancel"),
+	}
+	popup := m.styles.helpPopup.Width(popupWidth).Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, popup)
+}
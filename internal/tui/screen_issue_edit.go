@@ -0,0 +1,277 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type issueUpdatedMsg struct {
+	projectPath string
+	issueIID    int64
+	previous    ListItem
+	item        ListItem
+	err         error
+}
+
+var issueEditKeyHints = []string{
+	"x: close/reopen issue",
+	"m: assign/unassign me",
+	"L: add/remove labels",
+	"M: set milestone",
+	"!: toggle confidential",
+}
+
+// handleIssueEditKey runs the quick edits shared by the issue list and the
+// issue detail view on the selected issue. Epics share the detail view but
+// not the issue API, so the keys only leave a notice for them.
+func (m DashboardModel) handleIssueEditKey(key string) (tea.Model, tea.Cmd, bool) {
+	item, ok := m.selectedIssueItem()
+	if ok && item.Epic != nil {
+		switch key {
+		case "x", "m", "L", "M", "!":
+			m.notice = "quick edits are not available for epics"
+			return m, nil, true
+		}
+		return m, nil, false
+	}
+	if !ok || item.Issue == nil || item.Issue.IID <= 0 {
+		return m, nil, false
+	}
+	details := item.Issue
+
+	switch key {
+	case "x":
+		event, state := "close", "closed"
+		if details.State == "closed" {
+			event, state = "reopen", "opened"
+		}
+		model, cmd := m.updateIssue(details.IID, IssueUpdate{StateEvent: event}, func(d *IssueDetails) {
+			d.State = state
+		})
+		return model, cmd, true
+	case "m":
+		user := m.ctx.User
+		if user.ID <= 0 {
+			m.errorMessage = "cannot assign: the current user is unknown"
+			return m, nil, true
+		}
+		name := user.Name
+		if name == "" {
+			name = user.Username
+		}
+		assigneeIDs := slices.Clone(details.AssigneeIDs)
+		assigned := slices.Contains(assigneeIDs, user.ID)
+		if assigned {
+			assigneeIDs = slices.DeleteFunc(assigneeIDs, func(id int64) bool { return id == user.ID })
+		} else {
+			assigneeIDs = append(assigneeIDs, user.ID)
+		}
+		model, cmd := m.updateIssue(details.IID, IssueUpdate{AssigneeIDs: &assigneeIDs}, func(d *IssueDetails) {
+			d.AssigneeIDs = assigneeIDs
+			if assigned {
+				d.Assignees = slices.DeleteFunc(d.Assignees, func(assignee string) bool { return assignee == name })
+				return
+			}
+			d.Assignees = append(d.Assignees, name)
+		})
+		return model, cmd, true
+	case "!":
+		confidential := !details.Confidential
+		model, cmd := m.updateIssue(details.IID, IssueUpdate{Confidential: &confidential}, func(d *IssueDetails) {
+			d.Confidential = confidential
+		})
+		return model, cmd, true
+	case "L":
+		model, cmd := m.openIssuePicker(pickerIssueLabels, details.IID)
+		return model, cmd, true
+	case "M":
+		model, cmd := m.openIssuePicker(pickerIssueMilestone, details.IID)
+		return model, cmd, true
+	}
+	return m, nil, false
+}
+
+// updateIssue applies change to the listed issue straight away and sends the
+// update; applyIssueUpdated restores the previous item if GitLab rejects it.
+func (m DashboardModel) updateIssue(issueIID int64, update IssueUpdate, change func(*IssueDetails)) (tea.Model, tea.Cmd) {
+	index := m.issueItemIndex(issueIID)
+	if index < 0 {
+		return m, nil
+	}
+	previous := m.items[index]
+	details := *previous.Issue
+	details.Assignees = slices.Clone(details.Assignees)
+	details.AssigneeIDs = slices.Clone(details.AssigneeIDs)
+	details.Labels = slices.Clone(details.Labels)
+	change(&details)
+	next := previous
+	next.Issue = &details
+	next.Subtitle = fmt.Sprintf("#%d • %s", details.IID, details.State)
+	m = m.replaceIssueItem(index, next)
+
+	provider := m.provider
+	projectPath := m.ctx.ProjectPath
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		item, err := provider.UpdateIssue(ctx, issueIID, update)
+		return issueUpdatedMsg{projectPath: projectPath, issueIID: issueIID, previous: previous, item: item, err: err}
+	}
+}
+
+// applyIssueUpdated confirms or rolls back a quick edit. An issue with the
+// same IID listed after switching project is another issue, so results for
+// the previous project leave the list alone.
+func (m DashboardModel) applyIssueUpdated(msg issueUpdatedMsg) (tea.Model, tea.Cmd) {
+	index := -1
+	if msg.projectPath == m.ctx.ProjectPath {
+		index = m.issueItemIndex(msg.issueIID)
+	}
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("update issue #%d: %v", msg.issueIID, msg.err)
+		if index >= 0 {
+			m = m.replaceIssueItem(index, msg.previous)
+		}
+		return m, nil
+	}
+	if index >= 0 && msg.item.Issue != nil {
+		m = m.replaceIssueItem(index, msg.item)
+	}
+	return m, nil
+}
+
+func (m DashboardModel) issueItemIndex(issueIID int64) int {
+	if m.view != IssuesView {
+		return -1
+	}
+	for i, item := range m.items {
+		if item.Issue != nil && item.Issue.IID == issueIID {
+			return i
+		}
+	}
+	return -1
+}
+
+// replaceIssueItem swaps in a copy of the item list so models still holding
+// the old slice keep their own view of it.
+func (m DashboardModel) replaceIssueItem(index int, item ListItem) DashboardModel {
+	items := slices.Clone(m.items)
+	items[index] = item
+	m.items = items
+	m.invalidateDetailCacheForIssue(item.Issue.IID)
+	return m
+}
+
+// openIssuePicker opens the label or milestone picker for an issue, loading
+// the project options first when they are not cached yet.
+func (m DashboardModel) openIssuePicker(purpose pickerPurpose, issueIID int64) (tea.Model, tea.Cmd) {
+	m.pickerIssueIID = issueIID
+	if !m.issueFormOptionsReady {
+		m.pickerPending = purpose
+		m.notice = "loading project labels and milestones"
+		return m, m.loadIssueFormOptionsCmd()
+	}
+	index := m.issueItemIndex(issueIID)
+	if index < 0 {
+		return m, nil
+	}
+	details := m.items[index].Issue
+
+	switch purpose {
+	case pickerIssueLabels:
+		choices := make([]pickerOption, 0, len(m.issueFormOptions.Labels)+len(details.Labels))
+		known := make(map[string]bool, len(m.issueFormOptions.Labels))
+		for _, label := range m.issueFormOptions.Labels {
+			known[label.Name] = true
+			choices = append(choices, pickerOption{value: label.Name, label: label.Name, detail: label.Description})
+		}
+		for _, label := range details.Labels {
+			if !known[label] {
+				choices = append(choices, pickerOption{value: label, label: label})
+			}
+		}
+		m.picker = newFuzzyPicker(fmt.Sprintf("Labels for #%d", issueIID), choices, true, details.Labels)
+	case pickerIssueMilestone:
+		choices := milestonePickerOptions(m.issueFormOptions.Milestones)
+		m.picker = newFuzzyPicker(fmt.Sprintf("Milestone for #%d", issueIID), choices, false, []string{strconv.FormatInt(details.MilestoneID, 10)})
+	default:
+		return m, nil
+	}
+	m.pickerPurpose = purpose
+	m.pickerPending = pickerNone
+	m.pickerActive = true
+	return m, nil
+}
+
+func (m DashboardModel) applyIssueLabelsPicked(issueIID int64, labels []string) (tea.Model, tea.Cmd) {
+	index := m.issueItemIndex(issueIID)
+	if index < 0 {
+		return m, nil
+	}
+	current := m.items[index].Issue.Labels
+	var added, removed []string
+	for _, label := range labels {
+		if !slices.Contains(current, label) {
+			added = append(added, label)
+		}
+	}
+	for _, label := range current {
+		if !slices.Contains(labels, label) {
+			removed = append(removed, label)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return m, nil
+	}
+	return m.updateIssue(issueIID, IssueUpdate{AddLabels: added, RemoveLabels: removed}, func(d *IssueDetails) {
+		d.Labels = slices.DeleteFunc(d.Labels, func(label string) bool { return slices.Contains(removed, label) })
+		d.Labels = append(d.Labels, added...)
+	})
+}
+
+func (m DashboardModel) applyIssueMilestonePicked(issueIID int64, values []string) (tea.Model, tea.Cmd) {
+	milestoneID := int64(0)
+	if ids := parsePickerIDs(values); len(ids) == 1 {
+		milestoneID = ids[0]
+	}
+	index := m.issueItemIndex(issueIID)
+	if index < 0 || m.items[index].Issue.MilestoneID == milestoneID {
+		return m, nil
+	}
+	title := ""
+	for _, milestone := range m.issueFormOptions.Milestones {
+		if milestone.ID == milestoneID {
+			title = milestone.Title
+		}
+	}
+	return m.updateIssue(issueIID, IssueUpdate{MilestoneID: &milestoneID}, func(d *IssueDetails) {
+		d.Milestone, d.MilestoneID = title, milestoneID
+	})
+}
+
+func milestonePickerOptions(milestones []ProjectMilestone) []pickerOption {
+	choices := make([]pickerOption, 0, len(milestones)+1)
+	choices = append(choices, pickerOption{value: "0", label: "No milestone"})
+	for _, milestone := range milestones {
+		detail := ""
+		if milestone.DueDate != "" {
+			detail = "due " + milestone.DueDate
+		}
+		choices = append(choices, pickerOption{value: strconv.FormatInt(milestone.ID, 10), label: milestone.Title, detail: detail})
+	}
+	return choices
+}
+
+func (m DashboardModel) renderPickerFullscreen(width int, height int) string {
+	contentWidth := max(10, width-m.styles.panel.GetHorizontalFrameSize())
+	lines := m.picker.view(contentWidth, m.styles)
+	innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+	lines = fitHeight(lines, innerHeight)
+	return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+}
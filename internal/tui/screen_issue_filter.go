@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type issueFilterSavedMsg struct {
+	filter SavedFilter
+	err    error
+}
+
+// applyIssueFilterQuery applies the query typed after "/". A query that does
+// not parse keeps the input open with the problem shown below it.
+func (m DashboardModel) applyIssueFilterQuery(query string) (tea.Model, tea.Cmd) {
+	if _, _, err := parseIssueFilter(query); err != nil {
+		m.filterErr = err.Error()
+		return m, nil
+	}
+	m.searchMode = false
+	m.focus = focusMain
+	m.searchInput.Blur()
+	m.filterErr = ""
+	m.issueSearch = query
+	m.selected = 0
+	return m.startLoadCurrentView()
+}
+
+// openIssueFilterPanel offers the preset and saved filters in a picker.
+func (m DashboardModel) openIssueFilterPanel() (tea.Model, tea.Cmd) {
+	choices := []pickerOption{{value: "", label: "No filter", detail: "show every issue in the state tab"}}
+	for _, preset := range issueFilterPresets {
+		choices = append(choices, pickerOption{value: preset.Query, label: preset.Name, detail: preset.Query})
+	}
+	if m.ctx.Filters != nil {
+		for _, saved := range m.ctx.Filters.IssueFilters() {
+			choices = append(choices, pickerOption{value: saved.Query, label: saved.Name, detail: "saved • " + saved.Query})
+		}
+	}
+	m.picker = newFuzzyPicker("Issue filters", choices, false, []string{m.issueSearch})
+	m.pickerPurpose = pickerIssueFilter
+	m.pickerActive = true
+	return m, nil
+}
+
+func (m DashboardModel) applyIssueFilterPicked(values []string) (tea.Model, tea.Cmd) {
+	if len(values) != 1 {
+		return m, nil
+	}
+	m.issueSearch = values[0]
+	m.selected = 0
+	return m.startLoadCurrentView()
+}
+
+func (m DashboardModel) openIssueFilterSave() (tea.Model, tea.Cmd) {
+	if m.ctx.Filters == nil {
+		m.notice = "saving filters is not available"
+		return m, nil
+	}
+	if strings.TrimSpace(m.issueSearch) == "" {
+		m.notice = "nothing to save; press / to filter the issues first"
+		return m, nil
+	}
+	return m.openNamePrompt(namePromptIssueFilter)
+}
+
+func (m DashboardModel) saveIssueFilter(name string) (tea.Model, tea.Cmd) {
+	filter := SavedFilter{Name: name, Query: m.issueSearch}
+	store := m.ctx.Filters
+	m.notice = fmt.Sprintf("saving filter %q...", name)
+	return m, func() tea.Msg {
+		return issueFilterSavedMsg{filter: filter, err: store.SaveIssueFilter(filter)}
+	}
+}
+
+func (m DashboardModel) applyIssueFilterSaved(msg issueFilterSavedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("save filter %q: %v", msg.filter.Name, msg.err)
+		return m, nil
+	}
+	m.notice = fmt.Sprintf("saved filter %q", msg.filter.Name)
+	return m, nil
+}
+
+func (m DashboardModel) renderIssueSearch(width int) string {
+	if m.searchMode {
+		return fitLine(m.searchInput.View(), width)
+	}
+	if strings.TrimSpace(m.issueSearch) == "" {
+		return fitLine("Filter: (press / to type one, F for presets and saved filters)", width)
+	}
+	filter, search, err := parseIssueFilter(m.issueSearch)
+	if err != nil {
+		return fitLine("Filter: "+m.issueSearch, width)
+	}
+	return m.renderFilterChips(issueFilterChips(filter, search), "(/ edit, F filters, S save)")
+}
+
+// renderFilterChips shows each term of the active filter as a chip.
+func (m DashboardModel) renderFilterChips(chips []string, hint string) string {
+	parts := make([]string, 0, len(chips)+1)
+	for _, chip := range chips {
+		parts = append(parts, m.styles.chip.Render(chip))
+	}
+	parts = append(parts, m.styles.dim.Render(hint))
+	return "Filter: " + strings.Join(parts, " ")
+}
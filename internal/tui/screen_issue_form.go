@@ -0,0 +1,604 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type issueFormField int
+
+const (
+	issueFormFieldTitle issueFormField = iota
+	issueFormFieldDescription
+	issueFormFieldLabels
+	issueFormFieldAssignees
+	issueFormFieldMilestone
+	issueFormFieldDueDate
+	issueFormFieldConfidential
+	issueFormFieldCount
+)
+
+type pickerPurpose int
+
+const (
+	pickerNone pickerPurpose = iota
+	pickerIssueFormLabels
+	pickerIssueFormAssignees
+	pickerIssueFormMilestone
+	pickerIssueLabels
+	pickerIssueMilestone
+	pickerMergeRequestFormTarget
+	pickerMergeRequestFormReviewers
+	pickerMergeRequestFormLabels
+	pickerSwitchProject
+	pickerIssueFilter
+	pickerMergeRequestFilter
+	pickerSavedView
+	pickerBoardMove
+)
+
+const (
+	issueFormDueDateLayout = "2006-01-02"
+	issueFormLabelWidth    = 16
+)
+
+type issueFormOptionsLoadedMsg struct {
+	options IssueFormOptions
+	err     error
+}
+
+type issueCreatedMsg struct {
+	item      ListItem
+	err       error
+	requestID int
+}
+
+type issueFormEditorFinishedMsg struct {
+	path string
+	err  error
+}
+
+var issueFormKeyHints = []string{
+	"tab/shift+tab: next/previous field",
+	"enter: open picker (labels, assignees, milestone)",
+	"space: toggle confidential",
+	"backspace: clear the focused picker field",
+	"ctrl+o: edit the description in $VISUAL/$EDITOR",
+	"ctrl+s: create issue",
+	"esc: close (the form is kept until created)",
+}
+
+func newIssueFormTitleInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.Placeholder = "Issue title"
+	input.CharLimit = 255
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+func newIssueFormDescriptionInput() textarea.Model {
+	input := textarea.New()
+	input.Placeholder = "Describe the issue in markdown..."
+	input.ShowLineNumbers = false
+	input.CharLimit = 0
+	input.Prompt = ""
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+func newIssueFormDueDateInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.Placeholder = "YYYY-MM-DD"
+	input.CharLimit = len(issueFormDueDateLayout)
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+func (m DashboardModel) openIssueForm() (tea.Model, tea.Cmd) {
+	m.issueForm = true
+	m.issueFormErr = ""
+	m.issueFormSending = false
+	m.focus = focusForm
+	width, rows := m.issueFormViewport()
+	m.issueFormTitle.Width = width - 1
+	m.issueFormDueDate.Width = width - 1
+	m.issueFormDescription.SetWidth(width)
+	m.issueFormDescription.SetHeight(rows)
+
+	focus := m.focusIssueFormField(m.issueFormField)
+	return m, tea.Batch(focus, m.loadIssueFormOptionsCmd())
+}
+
+// loadIssueFormOptionsCmd fetches the labels, members and milestones shared by
+// the issue form and the quick edit pickers, unless they are cached or already
+// on their way.
+func (m *DashboardModel) loadIssueFormOptionsCmd() tea.Cmd {
+	if m.issueFormOptionsReady || m.issueFormOptionsLoad {
+		return nil
+	}
+	m.issueFormOptionsLoad = true
+	provider := m.provider
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		options, err := provider.LoadIssueFormOptions(ctx)
+		return issueFormOptionsLoadedMsg{options: options, err: err}
+	}
+}
+
+// focusIssueFormField moves the cursor to field, blurring the text inputs that
+// lose focus so only one of them reacts to typing.
+func (m *DashboardModel) focusIssueFormField(field issueFormField) tea.Cmd {
+	m.issueFormField = field
+	m.issueFormTitle.Blur()
+	m.issueFormDescription.Blur()
+	m.issueFormDueDate.Blur()
+	switch field {
+	case issueFormFieldTitle:
+		return m.issueFormTitle.Focus()
+	case issueFormFieldDescription:
+		return m.issueFormDescription.Focus()
+	case issueFormFieldDueDate:
+		return m.issueFormDueDate.Focus()
+	}
+	return nil
+}
+
+func (m DashboardModel) closeIssueForm() DashboardModel {
+	m.issueForm = false
+	m.issueFormErr = ""
+	m.issueFormTitle.Blur()
+	m.issueFormDescription.Blur()
+	m.issueFormDueDate.Blur()
+	m.focus = focusMain
+	return m
+}
+
+func (m DashboardModel) resetIssueForm() DashboardModel {
+	m = m.discardIssueFormDraft()
+	m.issueFormField = issueFormFieldTitle
+	m.issueFormTitle.Reset()
+	m.issueFormDescription.Reset()
+	m.issueFormDueDate.Reset()
+	m.issueFormLabels = nil
+	m.issueFormAssignees = nil
+	m.issueFormMilestone = 0
+	m.issueFormConfidential = false
+	return m
+}
+
+func (m DashboardModel) handleIssueFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.focus = focusForm
+	if m.issueFormSending {
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		return m.closeIssueForm(), nil
+	case "ctrl+s":
+		return m.submitIssueForm()
+	case "tab":
+		cmd := m.focusIssueFormField((m.issueFormField + 1) % issueFormFieldCount)
+		return m, cmd
+	case "shift+tab":
+		cmd := m.focusIssueFormField((m.issueFormField + issueFormFieldCount - 1) % issueFormFieldCount)
+		return m, cmd
+	case "ctrl+o":
+		if m.issueFormField == issueFormFieldDescription {
+			return m.openIssueFormEditor()
+		}
+	}
+
+	var cmd tea.Cmd
+	switch m.issueFormField {
+	case issueFormFieldTitle:
+		if msg.String() == "enter" {
+			return m, m.focusIssueFormField(issueFormFieldDescription)
+		}
+		m.issueFormTitle, cmd = m.issueFormTitle.Update(msg)
+	case issueFormFieldDescription:
+		m.issueFormDescription, cmd = m.issueFormDescription.Update(msg)
+	case issueFormFieldDueDate:
+		if msg.String() == "enter" {
+			return m, m.focusIssueFormField(issueFormFieldConfidential)
+		}
+		m.issueFormDueDate, cmd = m.issueFormDueDate.Update(msg)
+	case issueFormFieldConfidential:
+		switch msg.String() {
+		case " ", "enter", "x":
+			m.issueFormConfidential = !m.issueFormConfidential
+		}
+	default:
+		switch msg.String() {
+		case "enter", " ":
+			return m.openIssueFormPicker()
+		case "backspace", "delete":
+			switch m.issueFormField {
+			case issueFormFieldLabels:
+				m.issueFormLabels = nil
+			case issueFormFieldAssignees:
+				m.issueFormAssignees = nil
+			case issueFormFieldMilestone:
+				m.issueFormMilestone = 0
+			}
+		}
+	}
+	return m, cmd
+}
+
+func (m DashboardModel) openIssueFormPicker() (tea.Model, tea.Cmd) {
+	if !m.issueFormOptionsReady {
+		if m.issueFormErr == "" {
+			m.issueFormErr = "project labels, members and milestones are still loading"
+		}
+		return m, nil
+	}
+	m.issueFormErr = ""
+	options := m.issueFormOptions
+
+	switch m.issueFormField {
+	case issueFormFieldLabels:
+		choices := make([]pickerOption, 0, len(options.Labels))
+		for _, label := range options.Labels {
+			choices = append(choices, pickerOption{value: label.Name, label: label.Name, detail: label.Description})
+		}
+		m.picker = newFuzzyPicker("Labels", choices, true, m.issueFormLabels)
+		m.pickerPurpose = pickerIssueFormLabels
+	case issueFormFieldAssignees:
+		choices := make([]pickerOption, 0, len(options.Members))
+		for _, member := range options.Members {
+			choices = append(choices, pickerOption{value: strconv.FormatInt(member.ID, 10), label: "@" + member.Username, detail: member.Name})
+		}
+		checked := make([]string, 0, len(m.issueFormAssignees))
+		for _, id := range m.issueFormAssignees {
+			checked = append(checked, strconv.FormatInt(id, 10))
+		}
+		m.picker = newFuzzyPicker("Assignees", choices, true, checked)
+		m.pickerPurpose = pickerIssueFormAssignees
+	case issueFormFieldMilestone:
+		m.picker = newFuzzyPicker("Milestone", milestonePickerOptions(options.Milestones), false, []string{strconv.FormatInt(m.issueFormMilestone, 10)})
+		m.pickerPurpose = pickerIssueFormMilestone
+	default:
+		return m, nil
+	}
+	m.pickerActive = true
+	return m, nil
+}
+
+func (m DashboardModel) handlePickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+	if m.pickerPurpose == pickerSwitchProject && msg.String() == "ctrl+f" {
+		return m.toggleFavoriteProject()
+	}
+	picker, result, cmd := m.picker.update(msg)
+	m.picker = picker
+	switch result {
+	case pickerCancelled:
+		m.pickerActive = false
+		return m, nil
+	case pickerDone:
+		m.pickerActive = false
+		return m.applyPickerSelection(m.pickerPurpose, picker.selected)
+	}
+	return m, cmd
+}
+
+func (m DashboardModel) applyPickerSelection(purpose pickerPurpose, values []string) (tea.Model, tea.Cmd) {
+	switch purpose {
+	case pickerIssueFormLabels:
+		m.issueFormLabels = values
+	case pickerIssueFormAssignees:
+		m.issueFormAssignees = parsePickerIDs(values)
+	case pickerIssueFormMilestone:
+		m.issueFormMilestone = 0
+		if ids := parsePickerIDs(values); len(ids) == 1 {
+			m.issueFormMilestone = ids[0]
+		}
+	case pickerIssueLabels:
+		return m.applyIssueLabelsPicked(m.pickerIssueIID, values)
+	case pickerIssueMilestone:
+		return m.applyIssueMilestonePicked(m.pickerIssueIID, values)
+	case pickerMergeRequestFormTarget:
+		if len(values) == 1 {
+			m.mergeRequestFormTarget = values[0]
+		}
+	case pickerMergeRequestFormReviewers:
+		m.mergeRequestFormReviewers = parsePickerIDs(values)
+	case pickerMergeRequestFormLabels:
+		m.mergeRequestFormLabels = values
+	case pickerSwitchProject:
+		return m.applyProjectPicked(values)
+	case pickerIssueFilter:
+		return m.applyIssueFilterPicked(values)
+	case pickerMergeRequestFilter:
+		return m.applyMergeRequestFilterPicked(values)
+	case pickerSavedView:
+		return m.applySavedViewPicked(values)
+	case pickerBoardMove:
+		return m.applyBoardMovePicked(values)
+	}
+	return m, nil
+}
+
+func parsePickerIDs(values []string) []int64 {
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || id <= 0 {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (m DashboardModel) submitIssueForm() (tea.Model, tea.Cmd) {
+	issue := NewIssue{
+		Title:        strings.TrimSpace(m.issueFormTitle.Value()),
+		Description:  strings.TrimSpace(m.issueFormDescription.Value()),
+		Labels:       m.issueFormLabels,
+		AssigneeIDs:  m.issueFormAssignees,
+		MilestoneID:  m.issueFormMilestone,
+		DueDate:      strings.TrimSpace(m.issueFormDueDate.Value()),
+		Confidential: m.issueFormConfidential,
+	}
+	if issue.Title == "" {
+		m.issueFormErr = "title is required"
+		return m, m.focusIssueFormField(issueFormFieldTitle)
+	}
+	if issue.DueDate != "" {
+		if _, err := time.Parse(issueFormDueDateLayout, issue.DueDate); err != nil {
+			m.issueFormErr = fmt.Sprintf("due date %q is not a valid YYYY-MM-DD date", issue.DueDate)
+			return m, m.focusIssueFormField(issueFormFieldDueDate)
+		}
+	}
+	m.issueFormSending = true
+	m.issueFormErr = ""
+
+	provider := m.provider
+	requestID := m.requestID
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		item, err := provider.CreateIssue(ctx, issue)
+		return issueCreatedMsg{item: item, err: err, requestID: requestID}
+	}
+}
+
+// applyIssueCreated puts the new issue at the top of the list when it matches
+// the current state filter, so it shows up without reloading every page.
+func (m DashboardModel) applyIssueCreated(msg issueCreatedMsg) (tea.Model, tea.Cmd) {
+	if !m.issueFormSending {
+		return m, nil
+	}
+	m.issueFormSending = false
+	if msg.err != nil {
+		m.issueFormErr = msg.err.Error()
+		return m, nil
+	}
+
+	m = m.resetIssueForm().closeIssueForm()
+	reference := msg.item.Title
+	if msg.item.Issue != nil {
+		reference = fmt.Sprintf("#%d", msg.item.Issue.IID)
+	}
+	m.notice = "created issue " + reference
+	if msg.requestID != m.requestID || m.view != IssuesView || m.issueState == IssueStateClosed {
+		return m, nil
+	}
+	// Only an unfiltered list sorted newest first is known to start with the
+	// new issue; any other list is reloaded so GitLab places it, if at all.
+	newestFirst := !m.issueSort.Ascending && (m.issueSort.Field == "" || m.issueSort.Field == SortUpdated || m.issueSort.Field == SortCreated)
+	if strings.TrimSpace(m.issueSearch) != "" || !newestFirst {
+		notice := m.notice
+		model, cmd := m.startLoadCurrentView()
+		updated := model.(DashboardModel)
+		updated.notice = notice
+		return updated, cmd
+	}
+
+	items := make([]ListItem, 0, len(m.items)+1)
+	items = append(items, msg.item)
+	for _, item := range m.items {
+		if item.ID != msg.item.ID {
+			items = append(items, item)
+		}
+	}
+	m.items = items
+	m.selected = 0
+	m.clearDetailCache()
+	return m, nil
+}
+
+func (m DashboardModel) applyIssueFormOptions(msg issueFormOptionsLoadedMsg) (tea.Model, tea.Cmd) {
+	m.issueFormOptionsLoad = false
+	pending := m.pickerPending
+	m.pickerPending = pickerNone
+	if msg.err != nil {
+		switch {
+		case m.issueForm:
+			m.issueFormErr = "load form options: " + msg.err.Error()
+		case m.mergeRequestForm:
+			m.mergeRequestFormErr = "load labels and members: " + msg.err.Error()
+		default:
+			m.errorMessage = "load project labels and milestones: " + msg.err.Error()
+		}
+		return m, nil
+	}
+	m.issueFormOptions = msg.options
+	m.issueFormOptionsReady = true
+	if strings.HasPrefix(m.issueFormErr, "project labels") {
+		m.issueFormErr = ""
+	}
+	if strings.HasPrefix(m.mergeRequestFormErr, "project labels") {
+		m.mergeRequestFormErr = ""
+	}
+	if pending != pickerNone && !m.issueForm && !m.mergeRequestForm {
+		m.notice = ""
+		return m.openIssuePicker(pending, m.pickerIssueIID)
+	}
+	return m, nil
+}
+
+func (m DashboardModel) openIssueFormEditor() (tea.Model, tea.Cmd) {
+	reference := "New issue: " + fallbackValue(strings.TrimSpace(m.issueFormTitle.Value()), "untitled")
+	path, err := writeEditorDraft(m.issueFormDraftPath, reference, m.issueFormDescription.Value())
+	if err != nil {
+		m.issueFormErr = err.Error()
+		return m, nil
+	}
+	m.issueFormDraftPath = path
+	cmd, err := editorCommand(path)
+	if err != nil {
+		m.issueFormErr = err.Error()
+		return m, nil
+	}
+	m.issueFormErr = ""
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return issueFormEditorFinishedMsg{path: path, err: err}
+	})
+}
+
+func (m DashboardModel) applyIssueFormEditorFinished(msg issueFormEditorFinishedMsg) (tea.Model, tea.Cmd) {
+	if !m.issueForm || msg.path != m.issueFormDraftPath {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.issueFormErr = fmt.Sprintf("editor exited with an error: %v", msg.err)
+		return m, nil
+	}
+	body, err := readEditorDraft(msg.path)
+	if err != nil {
+		m.issueFormErr = err.Error()
+		return m, nil
+	}
+	m.issueFormDescription.SetValue(body)
+	m.issueFormErr = ""
+	return m, m.focusIssueFormField(issueFormFieldDescription)
+}
+
+func (m DashboardModel) discardIssueFormDraft() DashboardModel {
+	if m.issueFormDraftPath != "" {
+		_ = os.Remove(m.issueFormDraftPath)
+		m.issueFormDraftPath = ""
+	}
+	return m
+}
+
+func (m DashboardModel) issueFormViewport() (int, int) {
+	totalWidth := max(60, m.width-2)
+	contentHeight := max(8, m.height-5)
+	contentWidth := max(10, totalWidth-m.styles.panel.GetHorizontalFrameSize()-issueFormLabelWidth)
+	rows := min(10, max(3, contentHeight-m.styles.panel.GetVerticalFrameSize()-14))
+	return contentWidth, rows
+}
+
+func (m DashboardModel) issueFormLabelNames() string {
+	if len(m.issueFormLabels) == 0 {
+		return ""
+	}
+	return strings.Join(m.issueFormLabels, ", ")
+}
+
+func (m DashboardModel) issueFormAssigneeNames() string {
+	names := make([]string, 0, len(m.issueFormAssignees))
+	for _, id := range m.issueFormAssignees {
+		name := fmt.Sprintf("user %d", id)
+		for _, member := range m.issueFormOptions.Members {
+			if member.ID == id {
+				name = "@" + member.Username
+				break
+			}
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (m DashboardModel) issueFormMilestoneName() string {
+	if m.issueFormMilestone <= 0 {
+		return ""
+	}
+	for _, milestone := range m.issueFormOptions.Milestones {
+		if milestone.ID == m.issueFormMilestone {
+			return milestone.Title
+		}
+	}
+	return fmt.Sprintf("milestone %d", m.issueFormMilestone)
+}
+
+func (m DashboardModel) renderIssueFormFullscreen(width int, height int) string {
+	contentWidth := max(10, width-m.styles.panel.GetHorizontalFrameSize())
+	fieldWidth := max(10, contentWidth-issueFormLabelWidth)
+	lines := []string{
+		m.styles.header.Render(fitLine("New issue in "+fallbackValue(m.ctx.ProjectPath, "-"), contentWidth)),
+		m.styles.dim.Render(fitLine("tab next field | enter pick | ctrl+o $EDITOR | ctrl+s create | esc close (form kept)", contentWidth)),
+		"",
+	}
+
+	if m.pickerActive {
+		lines = append(lines, m.picker.view(contentWidth, m.styles)...)
+	} else {
+		label := func(field issueFormField, name string) string {
+			if m.issueFormField == field {
+				return m.styles.selectedRow.Render(padToWidth("› "+name, issueFormLabelWidth))
+			}
+			return m.styles.secondary.Render(padToWidth("  "+name, issueFormLabelWidth))
+		}
+		value := func(text string, placeholder string) string {
+			if text == "" {
+				return m.styles.dim.Render(placeholder)
+			}
+			return fitLine(text, fieldWidth)
+		}
+		confidential := "[ ] no"
+		if m.issueFormConfidential {
+			confidential = "[x] yes"
+		}
+
+		lines = append(lines, label(issueFormFieldTitle, "Title")+fitLine(m.issueFormTitle.View(), fieldWidth), "")
+		lines = append(lines, label(issueFormFieldDescription, "Description"))
+		for _, row := range strings.Split(m.issueFormDescription.View(), "\n") {
+			lines = append(lines, padToWidth("", issueFormLabelWidth)+row)
+		}
+		lines = append(lines,
+			"",
+			label(issueFormFieldLabels, "Labels")+value(m.issueFormLabelNames(), "none"),
+			label(issueFormFieldAssignees, "Assignees")+value(m.issueFormAssigneeNames(), "none"),
+			label(issueFormFieldMilestone, "Milestone")+value(m.issueFormMilestoneName(), "none"),
+			label(issueFormFieldDueDate, "Due date")+fitLine(m.issueFormDueDate.View(), fieldWidth),
+			label(issueFormFieldConfidential, "Confidential")+confidential,
+		)
+	}
+
+	switch {
+	case m.issueFormSending:
+		lines = append(lines, "", m.styles.dim.Render("Creating issue..."))
+	case m.issueFormErr != "":
+		lines = append(lines, "", m.styles.statusFailed.Render(fitLine(m.issueFormErr, contentWidth)))
+	case m.issueFormOptionsLoad:
+		lines = append(lines, "", m.styles.dim.Render(m.spinner.View()+" Loading labels, members and milestones..."))
+	}
+
+	innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+	lines = fitHeight(lines, innerHeight)
+	return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+}
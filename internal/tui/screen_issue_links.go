@@ -0,0 +1,102 @@
+package tui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// handleIssueLinkKey moves through the linked issues and merge requests in
+// the overview of the issue detail view with "[" and "]", and opens the
+// selected one with "enter".
+func (m DashboardModel) handleIssueLinkKey(key string) (tea.Model, tea.Cmd, bool) {
+	if key != "[" && key != "]" && key != "enter" {
+		return m, nil, false
+	}
+	if m.detailTab != issueDetailTabOverview {
+		return m, nil, true
+	}
+	links := m.selectedIssueLinks()
+	if len(links) == 0 {
+		return m, nil, true
+	}
+
+	switch key {
+	case "[":
+		m.detailLink = max(0, m.detailLink-1)
+	case "]":
+		m.detailLink = min(m.detailLink+1, len(links)-1)
+	case "enter":
+		link := links[min(m.detailLink, len(links)-1)]
+		model, cmd := m.jumpToItem(link.Item.ProjectPath, link.Item)
+		return model, cmd, true
+	}
+	return m, nil, true
+}
+
+// selectedIssueLinks returns the links of the issue in the detail view, once
+// its detail data is loaded.
+func (m DashboardModel) selectedIssueLinks() []IssueLink {
+	item, ok := m.selectedIssueItem()
+	if !ok || item.Epic != nil {
+		return nil
+	}
+	return m.detailData[issueDetailKey(item)].Links
+}
+
+// issueLinkLines lists the links of the issue under a heading per section,
+// with the selected one marked.
+func (m DashboardModel) issueLinkLines(key int64, width int) []string {
+	data, ok := m.detailData[key]
+	if !ok {
+		status := "Loading linked issues and merge requests..."
+		if m.detailErr != "" {
+			status = "Linked issues and merge requests failed to load; press r to retry."
+		}
+		return wrapLines([]string{"", status}, width)
+	}
+	if data.LinksErr != "" {
+		return wrapLines([]string{"", "Linked issues and merge requests failed to load: " + data.LinksErr + "; press r to retry."}, width)
+	}
+	if len(data.Links) == 0 {
+		return wrapLines([]string{"", "No linked issues or merge requests."}, width)
+	}
+
+	var lines []string
+	heading := ""
+	for i, link := range data.Links {
+		if next := issueLinkHeading(link.Kind); next != heading {
+			heading = next
+			lines = append(lines, "", heading)
+		}
+		line := issueLinkRelation(link.Kind) + link.Item.Title + " • " + link.Item.Subtitle
+		if i == m.detailLink {
+			lines = append(lines, m.styles.selectedRow.Render(fitLine("› "+line, width)))
+			continue
+		}
+		lines = append(lines, fitLine("  "+line, width))
+	}
+	return lines
+}
+
+func issueLinkHeading(kind IssueLinkKind) string {
+	switch kind {
+	case IssueLinkClosedBy:
+		return "Closed by merge requests:"
+	case IssueLinkRelatedToMR:
+		return "Related merge requests:"
+	default:
+		return "Linked issues:"
+	}
+}
+
+// issueLinkRelation describes how a linked issue relates to the issue, such
+// as "blocks: ".
+func issueLinkRelation(kind IssueLinkKind) string {
+	switch kind {
+	case IssueLinkRelatesTo:
+		return "relates to: "
+	case IssueLinkBlocks:
+		return "blocks: "
+	case IssueLinkBlockedBy:
+		return "is blocked by: "
+	default:
+		return ""
+	}
+}
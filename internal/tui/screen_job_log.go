@@ -0,0 +1,355 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	jobLogPollInterval = 2 * time.Second
+	jobLogMaxRetryWait = 30 * time.Second
+)
+
+type jobTraceLoadedMsg struct {
+	jobID     int64
+	trace     JobTrace
+	err       error
+	requestID int
+}
+
+type jobTracePollMsg struct {
+	jobID     int64
+	requestID int
+}
+
+var jobLogKeyHints = []string{
+	"enter/space: fold or unfold section",
+	"[/]: previous/next section",
+	"c/e: collapse/expand all sections",
+	"g/G: top/bottom (G follows new output)",
+	"f: toggle follow",
+	"r: reload log",
+}
+
+func (m DashboardModel) openJobLog(job PipelineJob) (tea.Model, tea.Cmd) {
+	m.jobLog = true
+	m.jobLogJob = job
+	m.jobLogContent = ""
+	m.jobLogDoc = jobLogDocument{}
+	m.jobLogOffset = 0
+	m.jobLogStatus = job.Status
+	m.jobLogComplete = false
+	m.jobLogScroll = 0
+	m.jobLogFollow = true
+	m.jobLogFolds = make(map[int]bool)
+	m.jobLogSection = -1
+	m.jobLogErr = ""
+	m.jobLogFailures = 0
+	m.jobLogLoad = true
+	m.jobLogRequest++
+	m.clearJobLogCache()
+	return m, m.loadJobTraceCmd()
+}
+
+func (m DashboardModel) handleJobLogKey(key string) (tea.Model, tea.Cmd) {
+	_, bodyRows := m.jobLogViewport()
+	switch key {
+	case "esc":
+		m.jobLog = false
+		m.jobLogLoad = false
+		m.jobLogErr = ""
+		m.jobLogRequest++
+		m.clearJobLogCache()
+		return m, nil
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "j", "down":
+		m.jobLogScroll = m.clampJobLogScroll(m.currentJobLogScroll() + 1)
+		m.jobLogFollow = false
+	case "k", "up":
+		m.jobLogScroll = m.clampJobLogScroll(m.currentJobLogScroll() - 1)
+		m.jobLogFollow = false
+	case "pgdown":
+		m.jobLogScroll = m.clampJobLogScroll(m.currentJobLogScroll() + bodyRows)
+		m.jobLogFollow = false
+	case "pgup":
+		m.jobLogScroll = m.clampJobLogScroll(m.currentJobLogScroll() - bodyRows)
+		m.jobLogFollow = false
+	case "g", "home":
+		m.jobLogScroll = 0
+		m.jobLogFollow = false
+	case "G", "end":
+		m.jobLogScroll = m.jobLogMaxScroll()
+		m.jobLogFollow = true
+	case "f":
+		m.jobLogFollow = !m.jobLogFollow
+		m.jobLogScroll = m.currentJobLogScroll()
+	case "]":
+		return m.selectJobLogSection(1), nil
+	case "[":
+		return m.selectJobLogSection(-1), nil
+	case "enter", " ":
+		if m.jobLogSection < 0 || m.jobLogSection >= len(m.jobLogDoc.sections) {
+			m = m.selectJobLogSection(1)
+		}
+		if m.jobLogSection >= 0 {
+			m.jobLogScroll = m.currentJobLogScroll()
+			m.jobLogFollow = false
+			m.jobLogFolds[m.jobLogSection] = !m.jobLogDoc.sectionCollapsed(m.jobLogSection, m.jobLogFolds)
+			m.clearJobLogCache()
+			m.jobLogScroll = m.clampJobLogScroll(m.jobLogScroll)
+		}
+	case "c", "e":
+		for i := range m.jobLogDoc.sections {
+			m.jobLogFolds[i] = key == "c"
+		}
+		m.clearJobLogCache()
+		m.jobLogScroll = m.clampJobLogScroll(m.jobLogScroll)
+	case "?":
+		m.showHelp = true
+	case "r":
+		return m.openJobLog(m.jobLogJob)
+	}
+	return m, nil
+}
+
+// selectJobLogSection moves the section cursor to the next visible header in
+// the given direction, starting from the top of the viewport when nothing is
+// selected yet, and scrolls that header into view.
+func (m DashboardModel) selectJobLogSection(direction int) DashboardModel {
+	width, bodyRows := m.jobLogViewport()
+	view := m.jobLogView(width)
+	anchor := m.currentJobLogScroll()
+	if m.jobLogSection >= 0 && m.jobLogSection < len(view.headerRows) && view.headerRows[m.jobLogSection] >= 0 {
+		anchor = view.headerRows[m.jobLogSection] + direction
+	} else if direction < 0 {
+		anchor = min(len(view.lines)-1, anchor+bodyRows-1)
+	}
+
+	best := -1
+	for index, row := range view.headerRows {
+		if row < 0 {
+			continue
+		}
+		if direction > 0 && row >= anchor && (best < 0 || row < view.headerRows[best]) {
+			best = index
+		}
+		if direction < 0 && row <= anchor && (best < 0 || row > view.headerRows[best]) {
+			best = index
+		}
+	}
+	if best < 0 {
+		return m
+	}
+
+	m.jobLogSection = best
+	m.jobLogFollow = false
+	m.clearJobLogCache()
+	row := view.headerRows[best]
+	scroll := m.currentJobLogScroll()
+	if row < scroll || row >= scroll+bodyRows {
+		scroll = row - bodyRows/3
+	}
+	m.jobLogScroll = m.clampJobLogScroll(scroll)
+	return m
+}
+
+func (m DashboardModel) loadJobTraceCmd() tea.Cmd {
+	if m.jobLogJob.ID <= 0 {
+		return nil
+	}
+	provider := m.provider
+	jobID := m.jobLogJob.ID
+	offset := m.jobLogOffset
+	requestID := m.jobLogRequest
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		trace, err := provider.LoadJobTrace(ctx, jobID, offset)
+		return jobTraceLoadedMsg{jobID: jobID, trace: trace, err: err, requestID: requestID}
+	}
+}
+
+func (m DashboardModel) applyJobTrace(msg jobTraceLoadedMsg) (tea.Model, tea.Cmd) {
+	if !m.jobLog || msg.requestID != m.jobLogRequest || msg.jobID != m.jobLogJob.ID {
+		return m, nil
+	}
+	m.jobLogLoad = false
+	if msg.err != nil {
+		// A failed poll keeps the log tailing: while the job has not been seen
+		// to finish, try again after a wait that doubles with every failure.
+		m.jobLogErr = msg.err.Error()
+		m.jobLogFailures++
+		if m.jobLogComplete {
+			return m, nil
+		}
+		return m, jobTracePollCmd(msg.jobID, msg.requestID, m.jobLogRetryWait())
+	}
+	m.jobLogErr = ""
+	m.jobLogFailures = 0
+
+	trace := msg.trace
+	if trace.Reset {
+		m.jobLogContent = trace.Content
+		m.jobLogFolds = make(map[int]bool)
+		m.jobLogSection = -1
+	} else {
+		m.jobLogContent += trace.Content
+	}
+	if trace.Reset || trace.Content != "" {
+		m.jobLogDoc = parseJobLog(m.jobLogContent)
+		m.clearJobLogCache()
+	}
+	m.jobLogOffset = trace.Offset
+	if trace.Status != "" {
+		m.jobLogStatus = trace.Status
+	}
+	m.jobLogComplete = trace.Complete
+	if m.jobLogComplete {
+		return m, nil
+	}
+
+	return m, jobTracePollCmd(msg.jobID, msg.requestID, jobLogPollInterval)
+}
+
+func jobTracePollCmd(jobID int64, requestID int, wait time.Duration) tea.Cmd {
+	return tea.Tick(wait, func(time.Time) tea.Msg {
+		return jobTracePollMsg{jobID: jobID, requestID: requestID}
+	})
+}
+
+// jobLogRetryWait is how long to wait before polling again after
+// jobLogFailures failed polls in a row.
+func (m DashboardModel) jobLogRetryWait() time.Duration {
+	wait := jobLogPollInterval
+	for i := 1; i < m.jobLogFailures && wait < jobLogMaxRetryWait; i++ {
+		wait *= 2
+	}
+	if wait > jobLogMaxRetryWait {
+		return jobLogMaxRetryWait
+	}
+	return wait
+}
+
+func (m DashboardModel) jobLogViewport() (int, int) {
+	totalWidth := max(60, m.width-2)
+	contentHeight := max(8, m.height-5)
+	contentWidth := max(8, totalWidth-6-2)
+	bodyRows := max(1, contentHeight-m.styles.panel.GetVerticalFrameSize()-5)
+	return contentWidth, bodyRows
+}
+
+func (m DashboardModel) jobLogView(width int) jobLogView {
+	key := fmt.Sprintf("%d:%d", width, m.jobLogSection)
+	if cached, ok := m.jobLogCache[key]; ok {
+		return cached
+	}
+	view := m.jobLogDoc.render(width, m.jobLogFolds, m.jobLogSection, m.styles)
+	if m.jobLogCache != nil {
+		m.jobLogCache[key] = view
+	}
+	return view
+}
+
+func (m DashboardModel) clearJobLogCache() {
+	for key := range m.jobLogCache {
+		delete(m.jobLogCache, key)
+	}
+}
+
+func (m DashboardModel) jobLogMaxScroll() int {
+	width, bodyRows := m.jobLogViewport()
+	return max(0, len(m.jobLogView(width).lines)-bodyRows)
+}
+
+// currentJobLogScroll is the effective top row, which sticks to the bottom of
+// the log while following a running job.
+func (m DashboardModel) currentJobLogScroll() int {
+	if m.jobLogFollow {
+		return m.jobLogMaxScroll()
+	}
+	return m.clampJobLogScroll(m.jobLogScroll)
+}
+
+func (m DashboardModel) clampJobLogScroll(next int) int {
+	maxScroll := m.jobLogMaxScroll()
+	if next < 0 {
+		return 0
+	}
+	if next > maxScroll {
+		return maxScroll
+	}
+	return next
+}
+
+func (m DashboardModel) renderJobLogFullscreen(width int, height int) string {
+	contentWidth := max(10, width-6)
+	viewportWidth := max(8, contentWidth-2)
+	title := fmt.Sprintf("Job Log: %s", fallbackValue(m.jobLogJob.Name, "-"))
+	if m.jobLogJob.ID > 0 {
+		title += fmt.Sprintf(" (#%d)", m.jobLogJob.ID)
+	}
+
+	state := []string{m.pipelineStatusStyle(m.jobLogStatus).Render(pipelineStatusLabel(m.jobLogStatus))}
+	if stage := strings.TrimSpace(m.jobLogJob.Stage); stage != "" {
+		state = append(state, "stage "+stage)
+	}
+	if !m.jobLogComplete && m.jobLogErr == "" && !m.jobLogLoad {
+		state = append(state, fmt.Sprintf("polling every %s", jobLogPollInterval))
+	}
+	if !m.jobLogComplete && m.jobLogErr != "" {
+		state = append(state, fmt.Sprintf("poll failed: %s; retrying in %s", m.jobLogErr, m.jobLogRetryWait()))
+	}
+	if m.jobLogFollow {
+		state = append(state, "following")
+	}
+
+	lines := []string{
+		m.styles.header.Render(fitLine(title, contentWidth)),
+		m.styles.dim.Render(fitLine("Esc return | j/k scroll | [/] section | enter fold | c/e collapse/expand | f follow | r reload", contentWidth)),
+		fitLine(strings.Join(state, m.styles.dim.Render(" | ")), contentWidth),
+		"",
+	}
+
+	var message string
+	switch {
+	case m.jobLogErr != "":
+		message = fmt.Sprintf("Failed to load job log: %s. Press r to retry.", m.jobLogErr)
+	case m.jobLogLoad && len(m.jobLogDoc.lines) == 0:
+		message = "Loading job log..."
+	case len(m.jobLogDoc.lines) == 0:
+		message = "Job log is empty."
+	}
+	view := m.jobLogView(viewportWidth)
+	if len(view.lines) == 0 {
+		lines = append(lines, wrapLines([]string{message}, contentWidth)...)
+		innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+		lines = fitHeight(lines, innerHeight)
+		return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+	}
+
+	bodyRows := max(1, height-len(lines)-3)
+	maxScroll := max(0, len(view.lines)-bodyRows)
+	start := m.jobLogScroll
+	if m.jobLogFollow || start > maxScroll {
+		start = maxScroll
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := min(len(view.lines), start+bodyRows)
+	lines = append(lines, withVerticalScroll(view.lines[start:end], viewportWidth, start, bodyRows, len(view.lines))...)
+	footer := fmt.Sprintf("%d-%d of %d", start+1, end, len(view.lines))
+	if message != "" {
+		footer += " | " + message
+	}
+	lines = append(lines, m.styles.dim.Render(fitLine(footer, contentWidth)))
+
+	innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+	lines = fitHeight(lines, innerHeight)
+	return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+}
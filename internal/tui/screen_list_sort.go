@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type listSortSavedMsg struct {
+	err error
+}
+
+var listSortKeyHints = []string{
+	"s: sort by the next field",
+	"O: reverse the sort order",
+}
+
+var issueSortFields = []SortField{
+	SortUpdated,
+	SortCreated,
+	SortPriority,
+	SortDueDate,
+	SortMilestoneDue,
+	SortPopularity,
+	SortTitle,
+	SortLabelPriority,
+}
+
+// mergeRequestSortFields leaves out the due date, which merge requests do
+// not have.
+var mergeRequestSortFields = []SortField{
+	SortUpdated,
+	SortCreated,
+	SortPriority,
+	SortMilestoneDue,
+	SortPopularity,
+	SortTitle,
+	SortLabelPriority,
+}
+
+// handleListSortKey changes the sort of the issue or merge request list, "s"
+// moving to the next field and "O" reversing the order, and saves the choice
+// for the view.
+func (m DashboardModel) handleListSortKey(key string) (tea.Model, tea.Cmd) {
+	view := m.view
+	sort, fields := m.issueSort, issueSortFields
+	if view == MergeRequestsView {
+		sort, fields = m.mergeRequestSort, mergeRequestSortFields
+	}
+	if sort.Field == "" {
+		sort.Field = SortUpdated
+	}
+	if key == "s" {
+		sort.Field = cycleSortField(fields, sort.Field)
+	} else {
+		sort.Ascending = !sort.Ascending
+	}
+	if view == MergeRequestsView {
+		m.mergeRequestSort = sort
+	} else {
+		m.issueSort = sort
+	}
+	m.selected = 0
+
+	var save tea.Cmd
+	if store := m.ctx.Sorts; store != nil {
+		save = func() tea.Msg {
+			return listSortSavedMsg{err: store.SaveListSort(view, sort)}
+		}
+	}
+	model, cmd := m.startLoadCurrentView()
+	return model, tea.Batch(cmd, save)
+}
+
+func (m DashboardModel) applyListSortSaved(msg listSortSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.notice = fmt.Sprintf("sort order not saved: %v", msg.err)
+	}
+	return m, nil
+}
+
+// savedListSort returns the sort order saved for view, or the default.
+func savedListSort(store SortStore, view ViewMode) ListSort {
+	if store == nil {
+		return ListSort{}
+	}
+	sort, _ := store.ListSort(view)
+	return sort
+}
+
+func cycleSortField(fields []SortField, current SortField) SortField {
+	index := max(0, slices.Index(fields, current))
+	return fields[(index+1)%len(fields)]
+}
+
+func listSortLabel(sort ListSort) string {
+	return sortFieldLabel(sort.Field) + " " + sortDirectionLabel(sort)
+}
+
+func sortFieldLabel(field SortField) string {
+	switch field {
+	case SortCreated:
+		return "created"
+	case SortPriority:
+		return "priority"
+	case SortDueDate:
+		return "due date"
+	case SortMilestoneDue:
+		return "milestone due"
+	case SortPopularity:
+		return "popularity"
+	case SortTitle:
+		return "title"
+	case SortLabelPriority:
+		return "label priority"
+	default:
+		return "updated"
+	}
+}
+
+func sortDirectionLabel(sort ListSort) string {
+	switch sort.Field {
+	case SortUpdated, SortCreated, "":
+		if sort.Ascending {
+			return "oldest first"
+		}
+		return "newest first"
+	case SortDueDate, SortMilestoneDue:
+		if sort.Ascending {
+			return "earliest first"
+		}
+		return "latest first"
+	case SortTitle:
+		if sort.Ascending {
+			return "A to Z"
+		}
+		return "Z to A"
+	default:
+		if sort.Ascending {
+			return "ascending"
+		}
+		return "descending"
+	}
+}
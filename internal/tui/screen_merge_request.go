@@ -1,12 +1,51 @@
 package tui
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type mergeRequestDetailTab int
+
+const (
+	mergeRequestDetailTabOverview mergeRequestDetailTab = iota
+	mergeRequestDetailTabChanges
+	mergeRequestDetailTabThreads
+	mergeRequestDetailTabActivity
+)
+
+type mergeRequestDetailLoadedMsg struct {
+	mergeRequestIID int64
+	data            MergeRequestDetailData
+	err             error
+	requestID       int
+}
+
+type mergeRequestDiffsLoadedMsg struct {
+	mergeRequestIID int64
+	files           []MergeRequestDiffFile
+	err             error
+	requestID       int
+}
 
 var mergeRequestKeyHints = []string{
 	"enter: open merge request details",
+	"n: new merge request from the checked out branch",
+	"/: filter, e.g. reviewer:@me draft:no target:main label:backend -author:bot text",
+	"F: filter panel, e.g. needs my review or approved by me",
 	"[: prev state",
 	"]: next state",
 	"o/m/c/a: open/merged/closed/all",
+	"p (in details): open latest pipeline jobs",
+	"tab or d/c/t/a (in details): Detail/Changes/Threads/Activity tabs",
+	"]/[ (in changes): next/previous file",
+	"s (in changes): toggle side-by-side diff",
+	"C (in details): write a comment",
+	"]/[ then R (in threads): reply to the selected thread",
 }
 
 func (m DashboardModel) handleMergeRequestScreenKey(key string) (tea.Model, tea.Cmd, bool) {
@@ -19,7 +58,16 @@ func (m DashboardModel) handleMergeRequestScreenKey(key string) (tea.Model, tea.
 		if m.hasMergeRequestDetailsSelection() {
 			m.mergeRequestDetail = true
 			m.mergeRequestDetailScroll = 0
-			return m, nil, true
+			m.mergeRequestDetailTab = mergeRequestDetailTabOverview
+			m.mergeRequestDiffFile = 0
+			m.mergeRequestDiffErr = ""
+			m.mergeRequestThread = 0
+			m.mergeRequestDetailErr = ""
+			cmd := m.loadMergeRequestDetailDataCmd()
+			if cmd != nil {
+				m.mergeRequestDetailLoad = true
+			}
+			return m, cmd, true
 		}
 	case "[":
 		m.mergeRequestState = prevMergeRequestState(m.mergeRequestState)
@@ -51,6 +99,31 @@ func (m DashboardModel) handleMergeRequestScreenKey(key string) (tea.Model, tea.
 		m.selected = 0
 		model, cmd := m.startLoadCurrentView()
 		return model, cmd, true
+	case "n":
+		model, cmd := m.openMergeRequestForm()
+		return model, cmd, true
+	case "/":
+		m.searchMode = true
+		m.searchInput.Focus()
+		m.searchInput.SetValue(m.mergeRequestSearch)
+		m.searchInput.CursorEnd()
+		return m, nil, true
+	case "F":
+		model, cmd := m.openMergeRequestFilterPanel()
+		return model, cmd, true
+	case "s", "O":
+		model, cmd := m.handleListSortKey(key)
+		return model, cmd, true
+	case "V":
+		model, cmd := m.openViewSave()
+		return model, cmd, true
+	case "A", "U", "M", "B", "x":
+		return m.handleMergeRequestActionKey(key)
+	case " ":
+		if m.hasMergeRequestDetailsSelection() {
+			model, cmd := m.checkoutMergeRequest()
+			return model, cmd, true
+		}
 	}
 
 	return m, nil, false
@@ -59,11 +132,530 @@ func (m DashboardModel) handleMergeRequestScreenKey(key string) (tea.Model, tea.
 func (m DashboardModel) renderMergeRequestBody(width int) []string {
 	lines := []string{
 		" " + m.renderMergeRequestTabs(max(20, width-8)),
-		m.styles.dim.Render(" sort: updated newest first"),
-		"",
+		" " + m.renderMergeRequestSearch(max(20, width-8)),
 	}
+	if m.searchMode && m.filterErr != "" {
+		lines = append(lines, " "+m.styles.statusFailed.Render(fitLine(m.filterErr, max(20, width-8))))
+	}
+	lines = append(lines, m.styles.dim.Render(" sort: "+listSortLabel(m.mergeRequestSort)), "")
 	for _, hint := range mergeRequestKeyHints {
 		lines = append(lines, m.styles.dim.Render(" "+hint))
 	}
+	for _, hint := range listSortKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	for _, hint := range savedViewKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	for _, hint := range mergeRequestActionKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+func (m DashboardModel) handleMergeRequestDetailKey(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "esc":
+		m.mergeRequestDetail = false
+		m.focus = focusMain
+		m.mergeRequestDetailScroll = 0
+		m.mergeRequestDetailTab = mergeRequestDetailTabOverview
+		m.mergeRequestDiffLoad = false
+		m.mergeRequestDiffErr = ""
+		m.mergeRequestDetailLoad = false
+		m.mergeRequestDetailErr = ""
+		return m, nil
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "j", "down":
+		m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll + 1)
+	case "k", "up":
+		m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll - 1)
+	case "pgdown":
+		m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll + 8)
+	case "pgup":
+		m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(m.mergeRequestDetailScroll - 8)
+	case "?":
+		m.showHelp = true
+	case "p":
+		return m.openMergeRequestPipeline()
+	case "tab", "l", "right":
+		return m.switchMergeRequestDetailTab(nextMergeRequestDetailTab(m.mergeRequestDetailTab))
+	case "shift+tab", "h", "left":
+		return m.switchMergeRequestDetailTab(prevMergeRequestDetailTab(m.mergeRequestDetailTab))
+	case "d":
+		return m.switchMergeRequestDetailTab(mergeRequestDetailTabOverview)
+	case "c":
+		return m.switchMergeRequestDetailTab(mergeRequestDetailTabChanges)
+	case "t":
+		return m.switchMergeRequestDetailTab(mergeRequestDetailTabThreads)
+	case "a":
+		return m.switchMergeRequestDetailTab(mergeRequestDetailTabActivity)
+	case "]", "n":
+		switch m.mergeRequestDetailTab {
+		case mergeRequestDetailTabChanges:
+			return m.selectMergeRequestDiffFile(m.mergeRequestDiffFile + 1), nil
+		case mergeRequestDetailTabThreads:
+			return m.selectMergeRequestThread(m.mergeRequestThread + 1), nil
+		}
+	case "[", "N":
+		switch m.mergeRequestDetailTab {
+		case mergeRequestDetailTabChanges:
+			return m.selectMergeRequestDiffFile(m.mergeRequestDiffFile - 1), nil
+		case mergeRequestDetailTabThreads:
+			return m.selectMergeRequestThread(m.mergeRequestThread - 1), nil
+		}
+	case "C":
+		return m.openMergeRequestComposer()
+	case "A", "U", "M", "B", "x":
+		model, cmd, _ := m.handleMergeRequestActionKey(key)
+		return model, cmd
+	case " ":
+		return m.checkoutMergeRequest()
+	case "R":
+		if m.mergeRequestDetailTab == mergeRequestDetailTabThreads {
+			return m.openThreadReplyComposer()
+		}
+	case "s":
+		if m.mergeRequestDetailTab == mergeRequestDetailTabChanges {
+			m.mergeRequestDiffSplit = !m.mergeRequestDiffSplit
+			return m.selectMergeRequestDiffFile(m.mergeRequestDiffFile), nil
+		}
+	case "r":
+		item, ok := m.selectedMergeRequestItem()
+		if !ok {
+			return m, nil
+		}
+		if m.mergeRequestDetailTab == mergeRequestDetailTabChanges {
+			delete(m.mergeRequestDiffs, item.MergeRequest.IID)
+			delete(m.mergeRequestDiffStats, item.MergeRequest.IID)
+			m.invalidateDiffCache(item.MergeRequest.IID)
+		} else {
+			delete(m.mergeRequestDetailData, item.MergeRequest.IID)
+		}
+		return m.switchMergeRequestDetailTab(m.mergeRequestDetailTab)
+	}
+	return m, nil
+}
+
+func (m DashboardModel) switchMergeRequestDetailTab(tab mergeRequestDetailTab) (tea.Model, tea.Cmd) {
+	m.mergeRequestDetailTab = tab
+	m.mergeRequestDetailScroll = 0
+	if tab == mergeRequestDetailTabChanges {
+		cmd := m.loadMergeRequestDiffsCmd()
+		if cmd != nil {
+			m.mergeRequestDiffLoad = true
+			m.mergeRequestDiffErr = ""
+		}
+		return m, cmd
+	}
+	cmd := m.loadMergeRequestDetailDataCmd()
+	if cmd != nil {
+		m.mergeRequestDetailLoad = true
+		m.mergeRequestDetailErr = ""
+	}
+	return m, cmd
+}
+
+func (m DashboardModel) loadMergeRequestDetailDataCmd() tea.Cmd {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok || item.MergeRequest.IID <= 0 {
+		return nil
+	}
+	if _, exists := m.mergeRequestDetailData[item.MergeRequest.IID]; exists {
+		return nil
+	}
+	if m.mergeRequestDetailLoad {
+		return nil
+	}
+	requestID := m.requestID
+	mergeRequestIID := item.MergeRequest.IID
+	provider := m.provider
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		data, err := provider.LoadMergeRequestDetailData(ctx, mergeRequestIID)
+		return mergeRequestDetailLoadedMsg{mergeRequestIID: mergeRequestIID, data: data, err: err, requestID: requestID}
+	}
+}
+
+// selectMergeRequestDiffFile moves to another file and scrolls so its diff
+// header sits at the top of the viewport.
+func (m DashboardModel) selectMergeRequestDiffFile(index int) DashboardModel {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok {
+		return m
+	}
+	files := m.mergeRequestDiffs[item.MergeRequest.IID]
+	if len(files) == 0 {
+		return m
+	}
+	m.mergeRequestDiffFile = min(len(files)-1, max(0, index))
+	contentWidth, _ := m.mergeRequestDetailViewport()
+	_, diffStart := m.mergeRequestChangesContent(contentWidth)
+	m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(diffStart)
+	return m
+}
+
+func (m DashboardModel) loadMergeRequestDiffsCmd() tea.Cmd {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok || item.MergeRequest.IID <= 0 {
+		return nil
+	}
+	if _, exists := m.mergeRequestDiffs[item.MergeRequest.IID]; exists {
+		return nil
+	}
+	if m.mergeRequestDiffLoad {
+		return nil
+	}
+	requestID := m.requestID
+	mergeRequestIID := item.MergeRequest.IID
+	provider := m.provider
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		files, err := provider.LoadMergeRequestDiffs(ctx, mergeRequestIID)
+		return mergeRequestDiffsLoadedMsg{mergeRequestIID: mergeRequestIID, files: files, err: err, requestID: requestID}
+	}
+}
+
+func (m DashboardModel) mergeRequestChangesLines(width int) []string {
+	lines, _ := m.mergeRequestChangesContent(width)
+	return lines
+}
+
+// mergeRequestChangesContent renders the changed file list followed by the
+// selected file's diff, and returns the row where that diff starts.
+func (m DashboardModel) mergeRequestChangesContent(width int) ([]string, int) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok {
+		return nil, 0
+	}
+	if m.mergeRequestDiffLoad {
+		return wrapLines([]string{"Loading changes..."}, width), 0
+	}
+	if m.mergeRequestDiffErr != "" {
+		return wrapLines([]string{
+			fmt.Sprintf("Failed to load changes: %s", m.mergeRequestDiffErr),
+			"Press r to retry.",
+		}, width), 0
+	}
+	files, loaded := m.mergeRequestDiffs[item.MergeRequest.IID]
+	if !loaded {
+		return wrapLines([]string{"Loading changes..."}, width), 0
+	}
+	if len(files) == 0 {
+		return wrapLines([]string{"No changes in this merge request."}, width), 0
+	}
+
+	selected := min(len(files)-1, max(0, m.mergeRequestDiffFile))
+	stats := m.mergeRequestDiffStats[item.MergeRequest.IID]
+	if len(stats) != len(files) {
+		stats = countDiffFileStats(files)
+	}
+	totalAdded, totalRemoved := 0, 0
+	rows := make([]string, 0, len(files))
+	for i, file := range files {
+		added, removed := stats[i].added, stats[i].removed
+		totalAdded += added
+		totalRemoved += removed
+		prefix := "  "
+		style := m.styles.normalRow
+		if i == selected {
+			prefix = "› "
+			style = m.styles.selectedRow
+		}
+		counts := m.styles.diffAdded.Render(fmt.Sprintf("+%d", added)) + " " + m.styles.diffRemoved.Render(fmt.Sprintf("-%d", removed))
+		row := style.Render(fitLine(prefix+diffFileStatus(file)+" "+diffFilePath(file), max(1, width-14))) + "  " + counts
+		rows = append(rows, fitLine(row, width))
+	}
+
+	mode := "unified"
+	if m.mergeRequestDiffSideBySide(width) {
+		mode = "side-by-side"
+	}
+	lines := []string{
+		fitLine(fmt.Sprintf("Files changed: %d (+%d -%d) | %s", len(files), totalAdded, totalRemoved, mode), width),
+	}
+	lines = append(lines, rows...)
+	lines = append(lines, "")
+
+	file := files[selected]
+	diffStart := len(lines)
+	title := fmt.Sprintf("%s (%d/%d)", diffFilePath(file), selected+1, len(files))
+	lines = append(lines, m.styles.title.Render(fitLine(title, width)))
+	return append(lines, m.mergeRequestDiffLines(item.MergeRequest.IID, selected, file, width)...), diffStart
+}
+
+func (m DashboardModel) mergeRequestDiffSideBySide(width int) bool {
+	return m.mergeRequestDiffSplit && width >= diffSideBySideMinWidth
+}
+
+func (m DashboardModel) mergeRequestDiffLines(mergeRequestIID int64, index int, file MergeRequestDiffFile, width int) []string {
+	sideBySide := m.mergeRequestDiffSideBySide(width)
+	key := fmt.Sprintf("%d:%d:%d:%t", mergeRequestIID, index, width, sideBySide)
+	if cached, ok := m.diffCache[key]; ok {
+		return cached
+	}
+
+	var lines []string
+	if strings.TrimSpace(file.Diff) == "" {
+		lines = []string{m.styles.dim.Render("No textual diff (binary or too large).")}
+	} else {
+		parsed := parseUnifiedDiff(file.Diff)
+		filename := file.NewPath
+		if file.DeletedFile || filename == "" {
+			filename = file.OldPath
+		}
+		highlightDiffLines(parsed, filename)
+		if sideBySide {
+			lines = m.renderSideBySideDiff(parsed, width)
+		} else {
+			lines = m.renderUnifiedDiff(parsed, width)
+		}
+	}
+	m.diffCache[key] = lines
 	return lines
 }
+
+func (m DashboardModel) invalidateDiffCache(mergeRequestIID int64) {
+	prefix := fmt.Sprintf("%d:", mergeRequestIID)
+	for key := range m.diffCache {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.diffCache, key)
+		}
+	}
+}
+
+func (m DashboardModel) renderMergeRequestDetailTabs() string {
+	tabs := []mergeRequestDetailTab{mergeRequestDetailTabOverview, mergeRequestDetailTabChanges, mergeRequestDetailTabThreads, mergeRequestDetailTabActivity}
+	labels := make([]string, 0, len(tabs))
+	active := 0
+	for i, tab := range tabs {
+		labels = append(labels, mergeRequestDetailTabLabel(tab))
+		if tab == m.mergeRequestDetailTab {
+			active = i
+		}
+	}
+	return m.renderDetailTabLabels(labels, active)
+}
+
+func mergeRequestDetailTabLabel(tab mergeRequestDetailTab) string {
+	switch tab {
+	case mergeRequestDetailTabChanges:
+		return "Changes"
+	case mergeRequestDetailTabThreads:
+		return "Threads"
+	case mergeRequestDetailTabActivity:
+		return "Activity"
+	default:
+		return "Detail"
+	}
+}
+
+func nextMergeRequestDetailTab(tab mergeRequestDetailTab) mergeRequestDetailTab {
+	switch tab {
+	case mergeRequestDetailTabOverview:
+		return mergeRequestDetailTabChanges
+	case mergeRequestDetailTabChanges:
+		return mergeRequestDetailTabThreads
+	case mergeRequestDetailTabThreads:
+		return mergeRequestDetailTabActivity
+	default:
+		return mergeRequestDetailTabOverview
+	}
+}
+
+func prevMergeRequestDetailTab(tab mergeRequestDetailTab) mergeRequestDetailTab {
+	switch tab {
+	case mergeRequestDetailTabChanges:
+		return mergeRequestDetailTabOverview
+	case mergeRequestDetailTabThreads:
+		return mergeRequestDetailTabChanges
+	case mergeRequestDetailTabActivity:
+		return mergeRequestDetailTabThreads
+	default:
+		return mergeRequestDetailTabActivity
+	}
+}
+
+// mergeRequestDetailDataLines covers the states shared by the Threads and
+// Activity tabs before the discussions have been fetched.
+func (m DashboardModel) mergeRequestDetailDataLines(width int, mergeRequestIID int64) ([]string, bool) {
+	if m.mergeRequestDetailErr != "" {
+		return wrapLines([]string{
+			fmt.Sprintf("Failed to load merge request discussions: %s", m.mergeRequestDetailErr),
+			"Press r to retry.",
+		}, width), false
+	}
+	if _, ok := m.mergeRequestDetailData[mergeRequestIID]; !ok || m.mergeRequestDetailLoad {
+		return wrapLines([]string{"Loading merge request discussions..."}, width), false
+	}
+	return nil, true
+}
+
+// mergeRequestThreadOrder lists unresolved threads first, then resolved
+// threads and plain comments, each keeping the order GitLab returned them in.
+func mergeRequestThreadOrder(threads []MergeRequestThread) []int {
+	order := make([]int, 0, len(threads))
+	for i, thread := range threads {
+		if thread.Resolvable && !thread.Resolved {
+			order = append(order, i)
+		}
+	}
+	for i, thread := range threads {
+		if !thread.Resolvable || thread.Resolved {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+func (m DashboardModel) selectedMergeRequestThread() (MergeRequestThread, bool) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok {
+		return MergeRequestThread{}, false
+	}
+	threads := m.mergeRequestDetailData[item.MergeRequest.IID].Threads
+	order := mergeRequestThreadOrder(threads)
+	if m.mergeRequestThread < 0 || m.mergeRequestThread >= len(order) {
+		return MergeRequestThread{}, false
+	}
+	return threads[order[m.mergeRequestThread]], true
+}
+
+// selectMergeRequestThread moves the thread cursor and scrolls the selected
+// thread's header to the top of the viewport.
+func (m DashboardModel) selectMergeRequestThread(index int) DashboardModel {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok {
+		return m
+	}
+	threads := m.mergeRequestDetailData[item.MergeRequest.IID].Threads
+	if len(threads) == 0 {
+		return m
+	}
+	m.mergeRequestThread = min(len(threads)-1, max(0, index))
+	contentWidth, _ := m.mergeRequestDetailViewport()
+	_, rows := m.mergeRequestThreadContent(contentWidth, item.MergeRequest.IID)
+	if m.mergeRequestThread < len(rows) {
+		m.mergeRequestDetailScroll = m.clampMergeRequestDetailScroll(rows[m.mergeRequestThread])
+	}
+	return m
+}
+
+func (m DashboardModel) mergeRequestThreadLines(width int, mergeRequestIID int64) []string {
+	lines, _ := m.mergeRequestThreadContent(width, mergeRequestIID)
+	return lines
+}
+
+// mergeRequestThreadContent renders every thread and returns the row of each
+// thread header in display order.
+func (m DashboardModel) mergeRequestThreadContent(width int, mergeRequestIID int64) ([]string, []int) {
+	if lines, ok := m.mergeRequestDetailDataLines(width, mergeRequestIID); !ok {
+		return lines, nil
+	}
+	threads := m.mergeRequestDetailData[mergeRequestIID].Threads
+	if len(threads) == 0 {
+		return wrapLines([]string{"No discussions yet. Press C to start one."}, width), nil
+	}
+
+	order := mergeRequestThreadOrder(threads)
+	rows := make([]int, 0, len(order))
+	lines := []string{fitLine(mergeRequestThreadSummary(threads), width)}
+	if threads[order[0]].Resolvable && !threads[order[0]].Resolved {
+		lines[0] = m.styles.statusFailed.Render(lines[0])
+	}
+	for position, index := range order {
+		thread := threads[index]
+		lines = append(lines, "")
+		rows = append(rows, len(lines))
+
+		var status string
+		switch {
+		case thread.Resolvable && thread.Resolved:
+			status = m.styles.statusSuccess.Render("✓ Resolved")
+			if thread.ResolvedBy != "" {
+				status += m.styles.dim.Render(" by " + thread.ResolvedBy)
+			}
+		case thread.Resolvable:
+			status = m.styles.statusFailed.Render("● Unresolved")
+		default:
+			status = m.styles.dim.Render("Comment")
+		}
+		if location := mergeRequestThreadPosition(thread); location != "" {
+			status += m.styles.dim.Render(" • ") + m.styles.title.Render(location)
+		}
+		marker := "  "
+		if position == m.mergeRequestThread {
+			marker = m.styles.selectedRow.Render("› ")
+		}
+		lines = append(lines, fitLine(marker+status, width))
+
+		for i, note := range thread.Notes {
+			indent := "  "
+			header := fmt.Sprintf("%s • %s", fallbackValue(note.Author, "-"), fallbackValue(note.CreatedAt, "-"))
+			if i > 0 {
+				indent = "      "
+				header = "↳ " + header
+			}
+			for _, line := range wrapLine(header, max(1, width-2)) {
+				lines = append(lines, "  "+line)
+			}
+			bodyWidth := max(1, width-len(indent))
+			key := fmt.Sprintf("thread:%s", thread.ID)
+			for _, line := range m.markdownOrWrapped(mergeRequestIID, key, i, note.Body, bodyWidth) {
+				lines = append(lines, indent+line)
+			}
+		}
+	}
+	return lines, rows
+}
+
+func mergeRequestThreadSummary(threads []MergeRequestThread) string {
+	resolvable, unresolved := 0, 0
+	for _, thread := range threads {
+		if !thread.Resolvable {
+			continue
+		}
+		resolvable++
+		if !thread.Resolved {
+			unresolved++
+		}
+	}
+	if resolvable == 0 {
+		return fmt.Sprintf("%d comment threads, none need resolving", len(threads))
+	}
+	return fmt.Sprintf("%d of %d threads unresolved", unresolved, resolvable)
+}
+
+func mergeRequestThreadPosition(thread MergeRequestThread) string {
+	if thread.Path == "" {
+		return ""
+	}
+	switch {
+	case thread.NewLine > 0:
+		return fmt.Sprintf("%s:%d", thread.Path, thread.NewLine)
+	case thread.OldLine > 0:
+		return fmt.Sprintf("%s:%d (old)", thread.Path, thread.OldLine)
+	default:
+		return thread.Path
+	}
+}
+
+func (m DashboardModel) mergeRequestActivityLines(width int, mergeRequestIID int64) []string {
+	if lines, ok := m.mergeRequestDetailDataLines(width, mergeRequestIID); !ok {
+		return lines
+	}
+	activities := m.mergeRequestDetailData[mergeRequestIID].Activities
+	if len(activities) == 0 {
+		return wrapLines([]string{"No activities available."}, width)
+	}
+
+	lines := make([]string, 0, len(activities))
+	for _, activity := range activities {
+		line := fmt.Sprintf("%s • %s • %s", fallbackValue(activity.CreatedAt, "-"), fallbackValue(activity.Actor, "-"), fallbackValue(activity.Action, "-"))
+		lines = append(lines, line)
+	}
+	return wrapLines(lines, width)
+}
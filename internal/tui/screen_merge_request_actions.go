@@ -0,0 +1,321 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type mergeRequestAction int
+
+const (
+	mergeRequestActionApprove mergeRequestAction = iota
+	mergeRequestActionUnapprove
+	mergeRequestActionMerge
+	mergeRequestActionRebase
+	mergeRequestActionClose
+	mergeRequestActionReopen
+)
+
+// mergeRequestConfirm is the action waiting in the confirmation popup. merge
+// holds the options toggled in the popup when the action is a merge.
+type mergeRequestConfirm struct {
+	action mergeRequestAction
+	iid    int64
+	title  string
+	merge  MergeOptions
+}
+
+type mergeRequestActionDoneMsg struct {
+	action mergeRequestAction
+	iid    int64
+	merge  MergeOptions
+	item   ListItem
+	err    error
+}
+
+var mergeRequestActionKeyHints = []string{
+	"A: approve",
+	"U: revoke your approval",
+	"M: merge (squash, delete branch and auto-merge are set in the popup)",
+	"B: rebase onto the target branch",
+	"x: close/reopen",
+	"space: check out the source branch locally",
+}
+
+// handleMergeRequestActionKey opens the confirmation popup for an action on
+// the selected merge request, from the list or the detail view.
+func (m DashboardModel) handleMergeRequestActionKey(key string) (tea.Model, tea.Cmd, bool) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok || item.MergeRequest.IID <= 0 {
+		return m, nil, false
+	}
+	details := item.MergeRequest
+
+	var action mergeRequestAction
+	switch key {
+	case "A":
+		action = mergeRequestActionApprove
+	case "U":
+		action = mergeRequestActionUnapprove
+	case "M":
+		action = mergeRequestActionMerge
+	case "B":
+		action = mergeRequestActionRebase
+	case "x":
+		switch details.State {
+		case "opened":
+			action = mergeRequestActionClose
+		case "closed":
+			action = mergeRequestActionReopen
+		default:
+			m.notice = fmt.Sprintf("!%d is %s and cannot be closed or reopened", details.IID, details.State)
+			return m, nil, true
+		}
+	default:
+		return m, nil, false
+	}
+	if action != mergeRequestActionReopen && details.State != "opened" {
+		m.notice = fmt.Sprintf("!%d is %s", details.IID, details.State)
+		return m, nil, true
+	}
+
+	m.mergeRequestConfirm = mergeRequestConfirm{
+		action: action,
+		iid:    details.IID,
+		title:  item.Title,
+		merge: MergeOptions{
+			Squash:             details.Squash,
+			RemoveSourceBranch: details.RemoveSourceBranch,
+		},
+	}
+	m.mergeRequestConfirmOpen = true
+	return m, nil, true
+}
+
+func (m DashboardModel) handleMergeRequestConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	confirm := &m.mergeRequestConfirm
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "n", "q":
+		m.mergeRequestConfirmOpen = false
+		return m, nil
+	case "y", "enter":
+		m.mergeRequestConfirmOpen = false
+		return m.runMergeRequestAction(*confirm)
+	}
+	if confirm.action != mergeRequestActionMerge {
+		return m, nil
+	}
+	switch msg.String() {
+	case "s":
+		confirm.merge.Squash = !confirm.merge.Squash
+	case "d":
+		confirm.merge.RemoveSourceBranch = !confirm.merge.RemoveSourceBranch
+	case "w":
+		confirm.merge.AutoMerge = !confirm.merge.AutoMerge
+	}
+	return m, nil
+}
+
+func (m DashboardModel) runMergeRequestAction(confirm mergeRequestConfirm) (tea.Model, tea.Cmd) {
+	m.notice = fmt.Sprintf("%s !%d...", mergeRequestActionProgress(confirm.action), confirm.iid)
+	provider := m.provider
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		msg := mergeRequestActionDoneMsg{action: confirm.action, iid: confirm.iid, merge: confirm.merge}
+		switch confirm.action {
+		case mergeRequestActionApprove:
+			msg.err = provider.ApproveMergeRequest(ctx, confirm.iid)
+		case mergeRequestActionUnapprove:
+			msg.err = provider.UnapproveMergeRequest(ctx, confirm.iid)
+		case mergeRequestActionMerge:
+			msg.item, msg.err = provider.MergeMergeRequest(ctx, confirm.iid, confirm.merge)
+		case mergeRequestActionRebase:
+			msg.err = provider.RebaseMergeRequest(ctx, confirm.iid)
+		case mergeRequestActionClose:
+			msg.item, msg.err = provider.SetMergeRequestState(ctx, confirm.iid, "close")
+		case mergeRequestActionReopen:
+			msg.item, msg.err = provider.SetMergeRequestState(ctx, confirm.iid, "reopen")
+		}
+		return msg
+	}
+}
+
+// applyMergeRequestActionDone swaps in the merge request GitLab returned and
+// drops its cached discussions and approvals so the overview reloads them.
+func (m DashboardModel) applyMergeRequestActionDone(msg mergeRequestActionDoneMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.notice = ""
+		m.errorMessage = fmt.Sprintf("%s !%d: %v", mergeRequestActionVerb(msg.action), msg.iid, msg.err)
+		return m, nil
+	}
+	m.notice = mergeRequestActionNotice(msg)
+	if msg.item.MergeRequest != nil && m.view == MergeRequestsView {
+		for i, item := range m.items {
+			if item.MergeRequest != nil && item.MergeRequest.IID == msg.iid {
+				items := slices.Clone(m.items)
+				items[i] = msg.item
+				m.items = items
+				break
+			}
+		}
+	}
+
+	delete(m.mergeRequestDetailData, msg.iid)
+	item, ok := m.selectedMergeRequestItem()
+	if !m.mergeRequestDetail || !ok || item.MergeRequest.IID != msg.iid {
+		return m, nil
+	}
+	if m.mergeRequestDetailTab == mergeRequestDetailTabChanges {
+		return m, nil
+	}
+	return m.switchMergeRequestDetailTab(m.mergeRequestDetailTab)
+}
+
+func mergeRequestActionVerb(action mergeRequestAction) string {
+	switch action {
+	case mergeRequestActionApprove:
+		return "approve"
+	case mergeRequestActionUnapprove:
+		return "revoke approval on"
+	case mergeRequestActionMerge:
+		return "merge"
+	case mergeRequestActionRebase:
+		return "rebase"
+	case mergeRequestActionClose:
+		return "close"
+	default:
+		return "reopen"
+	}
+}
+
+func mergeRequestActionProgress(action mergeRequestAction) string {
+	switch action {
+	case mergeRequestActionApprove:
+		return "approving"
+	case mergeRequestActionUnapprove:
+		return "revoking approval on"
+	case mergeRequestActionMerge:
+		return "merging"
+	case mergeRequestActionRebase:
+		return "requesting rebase of"
+	case mergeRequestActionClose:
+		return "closing"
+	default:
+		return "reopening"
+	}
+}
+
+func mergeRequestActionNotice(msg mergeRequestActionDoneMsg) string {
+	switch msg.action {
+	case mergeRequestActionApprove:
+		return fmt.Sprintf("approved !%d", msg.iid)
+	case mergeRequestActionUnapprove:
+		return fmt.Sprintf("revoked approval on !%d", msg.iid)
+	case mergeRequestActionMerge:
+		if msg.item.MergeRequest != nil && msg.item.MergeRequest.State == "merged" {
+			return fmt.Sprintf("merged !%d", msg.iid)
+		}
+		if msg.merge.AutoMerge {
+			return fmt.Sprintf("!%d will merge when the pipeline succeeds", msg.iid)
+		}
+		return fmt.Sprintf("merge of !%d accepted", msg.iid)
+	case mergeRequestActionRebase:
+		return fmt.Sprintf("rebase of !%d started", msg.iid)
+	case mergeRequestActionClose:
+		return fmt.Sprintf("closed !%d", msg.iid)
+	default:
+		return fmt.Sprintf("reopened !%d", msg.iid)
+	}
+}
+
+func (m DashboardModel) renderMergeRequestConfirm(width int, height int) string {
+	confirm := m.mergeRequestConfirm
+	popupWidth := min(70, max(40, width-4))
+	textWidth := max(10, popupWidth-m.styles.helpPopup.GetHorizontalFrameSize())
+
+	verb := mergeRequestActionVerb(confirm.action)
+	lines := []string{
+		m.styles.header.Render(fitLine(fmt.Sprintf("%s%s !%d?", strings.ToUpper(verb[:1]), verb[1:], confirm.iid), textWidth)),
+		fitLine(confirm.title, textWidth),
+		"",
+	}
+
+	if confirm.action == mergeRequestActionMerge {
+		if item, ok := m.selectedMergeRequestItem(); ok && item.MergeRequest.IID == confirm.iid {
+			details := item.MergeRequest
+			lines = append(lines, fitLine(fmt.Sprintf("%s → %s", details.SourceBranch, details.TargetBranch), textWidth))
+			status := m.styles.dim.Render("Merge status: " + fallbackValue(details.MergeStatus, "unknown"))
+			if details.HasConflicts {
+				status = m.styles.statusFailed.Render("Merge status: has conflicts")
+			}
+			lines = append(lines, status, "")
+		}
+		toggles := []struct {
+			key   string
+			label string
+			on    bool
+		}{
+			{"s", "Squash commits", confirm.merge.Squash},
+			{"d", "Delete source branch", confirm.merge.RemoveSourceBranch},
+			{"w", "Merge when pipeline succeeds", confirm.merge.AutoMerge},
+		}
+		for _, toggle := range toggles {
+			box := "[ ]"
+			if toggle.on {
+				box = "[x]"
+			}
+			lines = append(lines, fmt.Sprintf("%s %s  %s", box, toggle.label, m.styles.dim.Render("("+toggle.key+")")))
+		}
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, m.styles.dim.Render("y/enter: confirm | n/esc: cancel"))
+	popup := m.styles.helpPopup.Width(popupWidth).Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, popup)
+}
+
+// mergeRequestApprovalLines summarises the approvals for the overview, with
+// one line per approval rule when the project has any.
+func mergeRequestApprovalLines(approvals MergeRequestApprovals) []string {
+	summary := "Approvals: "
+	switch {
+	case approvals.Required > 0:
+		summary += fmt.Sprintf("%d of %d required", approvals.Required-approvals.Left, approvals.Required)
+	case len(approvals.ApprovedBy) > 0:
+		summary += fmt.Sprintf("%d, none required", len(approvals.ApprovedBy))
+	default:
+		summary += "none"
+	}
+	if approvals.Approved && approvals.Required > 0 {
+		summary += " ✓"
+	}
+	if len(approvals.ApprovedBy) > 0 {
+		summary += " • approved by " + strings.Join(approvals.ApprovedBy, ", ")
+	}
+	if approvals.UserHasApproved {
+		summary += " (including you)"
+	}
+
+	lines := []string{summary}
+	for _, rule := range approvals.Rules {
+		marker := "○"
+		if rule.Approved {
+			marker = "✓"
+		}
+		line := fmt.Sprintf("%s %s: %d of %d", marker, fallbackValue(rule.Name, "-"), len(rule.ApprovedBy), rule.Required)
+		if len(rule.ApprovedBy) > 0 {
+			line += " • " + strings.Join(rule.ApprovedBy, ", ")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// applyMergeRequestFilterQuery applies the query typed after "/" in the merge
+// request list, keeping the input open when it does not parse.
+func (m DashboardModel) applyMergeRequestFilterQuery(query string) (tea.Model, tea.Cmd) {
+	if _, _, err := parseMergeRequestFilter(query); err != nil {
+		m.filterErr = err.Error()
+		return m, nil
+	}
+	m.searchMode = false
+	m.focus = focusMain
+	m.searchInput.Blur()
+	m.filterErr = ""
+	m.mergeRequestSearch = query
+	m.selected = 0
+	return m.startLoadCurrentView()
+}
+
+// openMergeRequestFilterPanel offers the preset merge request filters in a
+// picker.
+func (m DashboardModel) openMergeRequestFilterPanel() (tea.Model, tea.Cmd) {
+	choices := []pickerOption{{value: "", label: "No filter", detail: "show every merge request in the state tab"}}
+	for _, preset := range mergeRequestFilterPresets {
+		choices = append(choices, pickerOption{value: preset.Query, label: preset.Name, detail: preset.Query})
+	}
+	m.picker = newFuzzyPicker("Merge request filters", choices, false, []string{m.mergeRequestSearch})
+	m.pickerPurpose = pickerMergeRequestFilter
+	m.pickerActive = true
+	return m, nil
+}
+
+func (m DashboardModel) applyMergeRequestFilterPicked(values []string) (tea.Model, tea.Cmd) {
+	if len(values) != 1 {
+		return m, nil
+	}
+	m.mergeRequestSearch = values[0]
+	m.selected = 0
+	return m.startLoadCurrentView()
+}
+
+func (m DashboardModel) renderMergeRequestSearch(width int) string {
+	if m.searchMode {
+		return fitLine(m.searchInput.View(), width)
+	}
+	if strings.TrimSpace(m.mergeRequestSearch) == "" {
+		return fitLine("Filter: (press / to type one, F for presets)", width)
+	}
+	filter, search, err := parseMergeRequestFilter(m.mergeRequestSearch)
+	if err != nil {
+		return fitLine("Filter: "+m.mergeRequestSearch, width)
+	}
+	return m.renderFilterChips(mergeRequestFilterChips(filter, search), "(/ edit, F filters)")
+}
@@ -0,0 +1,601 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type mergeRequestFormField int
+
+const (
+	mergeRequestFormFieldTitle mergeRequestFormField = iota
+	mergeRequestFormFieldDescription
+	mergeRequestFormFieldTarget
+	mergeRequestFormFieldReviewers
+	mergeRequestFormFieldLabels
+	mergeRequestFormFieldDraft
+	mergeRequestFormFieldSquash
+	mergeRequestFormFieldPush
+	mergeRequestFormFieldCount
+)
+
+type mergeRequestFormOptionsLoadedMsg struct {
+	options MergeRequestFormOptions
+	err     error
+}
+
+type mergeRequestBranchPushedMsg struct {
+	branch string
+	output string
+	err    error
+}
+
+type mergeRequestCreatedMsg struct {
+	item      ListItem
+	err       error
+	requestID int
+}
+
+type mergeRequestFormEditorFinishedMsg struct {
+	path string
+	err  error
+}
+
+var mergeRequestFormKeyHints = []string{
+	"tab/shift+tab: next/previous field",
+	"enter: open picker (target branch, reviewers, labels)",
+	"space: toggle draft, squash and push",
+	"backspace: clear the focused picker field",
+	"ctrl+o: edit the description in $VISUAL/$EDITOR",
+	"ctrl+s: push the branch if needed, then create the merge request",
+	"esc: close (the form is kept until created)",
+}
+
+func newMergeRequestFormTitleInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.Placeholder = "Merge request title"
+	input.CharLimit = 255
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+func newMergeRequestFormDescriptionInput() textarea.Model {
+	input := textarea.New()
+	input.Placeholder = "Describe the change in markdown..."
+	input.ShowLineNumbers = false
+	input.CharLimit = 0
+	input.Prompt = ""
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+// openMergeRequestForm opens the new merge request form for the branch checked
+// out locally. The branch is read again every time since it may have changed
+// since the form was last open.
+func (m DashboardModel) openMergeRequestForm() (tea.Model, tea.Cmd) {
+	m.mergeRequestForm = true
+	m.mergeRequestFormErr = ""
+	m.mergeRequestFormSending = false
+	m.focus = focusForm
+	width, rows := m.mergeRequestFormViewport()
+	m.mergeRequestFormTitle.Width = width - 1
+	m.mergeRequestFormDescription.SetWidth(width)
+	m.mergeRequestFormDescription.SetHeight(rows)
+
+	focus := m.focusMergeRequestFormField(m.mergeRequestFormField)
+	cmds := []tea.Cmd{focus, m.loadIssueFormOptionsCmd()}
+	if !m.mergeRequestFormLoad {
+		m.mergeRequestFormLoad = true
+		provider := m.provider
+		cmds = append(cmds, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+			options, err := provider.LoadMergeRequestFormOptions(ctx)
+			return mergeRequestFormOptionsLoadedMsg{options: options, err: err}
+		})
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// applyMergeRequestFormOptions prefills the form from the branch commits the
+// first time it is opened on a branch, keeping anything typed for it before.
+func (m DashboardModel) applyMergeRequestFormOptions(msg mergeRequestFormOptionsLoadedMsg) (tea.Model, tea.Cmd) {
+	m.mergeRequestFormLoad = false
+	if msg.err != nil {
+		m.mergeRequestFormErr = "load branch: " + msg.err.Error()
+		return m, nil
+	}
+	previous := m.mergeRequestFormOptions.Branch.Name
+	m.mergeRequestFormOptions = msg.options
+	m.mergeRequestFormReady = true
+	m.mergeRequestFormErr = ""
+	if msg.options.Branch.Name == previous {
+		return m, nil
+	}
+
+	title, description := mergeRequestFormPrefill(msg.options.Branch, msg.options.Template)
+	m.mergeRequestFormTitle.SetValue(title)
+	m.mergeRequestFormDescription.SetValue(description)
+	m.mergeRequestFormTarget = msg.options.DefaultBranch
+	if m.mergeRequestFormTarget == "" && len(msg.options.TargetBranches) > 0 {
+		m.mergeRequestFormTarget = msg.options.TargetBranches[0]
+	}
+	m.mergeRequestFormPush = !msg.options.Branch.HasUpstream
+	if m.mergeRequestFormField == mergeRequestFormFieldPush && !m.mergeRequestFormPush {
+		m.mergeRequestFormField = mergeRequestFormFieldTitle
+	}
+	return m, m.focusMergeRequestFormField(m.mergeRequestFormField)
+}
+
+// mergeRequestFormPrefill suggests a title and description the way GitLab does
+// when a branch is pushed: a single commit provides both, several commits are
+// listed under a title made from the branch name. The project template goes
+// last.
+func mergeRequestFormPrefill(branch LocalBranch, template string) (string, string) {
+	var title, description string
+	if len(branch.Commits) == 1 {
+		subject, body, _ := strings.Cut(branch.Commits[0], "\n")
+		title, description = strings.TrimSpace(subject), strings.TrimSpace(body)
+	} else {
+		title = humanizeBranchName(branch.Name)
+		subjects := make([]string, 0, len(branch.Commits))
+		for _, commit := range branch.Commits {
+			subject, _, _ := strings.Cut(commit, "\n")
+			subjects = append(subjects, "- "+strings.TrimSpace(subject))
+		}
+		description = strings.Join(subjects, "\n")
+	}
+	if template = strings.TrimSpace(template); template != "" {
+		if description != "" {
+			description += "\n\n"
+		}
+		description += template
+	}
+	return title, description
+}
+
+// humanizeBranchName turns "feature/add-login_form" into "Add login form".
+func humanizeBranchName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Join(strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' }), " ")
+	runes := []rune(name)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+func (m DashboardModel) mergeRequestFormFieldVisible(field mergeRequestFormField) bool {
+	if field == mergeRequestFormFieldPush {
+		return m.mergeRequestFormReady && !m.mergeRequestFormOptions.Branch.HasUpstream
+	}
+	return true
+}
+
+// nextMergeRequestFormField steps through the fields, skipping the push toggle
+// once the branch has an upstream.
+func (m DashboardModel) nextMergeRequestFormField(step int) mergeRequestFormField {
+	field := m.mergeRequestFormField
+	for range mergeRequestFormFieldCount {
+		field = mergeRequestFormField((int(field) + step + int(mergeRequestFormFieldCount)) % int(mergeRequestFormFieldCount))
+		if m.mergeRequestFormFieldVisible(field) {
+			break
+		}
+	}
+	return field
+}
+
+func (m *DashboardModel) focusMergeRequestFormField(field mergeRequestFormField) tea.Cmd {
+	m.mergeRequestFormField = field
+	m.mergeRequestFormTitle.Blur()
+	m.mergeRequestFormDescription.Blur()
+	switch field {
+	case mergeRequestFormFieldTitle:
+		return m.mergeRequestFormTitle.Focus()
+	case mergeRequestFormFieldDescription:
+		return m.mergeRequestFormDescription.Focus()
+	}
+	return nil
+}
+
+func (m DashboardModel) closeMergeRequestForm() DashboardModel {
+	m.mergeRequestForm = false
+	m.mergeRequestFormErr = ""
+	m.mergeRequestFormTitle.Blur()
+	m.mergeRequestFormDescription.Blur()
+	m.focus = focusMain
+	return m
+}
+
+// resetMergeRequestForm clears the form after a merge request was created.
+// Forgetting the branch makes the next opening prefill the form again.
+func (m DashboardModel) resetMergeRequestForm() DashboardModel {
+	m = m.discardMergeRequestFormDraft()
+	m.mergeRequestFormField = mergeRequestFormFieldTitle
+	m.mergeRequestFormTitle.Reset()
+	m.mergeRequestFormDescription.Reset()
+	m.mergeRequestFormTarget = ""
+	m.mergeRequestFormReviewers = nil
+	m.mergeRequestFormLabels = nil
+	m.mergeRequestFormDraft = false
+	m.mergeRequestFormSquash = false
+	m.mergeRequestFormPush = false
+	m.mergeRequestFormOptions = MergeRequestFormOptions{}
+	m.mergeRequestFormReady = false
+	return m
+}
+
+func (m DashboardModel) openMergeRequestFormEditor() (tea.Model, tea.Cmd) {
+	reference := "New merge request: " + fallbackValue(strings.TrimSpace(m.mergeRequestFormTitle.Value()), "untitled")
+	path, err := writeEditorDraft(m.mergeRequestFormDraftPath, reference, m.mergeRequestFormDescription.Value())
+	if err != nil {
+		m.mergeRequestFormErr = err.Error()
+		return m, nil
+	}
+	m.mergeRequestFormDraftPath = path
+	cmd, err := editorCommand(path)
+	if err != nil {
+		m.mergeRequestFormErr = err.Error()
+		return m, nil
+	}
+	m.mergeRequestFormErr = ""
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return mergeRequestFormEditorFinishedMsg{path: path, err: err}
+	})
+}
+
+func (m DashboardModel) applyMergeRequestFormEditorFinished(msg mergeRequestFormEditorFinishedMsg) (tea.Model, tea.Cmd) {
+	if !m.mergeRequestForm || msg.path != m.mergeRequestFormDraftPath {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.mergeRequestFormErr = fmt.Sprintf("editor exited with an error: %v", msg.err)
+		return m, nil
+	}
+	body, err := readEditorDraft(msg.path)
+	if err != nil {
+		m.mergeRequestFormErr = err.Error()
+		return m, nil
+	}
+	m.mergeRequestFormDescription.SetValue(body)
+	m.mergeRequestFormErr = ""
+	return m, m.focusMergeRequestFormField(mergeRequestFormFieldDescription)
+}
+
+func (m DashboardModel) discardMergeRequestFormDraft() DashboardModel {
+	if m.mergeRequestFormDraftPath != "" {
+		_ = os.Remove(m.mergeRequestFormDraftPath)
+		m.mergeRequestFormDraftPath = ""
+	}
+	return m
+}
+
+func (m DashboardModel) handleMergeRequestFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.focus = focusForm
+	if m.mergeRequestFormSending {
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		return m.closeMergeRequestForm(), nil
+	case "ctrl+s":
+		return m.submitMergeRequestForm()
+	case "tab":
+		return m, m.focusMergeRequestFormField(m.nextMergeRequestFormField(1))
+	case "shift+tab":
+		return m, m.focusMergeRequestFormField(m.nextMergeRequestFormField(-1))
+	case "ctrl+o":
+		if m.mergeRequestFormField == mergeRequestFormFieldDescription {
+			return m.openMergeRequestFormEditor()
+		}
+	}
+
+	var cmd tea.Cmd
+	switch m.mergeRequestFormField {
+	case mergeRequestFormFieldTitle:
+		if msg.String() == "enter" {
+			return m, m.focusMergeRequestFormField(mergeRequestFormFieldDescription)
+		}
+		m.mergeRequestFormTitle, cmd = m.mergeRequestFormTitle.Update(msg)
+	case mergeRequestFormFieldDescription:
+		m.mergeRequestFormDescription, cmd = m.mergeRequestFormDescription.Update(msg)
+	case mergeRequestFormFieldDraft, mergeRequestFormFieldSquash, mergeRequestFormFieldPush:
+		switch msg.String() {
+		case " ", "enter", "x":
+			switch m.mergeRequestFormField {
+			case mergeRequestFormFieldDraft:
+				m.mergeRequestFormDraft = !m.mergeRequestFormDraft
+			case mergeRequestFormFieldSquash:
+				m.mergeRequestFormSquash = !m.mergeRequestFormSquash
+			default:
+				m.mergeRequestFormPush = !m.mergeRequestFormPush
+			}
+		}
+	default:
+		switch msg.String() {
+		case "enter", " ":
+			return m.openMergeRequestFormPicker()
+		case "backspace", "delete":
+			switch m.mergeRequestFormField {
+			case mergeRequestFormFieldReviewers:
+				m.mergeRequestFormReviewers = nil
+			case mergeRequestFormFieldLabels:
+				m.mergeRequestFormLabels = nil
+			}
+		}
+	}
+	return m, cmd
+}
+
+func (m DashboardModel) openMergeRequestFormPicker() (tea.Model, tea.Cmd) {
+	switch m.mergeRequestFormField {
+	case mergeRequestFormFieldTarget:
+		if !m.mergeRequestFormReady {
+			m.mergeRequestFormErr = "branches are still loading"
+			return m, nil
+		}
+		choices := make([]pickerOption, 0, len(m.mergeRequestFormOptions.TargetBranches))
+		for _, branch := range m.mergeRequestFormOptions.TargetBranches {
+			detail := ""
+			if branch == m.mergeRequestFormOptions.DefaultBranch {
+				detail = "default branch"
+			}
+			choices = append(choices, pickerOption{value: branch, label: branch, detail: detail})
+		}
+		m.picker = newFuzzyPicker("Target branch", choices, false, []string{m.mergeRequestFormTarget})
+		m.pickerPurpose = pickerMergeRequestFormTarget
+	case mergeRequestFormFieldReviewers, mergeRequestFormFieldLabels:
+		if !m.issueFormOptionsReady {
+			m.mergeRequestFormErr = "project labels and members are still loading"
+			return m, nil
+		}
+		options := m.issueFormOptions
+		if m.mergeRequestFormField == mergeRequestFormFieldLabels {
+			choices := make([]pickerOption, 0, len(options.Labels))
+			for _, label := range options.Labels {
+				choices = append(choices, pickerOption{value: label.Name, label: label.Name, detail: label.Description})
+			}
+			m.picker = newFuzzyPicker("Labels", choices, true, m.mergeRequestFormLabels)
+			m.pickerPurpose = pickerMergeRequestFormLabels
+			break
+		}
+		choices := make([]pickerOption, 0, len(options.Members))
+		for _, member := range options.Members {
+			choices = append(choices, pickerOption{value: strconv.FormatInt(member.ID, 10), label: "@" + member.Username, detail: member.Name})
+		}
+		checked := make([]string, 0, len(m.mergeRequestFormReviewers))
+		for _, id := range m.mergeRequestFormReviewers {
+			checked = append(checked, strconv.FormatInt(id, 10))
+		}
+		m.picker = newFuzzyPicker("Reviewers", choices, true, checked)
+		m.pickerPurpose = pickerMergeRequestFormReviewers
+	default:
+		return m, nil
+	}
+	m.mergeRequestFormErr = ""
+	m.pickerActive = true
+	return m, nil
+}
+
+// submitMergeRequestForm pushes the branch first when asked to, since GitLab
+// only accepts merge requests for branches it already has.
+func (m DashboardModel) submitMergeRequestForm() (tea.Model, tea.Cmd) {
+	if !m.mergeRequestFormReady {
+		m.mergeRequestFormErr = "the local branch is still loading"
+		return m, nil
+	}
+	branch := m.mergeRequestFormOptions.Branch
+	if strings.TrimSpace(m.mergeRequestFormTitle.Value()) == "" {
+		m.mergeRequestFormErr = "title is required"
+		return m, m.focusMergeRequestFormField(mergeRequestFormFieldTitle)
+	}
+	if m.mergeRequestFormTarget == "" {
+		m.mergeRequestFormErr = "pick a target branch"
+		return m, m.focusMergeRequestFormField(mergeRequestFormFieldTarget)
+	}
+	if m.mergeRequestFormTarget == branch.Name {
+		m.mergeRequestFormErr = "the target branch must differ from " + branch.Name
+		return m, m.focusMergeRequestFormField(mergeRequestFormFieldTarget)
+	}
+	if !branch.HasUpstream && !m.mergeRequestFormPush {
+		m.mergeRequestFormErr = fmt.Sprintf("%s is not on origin yet; enable push to create the merge request", branch.Name)
+		return m, m.focusMergeRequestFormField(mergeRequestFormFieldPush)
+	}
+	m.mergeRequestFormSending = true
+	m.mergeRequestFormErr = ""
+
+	provider := m.provider
+	if !branch.HasUpstream {
+		return m, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			output, err := provider.PushBranch(ctx, branch.Name)
+			return mergeRequestBranchPushedMsg{branch: branch.Name, output: output, err: err}
+		}
+	}
+	return m, m.createMergeRequestCmd()
+}
+
+func (m DashboardModel) createMergeRequestCmd() tea.Cmd {
+	mr := NewMergeRequest{
+		SourceBranch: m.mergeRequestFormOptions.Branch.Name,
+		TargetBranch: m.mergeRequestFormTarget,
+		Title:        strings.TrimSpace(m.mergeRequestFormTitle.Value()),
+		Description:  strings.TrimSpace(m.mergeRequestFormDescription.Value()),
+		Labels:       m.mergeRequestFormLabels,
+		ReviewerIDs:  m.mergeRequestFormReviewers,
+		Draft:        m.mergeRequestFormDraft,
+		Squash:       m.mergeRequestFormSquash,
+	}
+	provider := m.provider
+	requestID := m.requestID
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		item, err := provider.CreateMergeRequest(ctx, mr)
+		return mergeRequestCreatedMsg{item: item, err: err, requestID: requestID}
+	}
+}
+
+func (m DashboardModel) applyMergeRequestBranchPushed(msg mergeRequestBranchPushedMsg) (tea.Model, tea.Cmd) {
+	if !m.mergeRequestFormSending || msg.branch != m.mergeRequestFormOptions.Branch.Name {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.mergeRequestFormSending = false
+		m.mergeRequestFormErr = "push failed; the merge request was not created"
+		return m.openGitOutput("Could not push "+msg.branch, msg.output, msg.err), nil
+	}
+	m.mergeRequestFormOptions.Branch.HasUpstream = true
+	m.mergeRequestFormPush = false
+	if m.mergeRequestFormField == mergeRequestFormFieldPush {
+		m.mergeRequestFormField = mergeRequestFormFieldTitle
+	}
+	return m, m.createMergeRequestCmd()
+}
+
+// applyMergeRequestCreated puts the new merge request at the top of the list
+// when the list shows open merge requests.
+func (m DashboardModel) applyMergeRequestCreated(msg mergeRequestCreatedMsg) (tea.Model, tea.Cmd) {
+	if !m.mergeRequestFormSending {
+		return m, nil
+	}
+	m.mergeRequestFormSending = false
+	if msg.err != nil {
+		m.mergeRequestFormErr = msg.err.Error()
+		return m, nil
+	}
+
+	m = m.resetMergeRequestForm().closeMergeRequestForm()
+	reference := msg.item.Title
+	if msg.item.MergeRequest != nil {
+		reference = fmt.Sprintf("!%d", msg.item.MergeRequest.IID)
+	}
+	m.notice = "created merge request " + reference
+	if msg.requestID != m.requestID || m.view != MergeRequestsView {
+		return m, nil
+	}
+	if m.mergeRequestState != MergeRequestStateOpened && m.mergeRequestState != MergeRequestStateAll {
+		return m, nil
+	}
+
+	items := make([]ListItem, 0, len(m.items)+1)
+	items = append(items, msg.item)
+	for _, item := range m.items {
+		if item.ID != msg.item.ID {
+			items = append(items, item)
+		}
+	}
+	m.items = items
+	m.selected = 0
+	return m, nil
+}
+
+// mergeRequestFormViewport sizes the description like the issue form does,
+// leaving room for the source line and the extra toggles.
+func (m DashboardModel) mergeRequestFormViewport() (int, int) {
+	width, _ := m.issueFormViewport()
+	contentHeight := max(8, m.height-5)
+	rows := min(10, max(3, contentHeight-m.styles.panel.GetVerticalFrameSize()-18))
+	return width, rows
+}
+
+func (m DashboardModel) mergeRequestFormReviewerNames() string {
+	names := make([]string, 0, len(m.mergeRequestFormReviewers))
+	for _, id := range m.mergeRequestFormReviewers {
+		name := fmt.Sprintf("user %d", id)
+		if i := slices.IndexFunc(m.issueFormOptions.Members, func(member ProjectMember) bool { return member.ID == id }); i >= 0 {
+			name = "@" + m.issueFormOptions.Members[i].Username
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (m DashboardModel) renderMergeRequestFormFullscreen(width int, height int) string {
+	contentWidth := max(10, width-m.styles.panel.GetHorizontalFrameSize())
+	fieldWidth := max(10, contentWidth-issueFormLabelWidth)
+	lines := []string{
+		m.styles.header.Render(fitLine("New merge request in "+fallbackValue(m.ctx.ProjectPath, "-"), contentWidth)),
+		m.styles.dim.Render(fitLine("tab next field | enter pick | space toggle | ctrl+o $EDITOR | ctrl+s create | esc close (form kept)", contentWidth)),
+		"",
+	}
+
+	label := func(field mergeRequestFormField, name string) string {
+		if m.mergeRequestFormField == field {
+			return m.styles.selectedRow.Render(padToWidth("› "+name, issueFormLabelWidth))
+		}
+		return m.styles.secondary.Render(padToWidth("  "+name, issueFormLabelWidth))
+	}
+	value := func(text string, placeholder string) string {
+		if text == "" {
+			return m.styles.dim.Render(placeholder)
+		}
+		return fitLine(text, fieldWidth)
+	}
+	toggle := func(on bool, text string) string {
+		if on {
+			return "[x] " + text
+		}
+		return "[ ] " + text
+	}
+
+	source := m.styles.dim.Render("reading the local branch...")
+	if m.mergeRequestFormReady {
+		branch := m.mergeRequestFormOptions.Branch
+		summary := fmt.Sprintf("%s • %d commit(s)", branch.Name, len(branch.Commits))
+		if !branch.HasUpstream {
+			summary += " • not pushed"
+		}
+		source = fitLine(summary, fieldWidth)
+	}
+	lines = append(lines, padToWidth("  Source", issueFormLabelWidth)+source, "")
+	lines = append(lines, label(mergeRequestFormFieldTitle, "Title")+fitLine(m.mergeRequestFormTitle.View(), fieldWidth), "")
+	lines = append(lines, label(mergeRequestFormFieldDescription, "Description"))
+	for _, row := range strings.Split(m.mergeRequestFormDescription.View(), "\n") {
+		lines = append(lines, padToWidth("", issueFormLabelWidth)+row)
+	}
+	lines = append(lines,
+		"",
+		label(mergeRequestFormFieldTarget, "Target branch")+value(m.mergeRequestFormTarget, "none"),
+		label(mergeRequestFormFieldReviewers, "Reviewers")+value(m.mergeRequestFormReviewerNames(), "none"),
+		label(mergeRequestFormFieldLabels, "Labels")+value(strings.Join(m.mergeRequestFormLabels, ", "), "none"),
+		label(mergeRequestFormFieldDraft, "Draft")+toggle(m.mergeRequestFormDraft, "mark as draft"),
+		label(mergeRequestFormFieldSquash, "Squash")+toggle(m.mergeRequestFormSquash, "squash commits when merging"),
+	)
+	if m.mergeRequestFormFieldVisible(mergeRequestFormFieldPush) {
+		lines = append(lines, label(mergeRequestFormFieldPush, "Push")+toggle(m.mergeRequestFormPush, "push the branch to origin first"))
+	}
+
+	switch {
+	case m.mergeRequestFormSending:
+		lines = append(lines, "", m.styles.dim.Render(m.spinner.View()+" Creating merge request..."))
+	case m.mergeRequestFormErr != "":
+		lines = append(lines, "", m.styles.statusFailed.Render(fitLine(m.mergeRequestFormErr, contentWidth)))
+	case m.mergeRequestFormLoad || m.issueFormOptionsLoad:
+		lines = append(lines, "", m.styles.dim.Render(m.spinner.View()+" Loading branches, labels and members..."))
+	}
+
+	innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+	lines = fitHeight(lines, innerHeight)
+	return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,228 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var milestoneKeyHints = []string{
+	"enter: open milestone, issue or merge request",
+	"backspace/u: back to the milestones",
+	"[: prev list",
+	"]: next list",
+	"r: refresh",
+}
+
+var milestoneStates = []MilestoneState{
+	MilestoneStateActive,
+	MilestoneStateClosed,
+}
+
+var milestoneScopes = []MilestoneScope{
+	MilestoneIssues,
+	MilestoneMergeRequests,
+}
+
+// milestoneProgressWidth is the number of cells of the progress bars.
+const milestoneProgressWidth = 10
+
+func (m DashboardModel) handleMilestoneScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != MilestonesView {
+		return m, nil, false
+	}
+
+	switch key {
+	case "enter":
+		if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
+			return m, nil, true
+		}
+		item := m.items[m.selected]
+		if item.Milestone == nil {
+			model, cmd := m.jumpToItem(item.ProjectPath, item)
+			return model, cmd, true
+		}
+		m.milestone = &item
+		m.milestoneScope = MilestoneIssues
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "backspace", "u":
+		if m.milestone == nil {
+			return m, nil, true
+		}
+		m.milestoneReturnTo = m.milestone.ID
+		m.milestone = nil
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "[", "]":
+		step := 1
+		if key == "[" {
+			step = -1
+		}
+		if m.milestone != nil {
+			m.milestoneScope = cycleMilestoneScope(m.milestoneScope, step)
+		} else {
+			m.milestoneState = cycleMilestoneState(m.milestoneState, step)
+		}
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "r":
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+// selectMilestoneReturn selects the milestone that was just left after going
+// back to the milestones.
+func (m DashboardModel) selectMilestoneReturn() DashboardModel {
+	index := slices.IndexFunc(m.items, func(item ListItem) bool {
+		return item.Milestone != nil && item.ID == m.milestoneReturnTo
+	})
+	if index >= 0 {
+		m.selected = index
+	}
+	m.milestoneReturnTo = 0
+	return m
+}
+
+func (m DashboardModel) renderMilestoneBody(width int) []string {
+	location := " milestones of " + m.ctx.ProjectPath + " and its groups, soonest due first"
+	if m.milestone != nil {
+		location = " milestone: " + m.milestone.Title + " • " + milestoneProgress(*m.milestone.Milestone)
+	}
+	lines := []string{
+		" " + m.renderMilestoneTabs(max(20, width-8)),
+		m.styles.dim.Render(fitLine(location, max(20, width-8))),
+		"",
+	}
+	for _, hint := range milestoneKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+func (m DashboardModel) renderMilestoneTabs(width int) string {
+	var labels []string
+	current := ""
+	if m.milestone != nil {
+		for _, scope := range milestoneScopes {
+			labels = append(labels, milestoneScopeLabel(scope))
+		}
+		current = milestoneScopeLabel(m.milestoneScope)
+	} else {
+		for _, state := range milestoneStates {
+			labels = append(labels, milestoneStateLabel(state))
+		}
+		current = milestoneStateLabel(m.milestoneState)
+	}
+	parts := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if label == current {
+			parts = append(parts, m.styles.selectedRow.Render("["+label+"]"))
+			continue
+		}
+		parts = append(parts, m.styles.dim.Render(label))
+	}
+	return fitLine(strings.Join(parts, "  "), width)
+}
+
+func (m DashboardModel) shouldLoadMoreMilestones() bool {
+	if m.view != MilestonesView || m.loading || m.loadingMore || !m.milestoneHasNext {
+		return false
+	}
+	if len(m.items) == 0 {
+		return false
+	}
+	return m.selected >= len(m.items)-2
+}
+
+func milestoneListMeta(item ListItem) string {
+	if item.Milestone == nil {
+		return myWorkListMeta(item)
+	}
+	meta := milestoneProgress(*item.Milestone)
+	if item.Milestone.GroupID > 0 {
+		meta += " • group milestone"
+	}
+	return meta
+}
+
+// milestoneProgress describes how far a milestone is, such as
+// "[######----] 60% • 6/10 issues closed • weight 13/21 • 12 days left".
+func milestoneProgress(milestone MilestoneDetails) string {
+	total := milestone.OpenIssues + milestone.ClosedIssues
+	parts := []string{
+		progressBar(milestone.ClosedIssues, total, milestoneProgressWidth),
+		fmt.Sprintf("%d/%d issues closed", milestone.ClosedIssues, total),
+	}
+	if milestone.TotalWeight > 0 {
+		parts = append(parts, fmt.Sprintf("weight %d/%d", milestone.ClosedWeight, milestone.TotalWeight))
+	}
+	return strings.Join(append(parts, milestoneDueLabel(milestone)), " • ")
+}
+
+// progressBar draws done out of total as a bar of width cells, such as
+// "[####------] 40%".
+func progressBar(done int, total int, width int) string {
+	percent, filled := 0, 0
+	if total > 0 {
+		percent = done * 100 / total
+		filled = done * width / total
+	}
+	filled = min(max(filled, 0), width)
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "] " + strconv.Itoa(percent) + "%"
+}
+
+func milestoneDueLabel(milestone MilestoneDetails) string {
+	switch {
+	case milestone.DueDate == "":
+		return "no due date"
+	case milestone.State == string(MilestoneStateClosed):
+		return "due " + milestone.DueDate
+	case milestone.DaysLeft == 0:
+		return "due today"
+	case milestone.DaysLeft == 1:
+		return "1 day left"
+	case milestone.DaysLeft > 1:
+		return fmt.Sprintf("%d days left", milestone.DaysLeft)
+	case milestone.DaysLeft == -1:
+		return "1 day past due"
+	default:
+		return fmt.Sprintf("%d days past due", -milestone.DaysLeft)
+	}
+}
+
+func milestoneStateLabel(state MilestoneState) string {
+	if state == MilestoneStateClosed {
+		return "Closed"
+	}
+	return "Active"
+}
+
+func milestoneScopeLabel(scope MilestoneScope) string {
+	if scope == MilestoneMergeRequests {
+		return "Merge requests"
+	}
+	return "Issues"
+}
+
+func cycleMilestoneState(current MilestoneState, step int) MilestoneState {
+	index := max(0, slices.Index(milestoneStates, current))
+	n := len(milestoneStates)
+	return milestoneStates[((index+step)%n+n)%n]
+}
+
+func cycleMilestoneScope(current MilestoneScope, step int) MilestoneScope {
+	index := max(0, slices.Index(milestoneScopes, current))
+	n := len(milestoneScopes)
+	return milestoneScopes[((index+step)%n+n)%n]
+}
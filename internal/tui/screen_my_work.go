@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var myWorkKeyHints = []string{
+	"enter: open in its project",
+	"[: prev list",
+	"]: next list",
+	"r: refresh",
+}
+
+var myWorkScopes = []MyWorkScope{
+	MyWorkAssignedIssues,
+	MyWorkAuthoredMergeRequests,
+	MyWorkReviewRequests,
+}
+
+func (m DashboardModel) handleMyWorkScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != MyWorkView {
+		return m, nil, false
+	}
+
+	switch key {
+	case "enter":
+		if len(m.items) > 0 && m.selected >= 0 && m.selected < len(m.items) {
+			item := m.items[m.selected]
+			model, cmd := m.jumpToItem(item.ProjectPath, item)
+			return model, cmd, true
+		}
+	case "[":
+		m.myWorkScope = cycleMyWorkScope(m.myWorkScope, -1)
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "]":
+		m.myWorkScope = cycleMyWorkScope(m.myWorkScope, 1)
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "r":
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+func (m DashboardModel) renderMyWorkBody(width int) []string {
+	lines := []string{
+		" " + m.renderMyWorkTabs(max(20, width-8)),
+		m.styles.dim.Render(" open items across all projects, updated newest first"),
+		"",
+	}
+	for _, hint := range myWorkKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+func (m DashboardModel) renderMyWorkTabs(width int) string {
+	parts := make([]string, 0, len(myWorkScopes))
+	for _, scope := range myWorkScopes {
+		label := myWorkScopeLabel(scope)
+		if scope == m.myWorkScope {
+			parts = append(parts, m.styles.selectedRow.Render("["+label+"]"))
+			continue
+		}
+		parts = append(parts, m.styles.dim.Render(label))
+	}
+	return fitLine(strings.Join(parts, "  "), width)
+}
+
+func (m DashboardModel) shouldLoadMoreMyWork() bool {
+	if m.view != MyWorkView || m.loading || m.loadingMore || !m.myWorkHasNext {
+		return false
+	}
+	if len(m.items) == 0 {
+		return false
+	}
+	return m.selected >= len(m.items)-2
+}
+
+func myWorkListMeta(item ListItem) string {
+	meta := fallbackValue(strings.TrimSpace(item.Subtitle), "-")
+	updated := ""
+	switch {
+	case item.Issue != nil:
+		updated = item.Issue.UpdatedAt
+	case item.MergeRequest != nil:
+		updated = item.MergeRequest.UpdatedAt
+	}
+	if updated != "" {
+		meta += " • updated " + updated
+	}
+	return meta
+}
+
+func myWorkScopeLabel(scope MyWorkScope) string {
+	switch scope {
+	case MyWorkAuthoredMergeRequests:
+		return "My merge requests"
+	case MyWorkReviewRequests:
+		return "Review requests"
+	default:
+		return "Assigned issues"
+	}
+}
+
+func cycleMyWorkScope(current MyWorkScope, step int) MyWorkScope {
+	index := max(0, slices.Index(myWorkScopes, current))
+	n := len(myWorkScopes)
+	return myWorkScopes[((index+step)%n+n)%n]
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// namePromptPurpose is what the name typed in the name prompt is saved for.
+type namePromptPurpose int
+
+const (
+	namePromptClosed namePromptPurpose = iota
+	namePromptIssueFilter
+	namePromptView
+)
+
+func newNameInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.Placeholder = "e.g. Triage"
+	input.CharLimit = 60
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return input
+}
+
+func (m DashboardModel) openNamePrompt(purpose namePromptPurpose) (tea.Model, tea.Cmd) {
+	m.namePrompt = purpose
+	m.nameInput.SetValue("")
+	m.nameInput.Width = m.nameInputWidth()
+	return m, m.nameInput.Focus()
+}
+
+func (m DashboardModel) handleNamePromptKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.namePrompt = namePromptClosed
+		m.nameInput.Blur()
+		return m, nil
+	case "enter":
+		name := strings.TrimSpace(m.nameInput.Value())
+		if name == "" {
+			return m, nil
+		}
+		purpose := m.namePrompt
+		m.namePrompt = namePromptClosed
+		m.nameInput.Blur()
+		if purpose == namePromptView {
+			return m.saveCurrentView(name)
+		}
+		return m.saveIssueFilter(name)
+	}
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return m, cmd
+}
+
+func (m DashboardModel) namePromptWidth() (int, int) {
+	popupWidth := min(70, max(40, max(60, m.width-2)-4))
+	return popupWidth, max(10, popupWidth-m.styles.helpPopup.GetHorizontalFrameSize())
+}
+
+func (m DashboardModel) nameInputWidth() int {
+	_, textWidth := m.namePromptWidth()
+	return max(1, textWidth-len("Name: ")-1)
+}
+
+func (m DashboardModel) renderNamePrompt(width int, height int) string {
+	popupWidth, textWidth := m.namePromptWidth()
+	title, subject, note := "Save issue filter", m.issueSearch, "a saved filter with the same name is replaced"
+	if m.namePrompt == namePromptView {
+		title, subject, note = "Save view", savedViewSummary(m.currentView("")), "a saved view with the same name is replaced"
+	}
+	lines := []string{
+		m.styles.header.Render(fitLine(title, textWidth)),
+		fitLine(subject, textWidth),
+		"",
+		fitLine("Name: "+m.nameInput.View(), textWidth),
+		m.styles.dim.Render(fitLine(note, textWidth)),
+		"",
+		m.styles.dim.Render("enter: save | esc: cancel"),
+	}
+	popup := m.styles.helpPopup.Width(popupWidth).Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, popup)
+}
@@ -0,0 +1,551 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type pipelineDetailLoadedMsg struct {
+	pipelineID int64
+	data       PipelineDetailData
+	err        error
+	requestID  int
+}
+
+type mergeRequestPipelineLoadedMsg struct {
+	mergeRequestIID int64
+	data            PipelineDetailData
+	err             error
+	requestID       int
+}
+
+const (
+	pipelineGridMinColumnWidth = 18
+	pipelineGridSeparator      = " │ "
+)
+
+var pipelineKeyHints = []string{
+	"enter: open pipeline stages and jobs",
+	"r: refresh pipelines",
+	"j/k h/l: pick a job in the stage grid",
+	"enter (in grid): open job log",
+}
+
+func (m DashboardModel) handlePipelineScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != PipelinesView {
+		return m, nil, false
+	}
+
+	switch key {
+	case "enter":
+		if item, ok := m.selectedPipelineItem(); ok {
+			m.pipelineDetail = true
+			m.pipelineDetailID = item.Pipeline.ID
+			m.pipelineDetailFromMergeRequest = false
+			m.pipelineDetailScroll = 0
+			m.pipelineJobCursor = 0
+			m.pipelineDetailErr = ""
+			cmd := m.loadPipelineDetailDataCmd()
+			if cmd != nil {
+				m.pipelineDetailLoad = true
+			}
+			return m, cmd, true
+		}
+	case "r":
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+// openMergeRequestPipeline shows the latest pipeline of the selected merge
+// request in the pipeline detail screen; Esc returns to the merge request.
+func (m DashboardModel) openMergeRequestPipeline() (tea.Model, tea.Cmd) {
+	item, ok := m.selectedMergeRequestItem()
+	if !ok || item.MergeRequest.IID <= 0 {
+		return m, nil
+	}
+	m.pipelineDetail = true
+	m.pipelineDetailFromMergeRequest = true
+	m.pipelineDetailID = 0
+	m.pipelineDetailScroll = 0
+	m.pipelineJobCursor = 0
+	m.pipelineDetailErr = ""
+	m.pipelineDetailLoad = true
+	return m, m.loadMergeRequestPipelineCmd(item.MergeRequest.IID)
+}
+
+func (m DashboardModel) handlePipelineDetailKey(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "esc":
+		m.pipelineDetail = false
+		m.pipelineDetailID = 0
+		m.pipelineDetailScroll = 0
+		m.pipelineJobCursor = 0
+		m.pipelineDetailLoad = false
+		m.pipelineDetailErr = ""
+		if m.pipelineDetailFromMergeRequest {
+			m.pipelineDetailFromMergeRequest = false
+			return m, nil
+		}
+		m.focus = focusMain
+		return m, nil
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "j", "down":
+		return m.movePipelineJobCursor(1), nil
+	case "k", "up":
+		return m.movePipelineJobCursor(-1), nil
+	case "l", "right":
+		return m.movePipelineJobStage(1), nil
+	case "h", "left":
+		return m.movePipelineJobStage(-1), nil
+	case "enter":
+		if job, ok := m.selectedPipelineJob(); ok {
+			return m.openJobLog(job)
+		}
+	case "pgdown":
+		m.pipelineDetailScroll = m.clampPipelineDetailScroll(m.pipelineDetailScroll + 8)
+	case "pgup":
+		m.pipelineDetailScroll = m.clampPipelineDetailScroll(m.pipelineDetailScroll - 8)
+	case "?":
+		m.showHelp = true
+	case "r":
+		delete(m.pipelineDetailData, m.pipelineDetailID)
+		if m.pipelineDetailFromMergeRequest {
+			item, ok := m.selectedMergeRequestItem()
+			if !ok || m.pipelineDetailLoad {
+				return m, nil
+			}
+			m.pipelineDetailID = 0
+			m.pipelineDetailLoad = true
+			m.pipelineDetailErr = ""
+			return m, m.loadMergeRequestPipelineCmd(item.MergeRequest.IID)
+		}
+		cmd := m.loadPipelineDetailDataCmd()
+		if cmd != nil {
+			m.pipelineDetailLoad = true
+			m.pipelineDetailErr = ""
+		}
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m DashboardModel) renderPipelineBody(_ int) []string {
+	lines := []string{
+		m.styles.dim.Render(" sort: newest first"),
+		"",
+	}
+	for _, hint := range pipelineKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+func (m DashboardModel) hasPipelineDetailsSelection() bool {
+	if m.view != PipelinesView {
+		return false
+	}
+	if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
+		return false
+	}
+	return m.items[m.selected].Pipeline != nil
+}
+
+func (m DashboardModel) selectedPipelineItem() (ListItem, bool) {
+	if !m.hasPipelineDetailsSelection() {
+		return ListItem{}, false
+	}
+	return m.items[m.selected], true
+}
+
+func (m DashboardModel) shouldLoadMorePipelines() bool {
+	if m.view != PipelinesView || m.loading || m.loadingMore || !m.pipelineHasNext {
+		return false
+	}
+	if len(m.items) == 0 {
+		return false
+	}
+	return m.selected >= len(m.items)-2
+}
+
+func (m DashboardModel) loadPipelineDetailDataCmd() tea.Cmd {
+	if m.pipelineDetailID <= 0 {
+		return nil
+	}
+	if _, exists := m.pipelineDetailData[m.pipelineDetailID]; exists {
+		return nil
+	}
+	if m.pipelineDetailLoad {
+		return nil
+	}
+	requestID := m.requestID
+	pipelineID := m.pipelineDetailID
+	provider := m.provider
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		data, err := provider.LoadPipelineDetailData(ctx, pipelineID)
+		return pipelineDetailLoadedMsg{pipelineID: pipelineID, data: data, err: err, requestID: requestID}
+	}
+}
+
+func (m DashboardModel) loadMergeRequestPipelineCmd(mergeRequestIID int64) tea.Cmd {
+	requestID := m.requestID
+	provider := m.provider
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		data, err := provider.LoadMergeRequestPipeline(ctx, mergeRequestIID)
+		return mergeRequestPipelineLoadedMsg{mergeRequestIID: mergeRequestIID, data: data, err: err, requestID: requestID}
+	}
+}
+
+// currentPipelineDetails prefers the freshly loaded pipeline and falls back to
+// the list row while the detail request is in flight.
+func (m DashboardModel) currentPipelineDetails() (PipelineDetails, bool) {
+	if data, ok := m.pipelineDetailData[m.pipelineDetailID]; ok && data.Pipeline.ID > 0 {
+		return data.Pipeline, true
+	}
+	if item, ok := m.selectedPipelineItem(); ok && item.Pipeline.ID == m.pipelineDetailID {
+		return *item.Pipeline, true
+	}
+	return PipelineDetails{}, false
+}
+
+func (m DashboardModel) pipelineDetailJobs() []PipelineJob {
+	data, ok := m.pipelineDetailData[m.pipelineDetailID]
+	if !ok {
+		return nil
+	}
+	jobs := make([]PipelineJob, 0, 16)
+	for _, stage := range data.Stages {
+		jobs = append(jobs, stage.Jobs...)
+	}
+	return jobs
+}
+
+func (m DashboardModel) selectedPipelineJob() (PipelineJob, bool) {
+	jobs := m.pipelineDetailJobs()
+	if m.pipelineJobCursor < 0 || m.pipelineJobCursor >= len(jobs) {
+		return PipelineJob{}, false
+	}
+	return jobs[m.pipelineJobCursor], true
+}
+
+// firstFailedJobIndex points the cursor at the job most worth inspecting.
+func firstFailedJobIndex(data PipelineDetailData) int {
+	index := 0
+	for _, stage := range data.Stages {
+		for _, job := range stage.Jobs {
+			if job.Status == "failed" && !job.AllowFailure {
+				return index
+			}
+			index++
+		}
+	}
+	return 0
+}
+
+func (m DashboardModel) movePipelineJobCursor(delta int) DashboardModel {
+	jobs := m.pipelineDetailJobs()
+	if len(jobs) == 0 {
+		m.pipelineDetailScroll = m.clampPipelineDetailScroll(m.pipelineDetailScroll + delta)
+		return m
+	}
+	m.pipelineJobCursor = min(len(jobs)-1, max(0, m.pipelineJobCursor+delta))
+	return m.scrollPipelineJobIntoView()
+}
+
+// movePipelineJobStage jumps to the neighbouring stage, keeping the row when
+// that stage has enough jobs.
+func (m DashboardModel) movePipelineJobStage(delta int) DashboardModel {
+	data, ok := m.pipelineDetailData[m.pipelineDetailID]
+	if !ok || len(data.Stages) == 0 {
+		return m
+	}
+	offsets := make([]int, len(data.Stages))
+	stage, row, total := 0, 0, 0
+	for i, candidate := range data.Stages {
+		offsets[i] = total
+		if m.pipelineJobCursor >= total && m.pipelineJobCursor < total+len(candidate.Jobs) {
+			stage, row = i, m.pipelineJobCursor-total
+		}
+		total += len(candidate.Jobs)
+	}
+	for next := stage + delta; next >= 0 && next < len(data.Stages); next += delta {
+		if len(data.Stages[next].Jobs) == 0 {
+			continue
+		}
+		m.pipelineJobCursor = offsets[next] + min(row, len(data.Stages[next].Jobs)-1)
+		return m.scrollPipelineJobIntoView()
+	}
+	return m
+}
+
+func (m DashboardModel) scrollPipelineJobIntoView() DashboardModel {
+	contentWidth, bodyRows := m.pipelineDetailViewport()
+	_, row := m.pipelineDetailContent(contentWidth)
+	if row < 0 {
+		return m
+	}
+	if row < m.pipelineDetailScroll {
+		m.pipelineDetailScroll = row
+	} else if row >= m.pipelineDetailScroll+bodyRows {
+		m.pipelineDetailScroll = row - bodyRows + 1
+	}
+	m.pipelineDetailScroll = m.clampPipelineDetailScroll(m.pipelineDetailScroll)
+	return m
+}
+
+func (m DashboardModel) pipelineDetailViewport() (int, int) {
+	totalWidth := max(60, m.width-2)
+	contentHeight := max(8, m.height-5)
+	contentWidth := max(10, totalWidth-m.styles.panel.GetHorizontalFrameSize()-2)
+	bodyRows := max(1, contentHeight-m.styles.panel.GetVerticalFrameSize()-3)
+	return contentWidth, bodyRows
+}
+
+func (m DashboardModel) clampPipelineDetailScroll(next int) int {
+	contentWidth, bodyRows := m.pipelineDetailViewport()
+	lines := m.pipelineDetailLines(contentWidth)
+	maxScroll := max(0, len(lines)-bodyRows)
+	if next < 0 {
+		return 0
+	}
+	if next > maxScroll {
+		return maxScroll
+	}
+	return next
+}
+
+func (m DashboardModel) pipelineDetailLines(width int) []string {
+	lines, _ := m.pipelineDetailContent(width)
+	return lines
+}
+
+// pipelineDetailContent returns the detail lines together with the row of the
+// selected job, or -1 when no job is selectable.
+func (m DashboardModel) pipelineDetailContent(width int) ([]string, int) {
+	details, ok := m.currentPipelineDetails()
+	if !ok {
+		if !m.pipelineDetailFromMergeRequest {
+			return nil, -1
+		}
+		if m.pipelineDetailErr != "" {
+			return wrapLines([]string{
+				fmt.Sprintf("Failed to load merge request pipeline: %s", m.pipelineDetailErr),
+				"Press r to retry.",
+			}, width), -1
+		}
+		return wrapLines([]string{"Loading merge request pipeline..."}, width), -1
+	}
+	data, loaded := m.pipelineDetailData[details.ID]
+
+	lines := wrapLines([]string{
+		"Info:",
+		fmt.Sprintf("Pipeline: #%d", details.ID),
+		fmt.Sprintf("Status: %s", pipelineStatusLabel(details.Status)),
+		fmt.Sprintf("Ref: %s", fallbackValue(details.Ref, "-")),
+		fmt.Sprintf("SHA: %s", fallbackValue(details.SHA, "-")),
+		fmt.Sprintf("Source: %s", fallbackValue(details.Source, "-")),
+		fmt.Sprintf("Duration: %s", fallbackValue(details.Duration, "-")),
+		fmt.Sprintf("Triggered by: %s", fallbackValue(details.TriggeredBy, "-")),
+		fmt.Sprintf("Created: %s", fallbackValue(details.CreatedAt, "-")),
+		fmt.Sprintf("URL: %s", fallbackValue(details.URL, "-")),
+		"",
+		"Stages:",
+	}, width)
+
+	switch {
+	case m.pipelineDetailLoad:
+		return append(lines, "Loading pipeline jobs..."), -1
+	case m.pipelineDetailErr != "":
+		return append(lines, wrapLines([]string{
+			fmt.Sprintf("Failed to load pipeline jobs: %s", m.pipelineDetailErr),
+			"Press r to retry.",
+		}, width)...), -1
+	case !loaded || len(data.Stages) == 0:
+		return append(lines, "No jobs available."), -1
+	}
+
+	selectedJobID := int64(-1)
+	if job, ok := m.selectedPipelineJob(); ok {
+		selectedJobID = job.ID
+	}
+	grid, selectedRow := m.renderPipelineStageGrid(data.Stages, width, selectedJobID)
+	if selectedRow >= 0 {
+		selectedRow += len(lines)
+	}
+	return append(lines, grid...), selectedRow
+}
+
+// renderPipelineStageGrid lays stages out as columns with one job per row,
+// splitting into several bands when the terminal is too narrow for all stages.
+// It also reports the line holding the selected job so callers can scroll to it.
+func (m DashboardModel) renderPipelineStageGrid(stages []PipelineStage, width int, selectedJobID int64) ([]string, int) {
+	separatorWidth := lipgloss.Width(pipelineGridSeparator)
+	perBand := max(1, (width+separatorWidth)/(pipelineGridMinColumnWidth+separatorWidth))
+	lines := make([]string, 0, len(stages)*4)
+	selectedRow := -1
+
+	for bandStart := 0; bandStart < len(stages); bandStart += perBand {
+		bandEnd := min(len(stages), bandStart+perBand)
+		band := stages[bandStart:bandEnd]
+		columnWidth := max(4, (width-separatorWidth*(len(band)-1))/len(band))
+
+		if bandStart > 0 {
+			lines = append(lines, "")
+		}
+
+		headers := make([]string, 0, len(band))
+		rules := make([]string, 0, len(band))
+		rows := 0
+		for _, stage := range band {
+			header := m.pipelineStatusStyle(stage.Status).Render(pipelineStatusIcon(stage.Status)) + " " + m.styles.title.Render(stage.Name)
+			headers = append(headers, padToWidth(fitLine(header, columnWidth), columnWidth))
+			rules = append(rules, m.styles.dim.Render(strings.Repeat("─", columnWidth)))
+			rows = max(rows, len(stage.Jobs))
+		}
+		lines = append(lines, strings.Join(headers, m.styles.dim.Render(pipelineGridSeparator)))
+		lines = append(lines, strings.Join(rules, m.styles.dim.Render("─┼─")))
+
+		for row := 0; row < rows; row++ {
+			cells := make([]string, 0, len(band))
+			for _, stage := range band {
+				if row >= len(stage.Jobs) {
+					cells = append(cells, strings.Repeat(" ", columnWidth))
+					continue
+				}
+				job := stage.Jobs[row]
+				if job.ID == selectedJobID {
+					selectedRow = len(lines)
+				}
+				cells = append(cells, padToWidth(fitLine(m.pipelineJobCell(job, job.ID == selectedJobID), columnWidth), columnWidth))
+			}
+			lines = append(lines, strings.Join(cells, m.styles.dim.Render(pipelineGridSeparator)))
+		}
+	}
+
+	return lines, selectedRow
+}
+
+func (m DashboardModel) pipelineJobCell(job PipelineJob, selected bool) string {
+	icon := m.pipelineStatusStyle(job.Status).Render(pipelineStatusIcon(job.Status))
+	name := job.Name
+	if selected {
+		name = m.styles.selectedRow.Reverse(true).Render(name)
+	}
+	cell := icon + " " + name
+	if job.AllowFailure && job.Status == "failed" {
+		cell += m.styles.dim.Render(" (allowed)")
+	}
+	if duration := strings.TrimSpace(job.Duration); duration != "" && duration != "-" {
+		cell += m.styles.dim.Render(" " + duration)
+	}
+	return cell
+}
+
+func (m DashboardModel) renderPipelineDetailFullscreen(width int, height int) string {
+	contentWidth := max(10, width-6)
+	viewportWidth := max(8, contentWidth-2)
+	lines := []string{
+		m.styles.header.Render("Pipeline Detail"),
+		m.styles.dim.Render("Esc return | j/k h/l select job | enter job log | pgup/pgdown scroll | r refresh"),
+		"",
+	}
+	detailLines := m.pipelineDetailLines(viewportWidth)
+	if len(detailLines) == 0 {
+		lines = append(lines, m.styles.dim.Render("No pipeline details available"))
+		innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+		lines = fitHeight(lines, innerHeight)
+		return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+	}
+
+	bodyRows := max(1, height-len(lines)-2)
+	maxScroll := max(0, len(detailLines)-bodyRows)
+	start := m.pipelineDetailScroll
+	if start > maxScroll {
+		start = maxScroll
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := min(len(detailLines), start+bodyRows)
+	lines = append(lines, withVerticalScroll(detailLines[start:end], viewportWidth, start, bodyRows, len(detailLines))...)
+	if len(detailLines) > bodyRows {
+		footer := fmt.Sprintf("%d-%d of %d", start+1, end, len(detailLines))
+		lines = append(lines, m.styles.dim.Render(fitLine(footer, contentWidth)))
+	}
+
+	innerHeight := max(1, height-m.styles.panel.GetVerticalFrameSize())
+	lines = fitHeight(lines, innerHeight)
+	return renderSizedBox(m.styles.panel, width, height, strings.Join(lines, "\n"))
+}
+
+func pipelineListMeta(item ListItem) string {
+	if item.Pipeline == nil {
+		return fallbackValue(strings.TrimSpace(item.Subtitle), "-")
+	}
+	sha := item.Pipeline.SHA
+	if len(sha) > 8 {
+		sha = sha[:8]
+	}
+	return fmt.Sprintf(
+		"%s | %s | %s | by %s",
+		padOrTrimRight(pipelineStatusLabel(item.Pipeline.Status), 14),
+		fallbackValue(sha, "-"),
+		padOrTrimRight(fallbackValue(item.Pipeline.Duration, "-"), 11),
+		fallbackValue(item.Pipeline.TriggeredBy, "-"),
+	)
+}
+
+func pipelineStatusIcon(status string) string {
+	switch status {
+	case "success":
+		return "✔"
+	case "failed":
+		return "✖"
+	case "running":
+		return "●"
+	case "pending", "created", "preparing", "waiting_for_resource", "scheduled":
+		return "○"
+	case "canceled":
+		return "⊘"
+	case "skipped":
+		return "»"
+	case "manual":
+		return "▶"
+	default:
+		return "?"
+	}
+}
+
+func pipelineStatusLabel(status string) string {
+	trimmed := strings.TrimSpace(status)
+	if trimmed == "" {
+		return "-"
+	}
+	return pipelineStatusIcon(trimmed) + " " + strings.ReplaceAll(trimmed, "_", " ")
+}
+
+func (m DashboardModel) pipelineStatusStyle(status string) lipgloss.Style {
+	switch status {
+	case "success":
+		return m.styles.statusSuccess
+	case "failed":
+		return m.styles.statusFailed
+	case "running", "pending", "preparing", "waiting_for_resource":
+		return m.styles.statusRunning
+	default:
+		return m.styles.dim
+	}
+}
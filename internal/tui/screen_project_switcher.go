@@ -0,0 +1,210 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type projectOptionsLoadedMsg struct {
+	options []ProjectOption
+	err     error
+}
+
+type projectFavoriteToggledMsg struct {
+	index    int
+	option   ProjectOption
+	favorite bool
+	err      error
+}
+
+type projectSwitchedMsg struct {
+	option   ProjectOption
+	provider DataProvider
+	ctx      DashboardContext
+	err      error
+}
+
+// openProjectSwitcher loads the projects of every configured instance for the
+// switcher picker.
+func (m DashboardModel) openProjectSwitcher() (tea.Model, tea.Cmd) {
+	switcher := m.ctx.Switcher
+	if switcher == nil {
+		m.notice = "project switching is not available"
+		return m, nil
+	}
+	m.notice = "loading projects..."
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		options, err := switcher.LoadProjectOptions(ctx)
+		return projectOptionsLoadedMsg{options: options, err: err}
+	}
+}
+
+func (m DashboardModel) applyProjectOptionsLoaded(msg projectOptionsLoadedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("load projects: %v", msg.err)
+		return m, nil
+	}
+	if len(msg.options) == 0 {
+		m.notice = "no projects to switch to"
+		return m, nil
+	}
+	m.projectOptions = msg.options
+	choices := make([]pickerOption, 0, len(msg.options))
+	current := ""
+	for i, option := range msg.options {
+		value := strconv.Itoa(i)
+		if current == "" && option.Path == m.ctx.ProjectPath && option.Host == m.ctx.Host {
+			current = value
+		}
+		choices = append(choices, pickerOption{value: value, label: option.Path, detail: projectOptionDetail(option)})
+	}
+	m.picker = newFuzzyPicker("Switch project", choices, false, []string{current})
+	m.picker.hint = "enter: switch | ctrl+f: pin/unpin | esc: cancel"
+	m.pickerPurpose = pickerSwitchProject
+	m.pickerActive = true
+	return m, nil
+}
+
+// toggleFavoriteProject pins or unpins the highlighted project. The picker
+// keeps its order; pinned projects move to the top the next time it opens.
+func (m DashboardModel) toggleFavoriteProject() (tea.Model, tea.Cmd) {
+	option, ok := m.picker.highlighted()
+	if !ok {
+		return m, nil
+	}
+	index, err := strconv.Atoi(option.value)
+	if err != nil || index < 0 || index >= len(m.projectOptions) {
+		return m, nil
+	}
+	project := m.projectOptions[index]
+	switcher := m.ctx.Switcher
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		favorite, err := switcher.ToggleFavorite(ctx, project)
+		return projectFavoriteToggledMsg{index: index, option: project, favorite: favorite, err: err}
+	}
+}
+
+func (m DashboardModel) applyProjectFavoriteToggled(msg projectFavoriteToggledMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("pin %s: %v", msg.option.Path, msg.err)
+		return m, nil
+	}
+	if msg.favorite {
+		m.notice = "pinned " + msg.option.Path
+	} else {
+		m.notice = "unpinned " + msg.option.Path
+	}
+	if !m.pickerActive || m.pickerPurpose != pickerSwitchProject || msg.index >= len(m.projectOptions) {
+		return m, nil
+	}
+	m.projectOptions = slices.Clone(m.projectOptions)
+	m.projectOptions[msg.index].Favorite = msg.favorite
+	m.picker.options = slices.Clone(m.picker.options)
+	for i, option := range m.picker.options {
+		if option.value == strconv.Itoa(msg.index) {
+			m.picker.options[i].detail = projectOptionDetail(m.projectOptions[msg.index])
+		}
+	}
+	return m, nil
+}
+
+func (m DashboardModel) applyProjectPicked(values []string) (tea.Model, tea.Cmd) {
+	if len(values) != 1 {
+		return m, nil
+	}
+	index, err := strconv.Atoi(values[0])
+	if err != nil || index < 0 || index >= len(m.projectOptions) {
+		return m, nil
+	}
+	option := m.projectOptions[index]
+	m.projectOptions = nil
+	if option.Path == m.ctx.ProjectPath && option.Host == m.ctx.Host {
+		return m, nil
+	}
+	m.notice = fmt.Sprintf("switching to %s...", option.Path)
+	switcher := m.ctx.Switcher
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		provider, dashboardCtx, err := switcher.SwitchProject(ctx, option)
+		return projectSwitchedMsg{option: option, provider: provider, ctx: dashboardCtx, err: err}
+	}
+}
+
+// applyProjectSwitched swaps in the new provider and context and reloads the
+// current view.
+func (m DashboardModel) applyProjectSwitched(msg projectSwitchedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("switch to %s: %v", msg.option.Path, msg.err)
+		return m, nil
+	}
+	m = m.resetProjectState()
+	m.provider = msg.provider
+	m.ctx = msg.ctx
+	if m.view == PrimaryView {
+		m.view = IssuesView
+	}
+	m.selected = 0
+	model, cmd := m.startLoadCurrentView()
+	updated := model.(DashboardModel)
+	updated.notice = "switched to " + msg.ctx.ProjectPath
+	return updated, cmd
+}
+
+func projectOptionDetail(option ProjectOption) string {
+	switch {
+	case option.Favorite:
+		return "★ pinned • " + option.HostLabel
+	case option.Recent:
+		return "recent • " + option.HostLabel
+	default:
+		return option.HostLabel
+	}
+}
+
+// switchProject points the dashboard at another project of the same
+// instance.
+func (m DashboardModel) switchProject(projectPath string) DashboardModel {
+	return m.useProject(projectPath, m.provider.ForProject(projectPath))
+}
+
+// useProject points the dashboard at projectPath through a provider already
+// made for it, for callers that checked the project before moving there.
+func (m DashboardModel) useProject(projectPath string, provider DataProvider) DashboardModel {
+	m = m.resetProjectState()
+	m.provider = provider
+	m.ctx.ProjectPath = projectPath
+	return m
+}
+
+// resetProjectState drops everything cached per issue, merge request or
+// pipeline, the open milestone and the board, which all belong to the
+// project being left.
+func (m DashboardModel) resetProjectState() DashboardModel {
+	m.issueFormOptionsReady = false
+	m.milestone = nil
+	m.boards, m.boardID, m.boardColumns = nil, 0, nil
+	m.boardColumn, m.boardRow = 0, 0
+	m.mergeRequestFormReady = false
+	clear(m.detailData)
+	clear(m.detailCache)
+	clear(m.markdownBody)
+	clear(m.mergeRequestDetailData)
+	clear(m.mergeRequestDiffs)
+	clear(m.mergeRequestDiffStats)
+	clear(m.diffCache)
+	clear(m.pipelineDetailData)
+	clear(m.jobLogCache)
+	return m
+}
@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type savedViewSavedMsg struct {
+	view  SavedView
+	views []SavedView
+	err   error
+}
+
+// savedViewKeys are the number keys of the first saved views, after the
+// keys of the built-in views.
+var savedViewKeys = []string{"8", "9", "0"}
+
+var savedViewKeyHints = []string{
+	"V: save the list with its state, filter and sort as a view",
+	"v (anywhere): pick a saved view; 8/9/0 open the first three",
+}
+
+func loadSavedViews(store ViewStore) []SavedView {
+	if store == nil {
+		return nil
+	}
+	return store.SavedViews()
+}
+
+// currentView describes the issue or merge request list as it is shown now.
+func (m DashboardModel) currentView(name string) SavedView {
+	if m.view == MergeRequestsView {
+		return SavedView{Name: name, View: MergeRequestsView, State: string(m.mergeRequestState), Query: m.mergeRequestSearch, Sort: m.mergeRequestSort}
+	}
+	return SavedView{Name: name, View: IssuesView, State: string(m.issueState), Query: m.issueSearch, Sort: m.issueSort}
+}
+
+func (m DashboardModel) openViewSave() (tea.Model, tea.Cmd) {
+	if m.ctx.Views == nil {
+		m.notice = "saving views is not available"
+		return m, nil
+	}
+	return m.openNamePrompt(namePromptView)
+}
+
+func (m DashboardModel) saveCurrentView(name string) (tea.Model, tea.Cmd) {
+	view := m.currentView(name)
+	store := m.ctx.Views
+	m.notice = fmt.Sprintf("saving view %q...", name)
+	return m, func() tea.Msg {
+		err := store.SaveView(view)
+		return savedViewSavedMsg{view: view, views: store.SavedViews(), err: err}
+	}
+}
+
+func (m DashboardModel) applySavedViewSaved(msg savedViewSavedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	m.savedViews = msg.views
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("save view %q: %v", msg.view.Name, msg.err)
+		return m, nil
+	}
+	m.notice = fmt.Sprintf("saved view %q", msg.view.Name)
+	index := slices.IndexFunc(m.savedViews, func(view SavedView) bool {
+		return strings.EqualFold(view.Name, msg.view.Name)
+	})
+	if index >= 0 && index < len(savedViewKeys) {
+		m.notice += fmt.Sprintf(", press %s to open it", savedViewKeys[index])
+		return m, nil
+	}
+	m.notice += fmt.Sprintf("; only the first %d views get a number key, press v to open it", len(savedViewKeys))
+	return m, nil
+}
+
+// openSavedViewKey opens the saved view bound to one of savedViewKeys.
+func (m DashboardModel) openSavedViewKey(key string) (tea.Model, tea.Cmd) {
+	index := slices.Index(savedViewKeys, key)
+	if index < 0 || index >= len(m.savedViews) {
+		m.notice = fmt.Sprintf("no saved view on %s; press V in the issue or merge request list to save one", key)
+		return m, nil
+	}
+	return m.openSavedView(m.savedViews[index])
+}
+
+func (m DashboardModel) openSavedView(view SavedView) (tea.Model, tea.Cmd) {
+	if view.View == MergeRequestsView {
+		m.view = MergeRequestsView
+		m.mergeRequestState = MergeRequestState(fallbackValue(view.State, string(MergeRequestStateOpened)))
+		m.mergeRequestSearch = view.Query
+		m.mergeRequestSort = view.Sort
+	} else {
+		m.view = IssuesView
+		m.issueState = IssueState(fallbackValue(view.State, string(IssueStateOpened)))
+		m.issueSearch = view.Query
+		m.issueSort = view.Sort
+	}
+	m.filterErr = ""
+	m.selected = 0
+	model, cmd := m.startLoadCurrentView()
+	updated := model.(DashboardModel)
+	updated.notice = "view " + view.Name
+	return updated, cmd
+}
+
+func (m DashboardModel) openSavedViewPicker() (tea.Model, tea.Cmd) {
+	if len(m.savedViews) == 0 {
+		m.notice = "no saved views; press V in the issue or merge request list to save one"
+		return m, nil
+	}
+	choices := make([]pickerOption, 0, len(m.savedViews))
+	for i, view := range m.savedViews {
+		label := view.Name
+		if i < len(savedViewKeys) {
+			label = savedViewKeys[i] + ". " + label
+		}
+		choices = append(choices, pickerOption{value: strconv.Itoa(i), label: label, detail: savedViewSummary(view)})
+	}
+	m.picker = newFuzzyPicker("Saved views", choices, false, nil)
+	m.pickerPurpose = pickerSavedView
+	m.pickerActive = true
+	return m, nil
+}
+
+func (m DashboardModel) applySavedViewPicked(values []string) (tea.Model, tea.Cmd) {
+	if len(values) != 1 {
+		return m, nil
+	}
+	index, err := strconv.Atoi(values[0])
+	if err != nil || index < 0 || index >= len(m.savedViews) {
+		return m, nil
+	}
+	return m.openSavedView(m.savedViews[index])
+}
+
+// savedViewNavLines lists the saved views of a list view for the sidebar,
+// below the view's own entry.
+func (m DashboardModel) savedViewNavLines(view ViewMode, width int) []string {
+	active := m.activeSavedView()
+	var lines []string
+	for i, saved := range m.savedViews {
+		if saved.View != view {
+			continue
+		}
+		label := "   " + saved.Name
+		if i < len(savedViewKeys) {
+			label = savedViewKeys[i] + ". " + saved.Name
+		}
+		label = "  " + fitLine(label, max(1, width-8))
+		if i == active {
+			lines = append(lines, m.styles.sidebarActive.Render("› "+label))
+			continue
+		}
+		lines = append(lines, m.styles.sidebar.Render("  "+label))
+	}
+	return lines
+}
+
+// activeSavedView returns the index of the first saved view matching the
+// list on screen, or -1.
+func (m DashboardModel) activeSavedView() int {
+	if m.view != IssuesView && m.view != MergeRequestsView {
+		return -1
+	}
+	current := m.currentView("")
+	return slices.IndexFunc(m.savedViews, func(saved SavedView) bool {
+		saved.Name = ""
+		return saved == current
+	})
+}
+
+// savedViewSummary describes a saved view, such as "issues • open • label:bug".
+func savedViewSummary(view SavedView) string {
+	parts := []string{"issues", issueStateLabel(IssueState(view.State))}
+	if view.View == MergeRequestsView {
+		parts = []string{"merge requests", mergeRequestStateLabel(MergeRequestState(view.State))}
+	}
+	if view.Query != "" {
+		parts = append(parts, view.Query)
+	}
+	parts = append(parts, "sort: "+listSortLabel(view.Sort))
+	return strings.Join(parts, " • ")
+}
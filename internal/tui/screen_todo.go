@@ -0,0 +1,325 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type todoDoneMsg struct {
+	todoID int64
+	all    bool
+	err    error
+}
+
+type todoTargetLoadedMsg struct {
+	todo     TodoDetails
+	item     ListItem
+	provider DataProvider
+	err      error
+}
+
+var todoKeyHints = []string{
+	"enter: open the issue or merge request",
+	"d: mark done",
+	"D: mark all done (press twice)",
+	"[: prev filter",
+	"]: next filter",
+	"r: refresh todos",
+}
+
+var todoActionFilters = []TodoAction{
+	TodoActionAll,
+	TodoActionAssigned,
+	TodoActionMentioned,
+	TodoActionReviewRequested,
+	TodoActionBuildFailed,
+}
+
+func (m DashboardModel) handleTodoScreenKey(key string) (tea.Model, tea.Cmd, bool) {
+	if m.view != TodosView {
+		return m, nil, false
+	}
+	if key != "D" {
+		m.todoMarkAllArmed = false
+	}
+
+	switch key {
+	case "enter":
+		if item, ok := m.selectedTodoItem(); ok {
+			model, cmd := m.openTodoTarget(*item.Todo)
+			return model, cmd, true
+		}
+	case "d":
+		if item, ok := m.selectedTodoItem(); ok {
+			m.notice = fmt.Sprintf("marking %s done...", todoReference(*item.Todo))
+			todoID := item.Todo.ID
+			provider := m.provider
+			return m, func() tea.Msg {
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				return todoDoneMsg{todoID: todoID, err: provider.MarkTodoDone(ctx, todoID)}
+			}, true
+		}
+	case "D":
+		if len(m.items) == 0 {
+			return m, nil, true
+		}
+		if !m.todoMarkAllArmed {
+			m.todoMarkAllArmed = true
+			m.notice = "press D again to mark all todos done"
+			return m, nil, true
+		}
+		m.todoMarkAllArmed = false
+		m.notice = "marking all todos done..."
+		provider := m.provider
+		return m, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			return todoDoneMsg{all: true, err: provider.MarkAllTodosDone(ctx)}
+		}, true
+	case "[":
+		m.todoAction = cycleTodoAction(m.todoAction, -1)
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "]":
+		m.todoAction = cycleTodoAction(m.todoAction, 1)
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	case "r":
+		m.selected = 0
+		model, cmd := m.startLoadCurrentView()
+		return model, cmd, true
+	}
+
+	return m, nil, false
+}
+
+// applyTodoDone drops the finished todos from the list instead of reloading
+// it, so the selection stays where it was.
+func (m DashboardModel) applyTodoDone(msg todoDoneMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil {
+		if msg.all {
+			m.errorMessage = fmt.Sprintf("mark all todos done: %v", msg.err)
+		} else {
+			m.errorMessage = fmt.Sprintf("mark todo %d done: %v", msg.todoID, msg.err)
+		}
+		return m, nil
+	}
+	if m.view != TodosView {
+		return m, nil
+	}
+	if msg.all {
+		m.items = nil
+		m.selected = 0
+		m.todoHasNext = false
+		m.notice = "all todos done"
+		return m, nil
+	}
+	for i, item := range m.items {
+		if item.Todo != nil && item.Todo.ID == msg.todoID {
+			m.notice = fmt.Sprintf("marked %s done", todoReference(*item.Todo))
+			m.items = slices.Delete(slices.Clone(m.items), i, i+1)
+			break
+		}
+	}
+	if m.selected >= len(m.items) {
+		m.selected = max(0, len(m.items)-1)
+	}
+	return m, nil
+}
+
+// openTodoTarget loads the issue or merge request a todo points at. The
+// dashboard switches to the todo's project first when it lives elsewhere.
+func (m DashboardModel) openTodoTarget(todo TodoDetails) (tea.Model, tea.Cmd) {
+	// The target is loaded through a provider for its project, and the
+	// dashboard only moves there once it has loaded.
+	provider := m.provider
+	if todo.ProjectPath != "" && todo.ProjectPath != m.ctx.ProjectPath {
+		provider = provider.ForProject(todo.ProjectPath)
+	}
+	m.notice = fmt.Sprintf("opening %s...", todoReference(todo))
+	return m, func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		msg := todoTargetLoadedMsg{todo: todo, provider: provider}
+		if todo.TargetType == TodoTargetMergeRequest {
+			msg.item, msg.err = provider.LoadMergeRequest(ctx, todo.TargetIID)
+		} else {
+			msg.item, msg.err = provider.LoadIssue(ctx, todo.TargetIID)
+		}
+		return msg
+	}
+}
+
+func (m DashboardModel) applyTodoTargetLoaded(msg todoTargetLoadedMsg) (tea.Model, tea.Cmd) {
+	m.notice = ""
+	if msg.err != nil {
+		m.errorMessage = fmt.Sprintf("open %s: %v", todoReference(msg.todo), msg.err)
+		return m, nil
+	}
+	if path := msg.todo.ProjectPath; path != "" && path != m.ctx.ProjectPath {
+		m = m.useProject(path, msg.provider)
+	}
+	return m.jumpToItem(msg.todo.ProjectPath, msg.item)
+}
+
+// jumpToItem switches to the issue or merge request list of projectPath. The
+// item is selected and opened once the list has loaded.
+func (m DashboardModel) jumpToItem(projectPath string, item ListItem) (tea.Model, tea.Cmd) {
+	if item.Issue == nil && item.MergeRequest == nil {
+		return m, nil
+	}
+	if projectPath != "" && projectPath != m.ctx.ProjectPath {
+		m = m.switchProject(projectPath)
+	}
+	if item.MergeRequest != nil {
+		m.view = MergeRequestsView
+	} else {
+		m.view = IssuesView
+	}
+	m.selected = 0
+	m.jumpTarget = &item
+	return m.startLoadCurrentView()
+}
+
+// openJumpTarget selects the jump target in the freshly loaded list, adding
+// it on top when the current state filter or page leaves it out, and opens
+// its details.
+func (m DashboardModel) openJumpTarget() (tea.Model, tea.Cmd) {
+	target := *m.jumpTarget
+	m.jumpTarget = nil
+	index := slices.IndexFunc(m.items, func(item ListItem) bool {
+		switch {
+		case target.Issue != nil:
+			return item.Issue != nil && item.Issue.IID == target.Issue.IID
+		case target.MergeRequest != nil:
+			return item.MergeRequest != nil && item.MergeRequest.IID == target.MergeRequest.IID
+		}
+		return false
+	})
+	if index < 0 {
+		m.items = append([]ListItem{target}, m.items...)
+		index = 0
+	}
+	m.selected = index
+
+	var model tea.Model = m
+	var cmd tea.Cmd
+	if m.view == MergeRequestsView {
+		model, cmd, _ = m.handleMergeRequestScreenKey("enter")
+	} else {
+		model, cmd, _ = m.handleIssueScreenKey("enter")
+	}
+	return model, cmd
+}
+
+func (m DashboardModel) renderTodoBody(width int) []string {
+	lines := []string{
+		" " + m.renderTodoTabs(max(20, width-8)),
+		m.styles.dim.Render(" sort: newest first"),
+		"",
+	}
+	for _, hint := range todoKeyHints {
+		lines = append(lines, m.styles.dim.Render(" "+hint))
+	}
+	return lines
+}
+
+func (m DashboardModel) renderTodoTabs(width int) string {
+	parts := make([]string, 0, len(todoActionFilters))
+	for _, action := range todoActionFilters {
+		label := todoActionLabel(action)
+		if action == m.todoAction {
+			parts = append(parts, m.styles.selectedRow.Render("["+label+"]"))
+			continue
+		}
+		parts = append(parts, m.styles.dim.Render(label))
+	}
+	return fitLine(strings.Join(parts, "  "), width)
+}
+
+func (m DashboardModel) hasTodoSelection() bool {
+	if m.view != TodosView {
+		return false
+	}
+	if len(m.items) == 0 || m.selected < 0 || m.selected >= len(m.items) {
+		return false
+	}
+	return m.items[m.selected].Todo != nil
+}
+
+func (m DashboardModel) selectedTodoItem() (ListItem, bool) {
+	if !m.hasTodoSelection() {
+		return ListItem{}, false
+	}
+	return m.items[m.selected], true
+}
+
+func (m DashboardModel) shouldLoadMoreTodos() bool {
+	if m.view != TodosView || m.loading || m.loadingMore || !m.todoHasNext {
+		return false
+	}
+	if len(m.items) == 0 {
+		return false
+	}
+	return m.selected >= len(m.items)-2
+}
+
+func todoListMeta(item ListItem) string {
+	if item.Todo == nil {
+		return fallbackValue(strings.TrimSpace(item.Subtitle), "-")
+	}
+	todo := item.Todo
+	parts := []string{
+		todo.ProjectPath + todoReference(*todo),
+		todoActionLabel(todo.Action),
+	}
+	if todo.Author != "" {
+		parts = append(parts, "by "+todo.Author)
+	}
+	if todo.TargetState != "" && todo.TargetState != "opened" {
+		parts = append(parts, todo.TargetState)
+	}
+	if todo.CreatedAt != "" {
+		parts = append(parts, todo.CreatedAt)
+	}
+	return strings.Join(parts, " • ")
+}
+
+func todoReference(todo TodoDetails) string {
+	if todo.TargetType == TodoTargetMergeRequest {
+		return fmt.Sprintf("!%d", todo.TargetIID)
+	}
+	return fmt.Sprintf("#%d", todo.TargetIID)
+}
+
+func todoActionLabel(action TodoAction) string {
+	switch action {
+	case TodoActionAssigned:
+		return "Assigned"
+	case TodoActionMentioned:
+		return "Mentioned"
+	case TodoActionReviewRequested:
+		return "Review requested"
+	case TodoActionBuildFailed:
+		return "Pipeline failed"
+	case TodoActionAll:
+		return "All"
+	default:
+		return strings.ReplaceAll(string(action), "_", " ")
+	}
+}
+
+func cycleTodoAction(current TodoAction, step int) TodoAction {
+	index := max(0, slices.Index(todoActionFilters, current))
+	n := len(todoActionFilters)
+	return todoActionFilters[((index+step)%n+n)%n]
+}
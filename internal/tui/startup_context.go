@@ -3,6 +3,7 @@ package tui
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/spinner"
@@ -24,15 +25,22 @@ type StartupContextChoice struct {
 	ProjectPath string
 }
 
+// StartupProjectOption is a project in the startup project list. Favorite
+// and recent projects are listed first.
 type StartupProjectOption struct {
-	Path  string
-	Label string
+	Path     string
+	Label    string
+	Favorite bool
+	Recent   bool
 }
 
+// StartupContextFlowOptions configures the startup flow. ToggleFavorite pins
+// or unpins a project of an instance and reports whether it is pinned now.
 type StartupContextFlowOptions struct {
-	LastProject  string
-	Instances    []InstanceOption
-	LoadProjects func(instance InstanceOption) ([]StartupProjectOption, error)
+	LastProject    string
+	Instances      []InstanceOption
+	LoadProjects   func(instance InstanceOption) ([]StartupProjectOption, error)
+	ToggleFavorite func(instance InstanceOption, projectPath string) (bool, error)
 }
 
 type startupStage int
@@ -50,6 +58,12 @@ type startupProjectsLoadedMsg struct {
 	err       error
 }
 
+type startupFavoriteToggledMsg struct {
+	path     string
+	favorite bool
+	err      error
+}
+
 type startupOption struct {
 	action StartupAction
 	label  string
@@ -74,6 +88,7 @@ type startupContextModel struct {
 	requestSeq       int
 	requestID        int
 	loadProjects     func(instance InstanceOption) ([]StartupProjectOption, error)
+	toggleFavorite   func(instance InstanceOption, projectPath string) (bool, error)
 	errMessage       string
 	choice           StartupContextChoice
 	cancelled        bool
@@ -137,15 +152,16 @@ func newStartupContextModel(opts StartupContextFlowOptions) startupContextModel
 	}
 
 	return startupContextModel{
-		stage:        startupStageChoice,
-		lastProject:  trimmedLastProject,
-		options:      options,
-		instances:    instances,
-		searchInput:  search,
-		spinner:      sp,
-		requestSeq:   1,
-		requestID:    1,
-		loadProjects: opts.LoadProjects,
+		stage:          startupStageChoice,
+		lastProject:    trimmedLastProject,
+		options:        options,
+		instances:      instances,
+		searchInput:    search,
+		spinner:        sp,
+		requestSeq:     1,
+		requestID:      1,
+		loadProjects:   opts.LoadProjects,
+		toggleFavorite: opts.ToggleFavorite,
 	}
 }
 
@@ -193,6 +209,9 @@ func (m startupContextModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.applyProjectFilter()
 		m.errMessage = ""
 		return m, nil
+
+	case startupFavoriteToggledMsg:
+		return m.applyFavoriteToggled(msg), nil
 	}
 
 	switch msg := msg.(type) {
@@ -338,6 +357,17 @@ func (m startupContextModel) updateProject(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 			m.projectSelected--
 		}
 		return m, nil
+	case "ctrl+f":
+		if len(m.filteredProjects) == 0 || m.toggleFavorite == nil {
+			return m, nil
+		}
+		instance := m.selectedInstance
+		path := m.filteredProjects[m.projectSelected].Path
+		toggle := m.toggleFavorite
+		return m, func() tea.Msg {
+			favorite, err := toggle(instance, path)
+			return startupFavoriteToggledMsg{path: path, favorite: favorite, err: err}
+		}
 	case "enter":
 		if len(m.filteredProjects) == 0 {
 			return m, nil
@@ -367,6 +397,31 @@ func (m startupContextModel) loadProjectsCmd(instance InstanceOption, requestID
 	}
 }
 
+// applyFavoriteToggled moves a pinned project up with the other favourites,
+// or an unpinned one back down, and keeps it selected.
+func (m startupContextModel) applyFavoriteToggled(msg startupFavoriteToggledMsg) startupContextModel {
+	if msg.err != nil {
+		m.errMessage = fmt.Sprintf("Could not update favourites: %v", msg.err)
+		return m
+	}
+	m.errMessage = ""
+	projects := append([]StartupProjectOption(nil), m.projects...)
+	for i := range projects {
+		if projects[i].Path == msg.path {
+			projects[i].Favorite = msg.favorite
+		}
+	}
+	m.projects = sortStartupProjects(projects)
+	m.applyProjectFilter()
+	for i, project := range m.filteredProjects {
+		if project.Path == msg.path {
+			m.projectSelected = i
+			break
+		}
+	}
+	return m
+}
+
 func (m *startupContextModel) applyProjectFilter() {
 	if len(m.projects) == 0 {
 		m.filteredProjects = nil
@@ -402,21 +457,49 @@ func normalizeStartupProjects(projects []StartupProjectOption) []StartupProjectO
 		return nil
 	}
 
-	seen := make(map[string]struct{}, len(projects))
+	// A project listed more than once, say as a favourite and as a recent
+	// project, keeps its first position and the flags of every listing.
+	seen := make(map[string]int, len(projects))
 	normalized := make([]StartupProjectOption, 0, len(projects))
 	for _, project := range projects {
 		path := strings.TrimSpace(project.Path)
 		if path == "" {
 			continue
 		}
-		if _, exists := seen[path]; exists {
+		if index, exists := seen[path]; exists {
+			normalized[index].Favorite = normalized[index].Favorite || project.Favorite
+			normalized[index].Recent = normalized[index].Recent || project.Recent
 			continue
 		}
-		seen[path] = struct{}{}
-		normalized = append(normalized, StartupProjectOption{Path: path, Label: strings.TrimSpace(project.Label)})
+		seen[path] = len(normalized)
+		normalized = append(normalized, StartupProjectOption{
+			Path:     path,
+			Label:    strings.TrimSpace(project.Label),
+			Favorite: project.Favorite,
+			Recent:   project.Recent,
+		})
 	}
 
-	return normalized
+	return sortStartupProjects(normalized)
+}
+
+// sortStartupProjects lists favourites first, then recent projects, keeping
+// the given order within each group.
+func sortStartupProjects(projects []StartupProjectOption) []StartupProjectOption {
+	rank := func(project StartupProjectOption) int {
+		switch {
+		case project.Favorite:
+			return 0
+		case project.Recent:
+			return 1
+		default:
+			return 2
+		}
+	}
+	slices.SortStableFunc(projects, func(a StartupProjectOption, b StartupProjectOption) int {
+		return rank(a) - rank(b)
+	})
+	return projects
 }
 
 func startupProjectLabel(project StartupProjectOption) string {
@@ -551,14 +634,23 @@ func (m startupContextModel) renderProjectView() string {
 		const windowSize = 12
 		start, end := visibleRange(len(m.filteredProjects), m.projectSelected, windowSize)
 		for i := start; i < end; i++ {
-			project := startupProjectLabel(m.filteredProjects[i])
+			option := m.filteredProjects[i]
+			project := startupProjectLabel(option)
 			prefix := "  "
 			style := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
 			if i == m.projectSelected {
 				prefix = "› "
 				style = lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Bold(true)
 			}
-			rows = append(rows, style.Render(prefix+project))
+			marker := "  "
+			if option.Favorite {
+				marker = "★ "
+			}
+			row := style.Render(prefix + marker + project)
+			if option.Recent && !option.Favorite {
+				row += lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render("  recent")
+			}
+			rows = append(rows, row)
 		}
 
 		if len(m.filteredProjects) > windowSize {
@@ -566,7 +658,7 @@ func (m startupContextModel) renderProjectView() string {
 		}
 	}
 
-	rows = append(rows, "", "Type to filter | Enter select | arrows/tab move | Esc back | Ctrl+C cancel")
+	rows = append(rows, "", "Type to filter | Enter select | arrows/tab move | Ctrl+F pin/unpin | Esc back | Ctrl+C cancel")
 
 	box := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -212,3 +213,89 @@ func TestStartupContextProjectQIsSearchInputNotCancel(t *testing.T) {
 		t.Fatalf("search value = %q want %q", model.searchInput.Value(), "q")
 	}
 }
+
+func TestStartupContextListsFavoritesFirstAndPins(t *testing.T) {
+	t.Parallel()
+
+	var toggled []string
+	m := newStartupContextModel(StartupContextFlowOptions{
+		Instances: []InstanceOption{{Host: "https://gitlab.com/api/v4", Label: "gitlab.com"}},
+		ToggleFavorite: func(_ InstanceOption, projectPath string) (bool, error) {
+			toggled = append(toggled, projectPath)
+			return true, nil
+		},
+	})
+	m.stage = startupStageLoadingProjects
+	updated, _ := m.Update(startupProjectsLoadedMsg{requestID: m.requestID, projects: []StartupProjectOption{
+		{Path: "group/alpha"},
+		{Path: "group/recent", Recent: true},
+		{Path: "group/pinned", Favorite: true},
+		{Path: "group/zeta"},
+	}})
+	model := updated.(startupContextModel)
+
+	paths := func(model startupContextModel) []string {
+		out := make([]string, 0, len(model.filteredProjects))
+		for _, project := range model.filteredProjects {
+			out = append(out, project.Path)
+		}
+		return out
+	}
+	if got := strings.Join(paths(model), ","); got != "group/pinned,group/recent,group/alpha,group/zeta" {
+		t.Fatalf("project order = %s want favourites, then recent, then the rest", got)
+	}
+
+	model.projectSelected = 3
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if cmd == nil {
+		t.Fatal("expected ctrl+f to toggle the favourite")
+	}
+	updated, _ = updated.Update(cmd())
+	model = updated.(startupContextModel)
+	if len(toggled) != 1 || toggled[0] != "group/zeta" {
+		t.Fatalf("toggled = %v want group/zeta", toggled)
+	}
+	if got := strings.Join(paths(model), ","); got != "group/pinned,group/zeta,group/recent,group/alpha" {
+		t.Fatalf("project order after pinning = %s", got)
+	}
+	if model.projectSelected != 1 {
+		t.Fatalf("selected = %d want the pinned project to stay selected", model.projectSelected)
+	}
+}
+
+func TestStartupContextUnpinnedRecentFavoriteStaysRecent(t *testing.T) {
+	t.Parallel()
+
+	m := newStartupContextModel(StartupContextFlowOptions{
+		Instances: []InstanceOption{{Host: "https://gitlab.com/api/v4", Label: "gitlab.com"}},
+		ToggleFavorite: func(InstanceOption, string) (bool, error) {
+			return false, nil
+		},
+	})
+	m.stage = startupStageLoadingProjects
+	updated, _ := m.Update(startupProjectsLoadedMsg{requestID: m.requestID, projects: []StartupProjectOption{
+		{Path: "group/both", Favorite: true},
+		{Path: "group/recent", Recent: true},
+		{Path: "group/both", Recent: true},
+		{Path: "group/alpha"},
+	}})
+	model := updated.(startupContextModel)
+	if len(model.filteredProjects) != 3 || !model.filteredProjects[0].Favorite || !model.filteredProjects[0].Recent {
+		t.Fatalf("projects = %+v want group/both once, pinned and recent", model.filteredProjects)
+	}
+
+	model.projectSelected = 0
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	updated, _ = updated.Update(cmd())
+	model = updated.(startupContextModel)
+	got := make([]string, 0, len(model.filteredProjects))
+	for _, project := range model.filteredProjects {
+		got = append(got, project.Path)
+	}
+	if strings.Join(got, ",") != "group/both,group/recent,group/alpha" || model.filteredProjects[0].Favorite || !model.filteredProjects[0].Recent {
+		t.Fatalf("projects after unpinning = %+v want group/both with the recent projects", model.filteredProjects)
+	}
+	if !strings.Contains(stripANSI(model.View()), "group/both  recent") {
+		t.Fatalf("view does not mark group/both as recent:\n%s", model.View())
+	}
+}
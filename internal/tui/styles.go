@@ -16,6 +16,13 @@ type styles struct {
 	secondary      lipgloss.Style
 	title          lipgloss.Style
 	dim            lipgloss.Style
+	statusSuccess  lipgloss.Style
+	statusFailed   lipgloss.Style
+	statusRunning  lipgloss.Style
+	diffAdded      lipgloss.Style
+	diffRemoved    lipgloss.Style
+	diffHunk       lipgloss.Style
+	chip           lipgloss.Style
 	topLevelBorder lipgloss.Border
 }
 
@@ -60,9 +67,16 @@ func newStyles() styles {
 		selectedRow: lipgloss.NewStyle().
 			Foreground(accent).
 			Bold(true),
-		normalRow: lipgloss.NewStyle().Foreground(lipgloss.Color("252")),
-		secondary: lipgloss.NewStyle().Foreground(muted),
-		title:     lipgloss.NewStyle().Bold(true).Foreground(accent),
-		dim:       lipgloss.NewStyle().Foreground(muted),
+		normalRow:     lipgloss.NewStyle().Foreground(lipgloss.Color("252")),
+		secondary:     lipgloss.NewStyle().Foreground(muted),
+		title:         lipgloss.NewStyle().Bold(true).Foreground(accent),
+		dim:           lipgloss.NewStyle().Foreground(muted),
+		statusSuccess: lipgloss.NewStyle().Foreground(lipgloss.Color("42")),
+		statusFailed:  lipgloss.NewStyle().Foreground(lipgloss.Color("196")),
+		statusRunning: lipgloss.NewStyle().Foreground(lipgloss.Color("39")),
+		diffAdded:     lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true),
+		diffRemoved:   lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
+		diffHunk:      lipgloss.NewStyle().Foreground(lipgloss.Color("37")),
+		chip:          lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Background(lipgloss.Color("24")).Padding(0, 1),
 	}
 }
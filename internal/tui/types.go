@@ -8,6 +8,13 @@ const (
 	PrimaryView ViewMode = iota
 	IssuesView
 	MergeRequestsView
+	PipelinesView
+	TodosView
+	MyWorkView
+	GroupsView
+	MilestonesView
+	BoardsView
+	EpicsView
 )
 
 type ListItem struct {
@@ -17,18 +24,33 @@ type ListItem struct {
 	URL          string
 	Issue        *IssueDetails
 	MergeRequest *MergeRequestDetails
+	Pipeline     *PipelineDetails
+	Todo         *TodoDetails
+	Group        *GroupEntry
+	Milestone    *MilestoneDetails
+	Epic         *EpicDetails
+	// Depth indents items shown as a tree, such as the child epics and issues
+	// of an epic.
+	Depth int
+	// ProjectPath is set on items listed across projects, such as in the my
+	// work view.
+	ProjectPath string
 }
 
 type IssueDetails struct {
-	IID         int64
-	State       string
-	Author      string
-	Assignees   []string
-	Labels      []string
-	CreatedAt   string
-	UpdatedAt   string
-	URL         string
-	Description string
+	IID          int64
+	State        string
+	Author       string
+	Assignees    []string
+	Labels       []string
+	CreatedAt    string
+	UpdatedAt    string
+	URL          string
+	Description  string
+	AssigneeIDs  []int64
+	Milestone    string
+	MilestoneID  int64
+	Confidential bool
 }
 
 type IssueComment struct {
@@ -46,6 +68,78 @@ type IssueActivity struct {
 type IssueDetailData struct {
 	Comments   []IssueComment
 	Activities []IssueActivity
+	// Links are the issues and merge requests linked to an issue, grouped by
+	// kind in the order of the IssueLinkKind constants.
+	Links []IssueLink
+	// LinksErr tells why the links could not be loaded; the comments and
+	// activities are still shown.
+	LinksErr string
+}
+
+// IssueLinkKind tells how an item is linked to an issue.
+type IssueLinkKind string
+
+const (
+	IssueLinkRelatesTo   IssueLinkKind = "relates_to"
+	IssueLinkBlocks      IssueLinkKind = "blocks"
+	IssueLinkBlockedBy   IssueLinkKind = "is_blocked_by"
+	IssueLinkClosedBy    IssueLinkKind = "closed_by"
+	IssueLinkRelatedToMR IssueLinkKind = "related_merge_request"
+)
+
+// IssueLink is an issue or merge request linked to an issue. Item has its
+// ProjectPath set, since it may belong to another project.
+type IssueLink struct {
+	Kind IssueLinkKind
+	Item ListItem
+}
+
+type ProjectLabel struct {
+	Name        string
+	Color       string
+	Description string
+}
+
+type ProjectMember struct {
+	ID       int64
+	Username string
+	Name     string
+}
+
+type ProjectMilestone struct {
+	ID      int64
+	Title   string
+	DueDate string
+}
+
+// IssueFormOptions holds the project data the issue pickers choose from.
+type IssueFormOptions struct {
+	Labels     []ProjectLabel
+	Members    []ProjectMember
+	Milestones []ProjectMilestone
+}
+
+// NewIssue is the content of the create issue form. DueDate uses the
+// YYYY-MM-DD format and may be empty.
+type NewIssue struct {
+	Title        string
+	Description  string
+	Labels       []string
+	AssigneeIDs  []int64
+	MilestoneID  int64
+	DueDate      string
+	Confidential bool
+}
+
+// IssueUpdate is a partial change to an issue. StateEvent is "close" or
+// "reopen"; nil pointers and empty label lists leave that attribute as is.
+type IssueUpdate struct {
+	StateEvent   string
+	AssigneeIDs  *[]int64
+	AddLabels    []string
+	RemoveLabels []string
+	MilestoneID  *int64
+	Confidential *bool
 }
 
 type IssueState string
@@ -59,10 +153,50 @@ const (
 type IssueQuery struct {
 	State   IssueState
 	Search  string
+	Filter  IssueFilter
+	Sort    ListSort
 	Page    int
 	PerPage int
 }
 
+// SortField is the GitLab order_by value of an issue or merge request list.
+type SortField string
+
+const (
+	SortUpdated       SortField = "updated_at"
+	SortCreated       SortField = "created_at"
+	SortPriority      SortField = "priority"
+	SortDueDate       SortField = "due_date"
+	SortMilestoneDue  SortField = "milestone_due"
+	SortPopularity    SortField = "popularity"
+	SortTitle         SortField = "title"
+	SortLabelPriority SortField = "label_priority"
+)
+
+// ListSort orders an issue or merge request list. The zero value lists the
+// most recently updated first.
+type ListSort struct {
+	Field     SortField
+	Ascending bool
+}
+
+// IssueFilter narrows the issue list beyond state and search; it is parsed
+// from a query such as "label:bug -label:wontfix assignee:alice". Empty
+// fields do not filter. Assignee, Milestone and Weight also take "none" and
+// "any", and Due takes GitLab's due_date values such as "overdue" or "week".
+type IssueFilter struct {
+	Labels       []string
+	NotLabels    []string
+	Assignee     string
+	NotAssignee  string
+	Author       string
+	NotAuthor    string
+	Milestone    string
+	NotMilestone string
+	Weight       string
+	Due          string
+}
+
 type IssueResult struct {
 	Items       []ListItem
 	HasNextPage bool
@@ -79,35 +213,556 @@ const (
 
 type MergeRequestQuery struct {
 	State   MergeRequestState
+	Search  string
+	Filter  MergeRequestFilter
+	Sort    ListSort
 	Page    int
 	PerPage int
 }
 
+// MergeRequestFilter narrows the merge request list beyond state and search;
+// it is parsed from a query such as "reviewer:@me draft:no target:main".
+// Empty fields do not filter. Assignee, Reviewer and ApprovedBy also take
+// "none" and "any", and Draft is "yes" or "no".
+type MergeRequestFilter struct {
+	Labels     []string
+	NotLabels  []string
+	Author     string
+	NotAuthor  string
+	Assignee   string
+	Reviewer   string
+	ApprovedBy string
+	Target     string
+	Draft      string
+}
+
 type MergeRequestResult struct {
 	Items       []ListItem
 	HasNextPage bool
 }
 
 type MergeRequestDetails struct {
-	IID          int64
-	State        string
-	Author       string
+	IID                int64
+	State              string
+	Author             string
+	SourceBranch       string
+	TargetBranch       string
+	FromFork           bool
+	CreatedAt          string
+	UpdatedAt          string
+	URL                string
+	Description        string
+	Draft              bool
+	MergeStatus        string
+	HasConflicts       bool
+	Squash             bool
+	RemoveSourceBranch bool
+	AutoMerge          bool
+}
+
+// MergeOptions are the choices offered when merging. AutoMerge waits for the
+// pipeline to succeed instead of merging straight away.
+type MergeOptions struct {
+	Squash             bool
+	RemoveSourceBranch bool
+	AutoMerge          bool
+}
+
+// LocalBranch is the branch checked out in the local repository. Commits holds
+// the messages of the commits it adds to the default branch, oldest first.
+type LocalBranch struct {
+	Name        string
+	HasUpstream bool
+	Commits     []string
+}
+
+// MergeRequestFormOptions is what the new merge request form needs besides the
+// project labels and members: the local branch, the branches it can target
+// and the project's default description template.
+type MergeRequestFormOptions struct {
+	Branch         LocalBranch
+	DefaultBranch  string
+	TargetBranches []string
+	Template       string
+}
+
+// NewMergeRequest is the content of the new merge request form. Draft marks
+// the merge request as a draft through its title.
+type NewMergeRequest struct {
 	SourceBranch string
 	TargetBranch string
-	CreatedAt    string
-	UpdatedAt    string
+	Title        string
+	Description  string
+	Labels       []string
+	ReviewerIDs  []int64
+	Draft        bool
+	Squash       bool
+}
+
+// IssueBranchRequest asks for a branch for an issue, created on GitLab from the
+// default branch and then checked out locally. DraftMergeRequest also opens a
+// draft merge request that closes the issue.
+type IssueBranchRequest struct {
+	IssueIID          int64
+	IssueTitle        string
+	Branch            string
+	DraftMergeRequest bool
+}
+
+// IssueBranchResult is what CreateIssueBranch did. Output is the git transcript
+// of the local checkout, or why it was skipped.
+type IssueBranchResult struct {
+	Branch       string
+	MergeRequest *ListItem
+	Output       string
+}
+
+type MergeRequestDiffFile struct {
+	OldPath     string
+	NewPath     string
+	NewFile     bool
+	RenamedFile bool
+	DeletedFile bool
+	Diff        string
+}
+
+// MergeRequestThread is one discussion on a merge request. Inline review
+// comments carry the diff position they were left on; Path is empty for
+// general discussion.
+type MergeRequestThread struct {
+	ID         string
+	Resolvable bool
+	Resolved   bool
+	ResolvedBy string
+	Path       string
+	OldLine    int64
+	NewLine    int64
+	Notes      []IssueComment
+}
+
+type MergeRequestDetailData struct {
+	Threads    []MergeRequestThread
+	Activities []IssueActivity
+	Approvals  MergeRequestApprovals
+}
+
+// MergeRequestApprovals summarises who approved a merge request. Rules stays
+// empty when the instance has no approval rules, which are a paid feature.
+type MergeRequestApprovals struct {
+	Approved        bool
+	Required        int64
+	Left            int64
+	ApprovedBy      []string
+	UserHasApproved bool
+	UserCanApprove  bool
+	Rules           []MergeRequestApprovalRule
+}
+
+type MergeRequestApprovalRule struct {
+	Name       string
+	Required   int64
+	Approved   bool
+	ApprovedBy []string
+}
+
+type PipelineDetails struct {
+	ID          int64
+	IID         int64
+	Status      string
+	Ref         string
+	SHA         string
+	Source      string
+	Duration    string
+	TriggeredBy string
+	CreatedAt   string
+	UpdatedAt   string
+	URL         string
+}
+
+type PipelineJob struct {
+	ID           int64
+	Name         string
+	Stage        string
+	Status       string
+	Duration     string
+	AllowFailure bool
 	URL          string
+}
+
+type PipelineStage struct {
+	Name   string
+	Status string
+	Jobs   []PipelineJob
+}
+
+type PipelineDetailData struct {
+	Pipeline PipelineDetails
+	Stages   []PipelineStage
+}
+
+type JobTrace struct {
+	Content  string
+	Offset   int64
+	Reset    bool
+	Status   string
+	Complete bool
+}
+
+// TodoDetails is a pending to-do item. The target is an issue or a merge
+// request in ProjectPath, which may differ from the dashboard project.
+type TodoDetails struct {
+	ID          int64
+	Action      TodoAction
+	TargetType  TodoTargetType
+	TargetIID   int64
+	TargetState string
+	ProjectPath string
+	Author      string
+	Body        string
+	CreatedAt   string
+}
+
+type TodoAction string
+
+const (
+	TodoActionAll             TodoAction = ""
+	TodoActionAssigned        TodoAction = "assigned"
+	TodoActionMentioned       TodoAction = "mentioned"
+	TodoActionReviewRequested TodoAction = "review_requested"
+	TodoActionBuildFailed     TodoAction = "build_failed"
+)
+
+type TodoTargetType string
+
+const (
+	TodoTargetIssue        TodoTargetType = "Issue"
+	TodoTargetMergeRequest TodoTargetType = "MergeRequest"
+)
+
+type TodoQuery struct {
+	Action  TodoAction
+	Page    int
+	PerPage int
+}
+
+type TodoResult struct {
+	Items       []ListItem
+	HasNextPage bool
+}
+
+// MyWorkScope selects one of the cross-project lists in the my work view.
+type MyWorkScope string
+
+const (
+	MyWorkAssignedIssues        MyWorkScope = "assigned_issues"
+	MyWorkAuthoredMergeRequests MyWorkScope = "authored_merge_requests"
+	MyWorkReviewRequests        MyWorkScope = "review_requests"
+)
+
+type MyWorkQuery struct {
+	Scope   MyWorkScope
+	Page    int
+	PerPage int
+}
+
+type MyWorkResult struct {
+	Items       []ListItem
+	HasNextPage bool
+}
+
+// GroupEntryKind tells subgroups and projects apart in the groups view.
+type GroupEntryKind string
+
+const (
+	GroupEntrySubgroup GroupEntryKind = "group"
+	GroupEntryProject  GroupEntryKind = "project"
+)
+
+// GroupEntry is a subgroup or project listed in the groups view.
+type GroupEntry struct {
+	Kind        GroupEntryKind
+	FullPath    string
+	Description string
+}
+
+// GroupScope selects what the groups view lists for the open group.
+type GroupScope string
+
+const (
+	GroupContents      GroupScope = "contents"
+	GroupIssues        GroupScope = "issues"
+	GroupMergeRequests GroupScope = "merge_requests"
+)
+
+// GroupQuery lists the contents, open issues or open merge requests of the
+// group at Path. An empty Path lists the top-level groups. Sort orders the
+// issues and merge requests; groups and projects are listed by name.
+type GroupQuery struct {
+	Path    string
+	Scope   GroupScope
+	Sort    ListSort
+	Page    int
+	PerPage int
+}
+
+type GroupResult struct {
+	Items       []ListItem
+	HasNextPage bool
+}
+
+// MilestoneState selects the active or closed milestones.
+type MilestoneState string
+
+const (
+	MilestoneStateActive MilestoneState = "active"
+	MilestoneStateClosed MilestoneState = "closed"
+)
+
+// MilestoneDetails summarises a milestone of the project or one of its parent
+// groups, with the progress of its issues. DaysLeft counts the days to
+// DueDate, negative once it has passed, and is only set with a due date.
+type MilestoneDetails struct {
+	ID           int64
+	IID          int64
+	GroupID      int64
+	State        string
 	Description  string
+	StartDate    string
+	DueDate      string
+	DaysLeft     int
+	OpenIssues   int
+	ClosedIssues int
+	TotalWeight  int64
+	ClosedWeight int64
+	URL          string
+}
+
+// MilestoneScope selects what the milestones view lists for the open
+// milestone.
+type MilestoneScope string
+
+const (
+	MilestoneIssues        MilestoneScope = "issues"
+	MilestoneMergeRequests MilestoneScope = "merge_requests"
+)
+
+// MilestoneQuery lists the milestones in State or, when Milestone is set, the
+// issues or merge requests of that milestone.
+type MilestoneQuery struct {
+	State     MilestoneState
+	Milestone *MilestoneDetails
+	Scope     MilestoneScope
+	Page      int
+	PerPage   int
+}
+
+type MilestoneResult struct {
+	Items       []ListItem
+	HasNextPage bool
+}
+
+// IssueBoard is one of the project's issue boards.
+type IssueBoard struct {
+	ID   int64
+	Name string
+}
+
+// BoardListKind tells the open and closed lists every board has apart from
+// its label lists.
+type BoardListKind string
+
+const (
+	BoardListOpen   BoardListKind = "open"
+	BoardListLabel  BoardListKind = "label"
+	BoardListClosed BoardListKind = "closed"
+)
+
+// BoardColumn is a list of an issue board with its issues as cards. Label is
+// set on label lists; HasMore tells that the list holds more issues than
+// were loaded.
+type BoardColumn struct {
+	Kind    BoardListKind
+	Title   string
+	Label   string
+	Items   []ListItem
+	HasMore bool
+}
+
+// BoardQuery loads the board with BoardID, or the first board when it is
+// zero, with up to PerList issues in each of its lists.
+type BoardQuery struct {
+	BoardID int64
+	PerList int
+}
+
+// BoardResult holds every board of the project and the columns of the one
+// that was loaded.
+type BoardResult struct {
+	Boards  []IssueBoard
+	Board   IssueBoard
+	Columns []BoardColumn
+}
+
+// EpicState selects the open, closed or all epics.
+type EpicState string
+
+const (
+	EpicStateOpened EpicState = "opened"
+	EpicStateClosed EpicState = "closed"
+	EpicStateAll    EpicState = "all"
+)
+
+// EpicDetails identifies an epic of the project's parent group or one of its
+// subgroups. Epic items also carry IssueDetails, without an IID, so the issue
+// detail view can show them. Expanded is set once its children are listed
+// below it.
+type EpicDetails struct {
+	ID        int64
+	IID       int64
+	GroupID   int64
+	Reference string
+	StartDate string
+	DueDate   string
+	Expanded  bool
+}
+
+// EpicQuery lists the epics of the project's parent group in State.
+type EpicQuery struct {
+	State   EpicState
+	Page    int
+	PerPage int
+}
+
+type EpicResult struct {
+	Items       []ListItem
+	HasNextPage bool
+}
+
+type PipelineQuery struct {
+	Page    int
+	PerPage int
+}
+
+type PipelineResult struct {
+	Items       []ListItem
+	HasNextPage bool
 }
 
 type DataProvider interface {
 	LoadIssues(ctx context.Context, query IssueQuery) (IssueResult, error)
 	LoadMergeRequests(ctx context.Context, query MergeRequestQuery) (MergeRequestResult, error)
 	LoadIssueDetailData(ctx context.Context, issueIID int64) (IssueDetailData, error)
+	LoadPipelines(ctx context.Context, query PipelineQuery) (PipelineResult, error)
+	LoadPipelineDetailData(ctx context.Context, pipelineID int64) (PipelineDetailData, error)
+	LoadMergeRequestDetailData(ctx context.Context, mergeRequestIID int64) (MergeRequestDetailData, error)
+	LoadMergeRequestDiffs(ctx context.Context, mergeRequestIID int64) ([]MergeRequestDiffFile, error)
+	LoadMergeRequestPipeline(ctx context.Context, mergeRequestIID int64) (PipelineDetailData, error)
+	LoadJobTrace(ctx context.Context, jobID int64, offset int64) (JobTrace, error)
+	CreateIssueNote(ctx context.Context, issueIID int64, body string) error
+	CreateMergeRequestNote(ctx context.Context, mergeRequestIID int64, body string) error
+	AddDiscussionNote(ctx context.Context, mergeRequestIID int64, discussionID string, body string) error
+	LoadIssueFormOptions(ctx context.Context) (IssueFormOptions, error)
+	CreateIssue(ctx context.Context, issue NewIssue) (ListItem, error)
+	UpdateIssue(ctx context.Context, issueIID int64, update IssueUpdate) (ListItem, error)
+	ApproveMergeRequest(ctx context.Context, mergeRequestIID int64) error
+	UnapproveMergeRequest(ctx context.Context, mergeRequestIID int64) error
+	MergeMergeRequest(ctx context.Context, mergeRequestIID int64, opts MergeOptions) (ListItem, error)
+	RebaseMergeRequest(ctx context.Context, mergeRequestIID int64) error
+	SetMergeRequestState(ctx context.Context, mergeRequestIID int64, stateEvent string) (ListItem, error)
+	CheckoutMergeRequest(ctx context.Context, mergeRequestIID int64, sourceBranch string) (string, error)
+	LoadMergeRequestFormOptions(ctx context.Context) (MergeRequestFormOptions, error)
+	PushBranch(ctx context.Context, branch string) (string, error)
+	CreateMergeRequest(ctx context.Context, mr NewMergeRequest) (ListItem, error)
+	CreateIssueBranch(ctx context.Context, req IssueBranchRequest) (IssueBranchResult, error)
+	LoadTodos(ctx context.Context, query TodoQuery) (TodoResult, error)
+	MarkTodoDone(ctx context.Context, todoID int64) error
+	MarkAllTodosDone(ctx context.Context) error
+	LoadIssue(ctx context.Context, issueIID int64) (ListItem, error)
+	LoadMergeRequest(ctx context.Context, mergeRequestIID int64) (ListItem, error)
+	LoadMyWork(ctx context.Context, query MyWorkQuery) (MyWorkResult, error)
+	LoadGroup(ctx context.Context, query GroupQuery) (GroupResult, error)
+	LoadMilestones(ctx context.Context, query MilestoneQuery) (MilestoneResult, error)
+	LoadBoard(ctx context.Context, query BoardQuery) (BoardResult, error)
+	LoadEpics(ctx context.Context, query EpicQuery) (EpicResult, error)
+	// LoadEpicChildren lists the child epics of an epic followed by its
+	// issues.
+	LoadEpicChildren(ctx context.Context, epic EpicDetails) ([]ListItem, error)
+	LoadEpicDetailData(ctx context.Context, epic EpicDetails) (IssueDetailData, error)
+	// ForProject returns a provider for another project of the same GitLab
+	// instance, for following links that leave the current project.
+	ForProject(projectPath string) DataProvider
+}
+
+// CurrentUser is the account the dashboard acts as, used for "assign me".
+type CurrentUser struct {
+	ID       int64
+	Username string
+	Name     string
 }
 
 type DashboardContext struct {
-	ProjectPath string
-	Connection  string
-	Host        string
+	ProjectPath        string
+	Connection         string
+	Host               string
+	User               CurrentUser
+	IssueBranchPattern string
+	Switcher           ProjectSwitcher
+	Filters            FilterStore
+	Sorts              SortStore
+	Views              ViewStore
+}
+
+// SavedFilter is an issue filter query saved under a name.
+type SavedFilter struct {
+	Name  string
+	Query string
+}
+
+// FilterStore keeps saved issue filters between sessions.
+type FilterStore interface {
+	IssueFilters() []SavedFilter
+	SaveIssueFilter(filter SavedFilter) error
+}
+
+// SavedView is the issue or merge request list with its state, filter query
+// and sort, saved under a name.
+type SavedView struct {
+	Name  string
+	View  ViewMode
+	State string
+	Query string
+	Sort  ListSort
+}
+
+// ViewStore keeps saved views between sessions. SaveView replaces a view
+// with the same name.
+type ViewStore interface {
+	SavedViews() []SavedView
+	SaveView(view SavedView) error
+}
+
+// SortStore keeps the sort order picked for the issue and merge request lists
+// between sessions.
+type SortStore interface {
+	ListSort(view ViewMode) (ListSort, bool)
+	SaveListSort(view ViewMode, sort ListSort) error
+}
+
+// ProjectOption is a project offered by the project switcher. Recent marks
+// projects opened before and Favorite projects pinned by the user.
+type ProjectOption struct {
+	Host      string
+	HostLabel string
+	Path      string
+	Recent    bool
+	Favorite  bool
+}
+
+// ProjectSwitcher moves the dashboard to another project, possibly on another
+// GitLab instance, without restarting the program. SwitchProject returns the
+// provider and context to continue with.
+type ProjectSwitcher interface {
+	LoadProjectOptions(ctx context.Context) ([]ProjectOption, error)
+	SwitchProject(ctx context.Context, option ProjectOption) (DataProvider, DashboardContext, error)
+	ToggleFavorite(ctx context.Context, option ProjectOption) (bool, error)
 }